@@ -3,6 +3,7 @@ package bitcoin
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -20,12 +21,15 @@ import (
 
 type (
 	bitcoinClient struct {
-		config   *config.Config
-		logger   *zap.Logger
-		client   jsonrpc.Client
-		validate *validator.Validate
+		config         *config.Config
+		logger         *zap.Logger
+		client         jsonrpc.Client
+		validate       *validator.Validate
+		legacyGetBlock bool
 	}
 
+	BitcoinClientOption func(client *bitcoinClient)
+
 	bitcoinBlockHeaderResultHolder struct {
 		header  *bitcoin.BitcoinBlockLit // Use the light version for faster parsing.
 		rawJson json.RawMessage          // Store the raw message in blob storage.
@@ -70,18 +74,31 @@ var (
 	}
 )
 
-func NewBitcoinClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+func NewBitcoinClientFactory(params internal.JsonrpcClientParams, opts ...BitcoinClientOption) internal.ClientFactory {
 	return internal.NewJsonrpcClientFactory(params, func(client jsonrpc.Client) internal.Client {
 		logger := log.WithPackage(params.Logger)
-		return &bitcoinClient{
+		result := &bitcoinClient{
 			config:   params.Config,
 			logger:   logger,
 			client:   client,
 			validate: validator.New(),
 		}
+		for _, opt := range opts {
+			opt(result)
+		}
+		return result
 	})
 }
 
+// WithBitcoinLegacyGetBlock is used by the nodes forked from older versions of bitcoin core, e.g. Dogecoin,
+// whose getblock method only accepts a boolean verbose flag and does not return the full transaction objects.
+// When enabled, the transactions are fetched separately and embedded into the block.
+func WithBitcoinLegacyGetBlock() BitcoinClientOption {
+	return func(client *bitcoinClient) {
+		client.legacyGetBlock = true
+	}
+}
+
 func (b *bitcoinClient) BatchGetBlockMetadata(ctx context.Context, tag uint32, from uint64, to uint64) ([]*api.BlockMetadata, error) {
 	if from >= to {
 		return nil, xerrors.Errorf("invalid height range range of [%d, %d)", from, to)
@@ -95,10 +112,7 @@ func (b *bitcoinClient) BatchGetBlockMetadata(ctx context.Context, tag uint32, f
 
 	params := make([]jsonrpc.Params, len(blockHashes))
 	for i, hash := range blockHashes {
-		params[i] = jsonrpc.Params{
-			hash,
-			bitcoinBlockMetadataVerbosity,
-		}
+		params[i] = b.getBlockParams(hash, bitcoinBlockMetadataVerbosity)
 	}
 
 	responses, err := b.client.BatchCall(ctx, bitcoinGetBlockByHashMethod, params)
@@ -176,10 +190,7 @@ func (b *bitcoinClient) GetBlockByHeight(ctx context.Context, tag uint32, height
 
 func (b *bitcoinClient) GetBlockByHash(ctx context.Context, tag uint32, height uint64, hash string, opts ...internal.ClientOption) (*api.Block, error) {
 	ctx = internal.ContextWithOptions(ctx, opts...)
-	params := jsonrpc.Params{
-		hash,
-		bitcoinBlockVerbosity,
-	}
+	params := b.getBlockParams(hash, bitcoinBlockVerbosity)
 
 	response, err := b.client.Call(ctx, bitcoinGetBlockByHashMethod, params)
 	if err != nil {
@@ -192,6 +203,13 @@ func (b *bitcoinClient) GetBlockByHash(ctx context.Context, tag uint32, height u
 		return nil, xerrors.Errorf("failed to make a call for block hash %s: %w", hash, err)
 	}
 
+	if b.legacyGetBlock {
+		response, err = b.getBlockWithTransactions(ctx, hash, response)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get transactions for block hash %s: %w", hash, err)
+		}
+	}
+
 	headerResult, err := b.getBlockHeader(response)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to get block hash %s: %w", hash, err)
@@ -232,6 +250,88 @@ func (b *bitcoinClient) GetAccountProof(ctx context.Context, req *api.GetVerifie
 	return nil, internal.ErrNotImplemented
 }
 
+func (b *bitcoinClient) getBlockParams(hash string, verbosity int) jsonrpc.Params {
+	if b.legacyGetBlock {
+		// The legacy getblock method always returns the transaction ids only.
+		return jsonrpc.Params{hash, true}
+	}
+
+	return jsonrpc.Params{hash, verbosity}
+}
+
+// getBlockWithTransactions replaces the transaction ids returned by the legacy getblock method with the full transaction objects,
+// so that the block is stored in the same format as the one returned by getblock with verbosity 2.
+func (b *bitcoinClient) getBlockWithTransactions(ctx context.Context, hash string, response *jsonrpc.Response) (*jsonrpc.Response, error) {
+	var block map[string]json.RawMessage
+	if err := response.Unmarshal(&block); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	var transactionIDs []string
+	if err := json.Unmarshal(block["tx"], &transactionIDs); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal transaction ids: %w", err)
+	}
+
+	numTransactions := len(transactionIDs)
+	transactions := make([]json.RawMessage, 0, numTransactions)
+	for batchStart := 0; batchStart < numTransactions; batchStart += bitcoinGetInputTransactionsBatchSize {
+		batchEnd := batchStart + bitcoinGetInputTransactionsBatchSize
+		if batchEnd > numTransactions {
+			batchEnd = numTransactions
+		}
+
+		batchParams := make([]jsonrpc.Params, batchEnd-batchStart)
+		for i, transactionID := range transactionIDs[batchStart:batchEnd] {
+			batchParams[i] = jsonrpc.Params{
+				transactionID,
+				true,
+			}
+		}
+
+		batchResponses, err := b.client.BatchCall(ctx, bitcoinGetRawTransactionMethod, batchParams)
+		if err != nil {
+			return nil, xerrors.Errorf(
+				"failed to call %s for subset of (blockHash=%s, startTransactionID=%v, batchSize=%v): %w",
+				bitcoinGetRawTransactionMethod.Name,
+				hash,
+				transactionIDs[batchStart],
+				batchEnd-batchStart,
+				err,
+			)
+		}
+
+		for _, resp := range batchResponses {
+			transactions = append(transactions, resp.Result)
+		}
+	}
+
+	if len(transactions) != numTransactions {
+		return nil, xerrors.Errorf("missing transactions in BatchCall to %s (expected=%v, actual=%v)", bitcoinGetRawTransactionMethod.Name, numTransactions, len(transactions))
+	}
+
+	rawTransactions, err := json.Marshal(transactions)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to marshal transactions: %w", err)
+	}
+
+	block["tx"] = rawTransactions
+	if _, ok := block["nTx"]; !ok {
+		// nTx is not returned by the legacy getblock method either.
+		block["nTx"] = json.RawMessage(strconv.Itoa(numTransactions))
+	}
+
+	result, err := json.Marshal(block)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to marshal block: %w", err)
+	}
+
+	return &jsonrpc.Response{
+		JSONRPC: response.JSONRPC,
+		Result:  result,
+		ID:      response.ID,
+	}, nil
+}
+
 func (b *bitcoinClient) getBlockHeader(response *jsonrpc.Response) (*bitcoinBlockHeaderResultHolder, error) {
 	var header bitcoin.BitcoinBlockLit
 	if err := response.Unmarshal(&header); err != nil {
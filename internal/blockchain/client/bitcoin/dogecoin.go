@@ -0,0 +1,11 @@
+package bitcoin
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewDogecoinClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	// Dogecoin shares the same data schema as Bitcoin,
+	// but its node is forked from an older version of bitcoin core without getblock verbosity 2.
+	return NewBitcoinClientFactory(params, WithBitcoinLegacyGetBlock())
+}
@@ -0,0 +1,153 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
+	jsonrpcmocks "github.com/coinbase/chainstorage/internal/blockchain/jsonrpc/mocks"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+)
+
+const (
+	dogeFixtureBlockHash         = "0c62d0549eeafa6616e3b12cde83406b3706bc31b84188ab8592c8e875174373"
+	dogeFixturePreviousBlockHash = "4d8b5b6d5dfb2eea3dd4a9fb5d1a6e1d1f2ae2cb1a2e3b0d3b8a5c6b7e1f2a3b"
+	dogeFixtureBlockHeight       = uint64(100000)
+	dogeFixtureCoinbaseID        = "d3a0e0f1f6c3e6b5c0a1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3"
+	dogeFixtureTransactionID     = "a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2"
+	dogeFixtureInputID           = "f0e1d2c3b4a5968778695a4b3c2d1e0ff0e1d2c3b4a5968778695a4b3c2d1e0f"
+
+	dogeFixtureGetBlockHashResponse = `"0c62d0549eeafa6616e3b12cde83406b3706bc31b84188ab8592c8e875174373"`
+	dogeFixtureGetBlockResponse     = `
+{
+  "hash": "0c62d0549eeafa6616e3b12cde83406b3706bc31b84188ab8592c8e875174373",
+  "height": 100000,
+  "tx": [
+    "d3a0e0f1f6c3e6b5c0a1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3",
+    "a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2"
+  ],
+  "previousblockhash": "4d8b5b6d5dfb2eea3dd4a9fb5d1a6e1d1f2ae2cb1a2e3b0d3b8a5c6b7e1f2a3b",
+  "time": 1700000000,
+  "auxpow": {
+    "index": 0,
+    "chainindex": 0,
+    "parentblock": "00000020"
+  }
+}
+`
+	dogeFixtureGetCoinbaseResponse = `
+{
+  "txid": "d3a0e0f1f6c3e6b5c0a1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3",
+  "vin": [{"coinbase": "03a08601", "sequence": 4294967295}],
+  "vout": [{"value": 10000, "n": 0}]
+}
+`
+	dogeFixtureGetTransactionResponse = `
+{
+  "txid": "a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2",
+  "vin": [{"txid": "f0e1d2c3b4a5968778695a4b3c2d1e0ff0e1d2c3b4a5968778695a4b3c2d1e0f", "vout": 0}],
+  "vout": [{"value": 1, "n": 0}]
+}
+`
+	dogeFixtureGetInputResponse = `
+{
+  "txid": "f0e1d2c3b4a5968778695a4b3c2d1e0ff0e1d2c3b4a5968778695a4b3c2d1e0f",
+  "vout": [
+    {
+      "value": 2,
+      "n": 0,
+      "scriptPubKey": {
+        "hex": "76a9146de9db8719ab43dab1c05954a066b776154b499c88ac",
+        "type": "pubkeyhash",
+        "addresses": ["DFAGLseSCfJhK3DjSZRzoAs8yjWhRBP3R8"]
+      }
+    }
+  ]
+}
+`
+)
+
+func TestDogecoinClient_GetBlockByHeight(t *testing.T) {
+	require := testutil.Require(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+
+	var result internal.ClientParams
+	app := testapp.New(
+		t,
+		Module,
+		testModule(rpcClient),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_DOGECOIN, common.Network_NETWORK_DOGECOIN_TESTNET),
+		fx.Populate(&result),
+	)
+	defer app.Close()
+
+	client := result.Master
+	require.NotNil(client)
+
+	rpcClient.EXPECT().Call(
+		gomock.Any(), bitcoinGetBlockHashMethod, jsonrpc.Params{dogeFixtureBlockHeight},
+	).Return(&jsonrpc.Response{Result: json.RawMessage(dogeFixtureGetBlockHashResponse)}, nil)
+
+	// The legacy getblock method only accepts a boolean verbose flag.
+	rpcClient.EXPECT().Call(
+		gomock.Any(), bitcoinGetBlockByHashMethod, jsonrpc.Params{dogeFixtureBlockHash, true},
+	).Return(&jsonrpc.Response{Result: json.RawMessage(dogeFixtureGetBlockResponse)}, nil)
+
+	// The transactions of the block are fetched separately.
+	rpcClient.EXPECT().BatchCall(
+		gomock.Any(), bitcoinGetRawTransactionMethod, []jsonrpc.Params{
+			{dogeFixtureCoinbaseID, true},
+			{dogeFixtureTransactionID, true},
+		},
+	).Return([]*jsonrpc.Response{
+		{Result: json.RawMessage(dogeFixtureGetCoinbaseResponse)},
+		{Result: json.RawMessage(dogeFixtureGetTransactionResponse)},
+	}, nil)
+
+	rpcClient.EXPECT().BatchCall(
+		gomock.Any(), bitcoinGetRawTransactionMethod, []jsonrpc.Params{
+			{dogeFixtureInputID, true},
+		},
+	).Return([]*jsonrpc.Response{
+		{Result: json.RawMessage(dogeFixtureGetInputResponse)},
+	}, nil)
+
+	block, err := client.GetBlockByHeight(context.Background(), btcTag, dogeFixtureBlockHeight)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_DOGECOIN, block.Blockchain)
+	require.Equal(common.Network_NETWORK_DOGECOIN_TESTNET, block.Network)
+	require.Equal(dogeFixtureBlockHash, block.Metadata.Hash)
+	require.Equal(dogeFixturePreviousBlockHash, block.Metadata.ParentHash)
+	require.Equal(dogeFixtureBlockHeight, block.Metadata.Height)
+
+	blobdata := block.GetBitcoin()
+	require.NotNil(blobdata)
+	require.Len(blobdata.InputTransactions, 2)
+	require.Empty(blobdata.InputTransactions[0].Data)
+	require.Len(blobdata.InputTransactions[1].Data, 1)
+
+	var header struct {
+		NTx int `json:"nTx"`
+		Tx  []struct {
+			TxId string `json:"txid"`
+		} `json:"tx"`
+		AuxPow json.RawMessage `json:"auxpow"`
+	}
+	err = json.Unmarshal(blobdata.Header, &header)
+	require.NoError(err)
+	require.Equal(2, header.NTx)
+	require.Len(header.Tx, 2)
+	require.Equal(dogeFixtureCoinbaseID, header.Tx[0].TxId)
+	require.Equal(dogeFixtureTransactionID, header.Tx[1].TxId)
+	require.NotEmpty(header.AuxPow)
+}
@@ -0,0 +1,10 @@
+package bitcoin
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewLitecoinClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	// Litecoin shares the same data schema as Bitcoin.
+	return NewBitcoinClientFactory(params)
+}
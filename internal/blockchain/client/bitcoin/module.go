@@ -7,4 +7,12 @@ var Module = fx.Options(
 		Name:   "bitcoin",
 		Target: NewBitcoinClientFactory,
 	}),
+	fx.Provide(fx.Annotated{
+		Name:   "dogecoin",
+		Target: NewDogecoinClientFactory,
+	}),
+	fx.Provide(fx.Annotated{
+		Name:   "litecoin",
+		Target: NewLitecoinClientFactory,
+	}),
 )
@@ -0,0 +1,325 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/cosmos"
+	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	clientImpl struct {
+		config   *config.Config
+		logger   *zap.Logger
+		client   restapi.Client
+		validate *validator.Validate
+	}
+
+	// rpcErrorResponse is the error envelope of the Tendermint/CometBFT RPC.
+	// Depending on the version, the error is returned with either a 200 or a 500 status code.
+	rpcErrorResponse struct {
+		Error *rpcError `json:"error"`
+	}
+
+	rpcError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	}
+)
+
+const (
+	// The maximum number of block metas returned by /blockchain.
+	blockchainMaxBlocks = 20
+
+	// The error data returned when the requested height has not been produced yet.
+	heightNotAvailableError = "must be less than or equal to the current blockchain height"
+)
+
+var _ internal.Client = (*clientImpl)(nil)
+
+func NewClientFactory(params internal.RestapiClientParams) internal.ClientFactory {
+	return internal.NewRestapiClientFactory(params, func(client restapi.Client) internal.Client {
+		logger := log.WithPackage(params.Logger)
+		return &clientImpl{
+			config:   params.Config,
+			logger:   logger,
+			client:   client,
+			validate: validator.New(),
+		}
+	})
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("RPCError %v: %v (%v)", e.Code, e.Message, e.Data)
+}
+
+func (c *clientImpl) BatchGetBlockMetadata(ctx context.Context, tag uint32, from uint64, to uint64) ([]*api.BlockMetadata, error) {
+	if from >= to {
+		return nil, xerrors.Errorf("invalid height range of [%d, %d)", from, to)
+	}
+
+	blocks := make([]*api.BlockMetadata, 0, to-from)
+	for minHeight := from; minHeight < to; minHeight += blockchainMaxBlocks {
+		maxHeight := minHeight + blockchainMaxBlocks - 1
+		if maxHeight > to-1 {
+			maxHeight = to - 1
+		}
+
+		var response cosmos.BlockchainResponse
+		if err := c.call(ctx, c.getBlockchainMethod(minHeight, maxHeight), &response); err != nil {
+			return nil, xerrors.Errorf("failed to get block metas [%v, %v]: %w", minHeight, maxHeight, err)
+		}
+
+		metas := response.Result.BlockMetas
+		if len(metas) != int(maxHeight-minHeight+1) {
+			return nil, xerrors.Errorf(
+				"failed to get block metas [%v, %v]: got unexpected number of blocks %v (last height %v): %w",
+				minHeight, maxHeight, len(metas), response.Result.LastHeight.Value(), internal.ErrBlockNotFound,
+			)
+		}
+
+		// The block metas are returned in descending order.
+		sort.Slice(metas, func(i, j int) bool {
+			return metas[i].Header.Height < metas[j].Header.Height
+		})
+
+		for i, meta := range metas {
+			height := minHeight + uint64(i)
+			if meta.Header.Height.Value() != height {
+				return nil, xerrors.Errorf("got unexpected height (expected=%v, actual=%v)", height, meta.Header.Height.Value())
+			}
+
+			metadata, err := c.getBlockMetadata(tag, meta.BlockId, meta.Header)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to get block metadata (height=%v): %w", height, err)
+			}
+
+			blocks = append(blocks, metadata)
+		}
+	}
+
+	return blocks, nil
+}
+
+func (c *clientImpl) GetBlockByHeight(ctx context.Context, tag uint32, height uint64, _ ...internal.ClientOption) (*api.Block, error) {
+	block, err := c.getBlock(ctx, tag, height)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block (height=%v): %w", height, err)
+	}
+
+	return block, nil
+}
+
+func (c *clientImpl) GetBlockByHash(ctx context.Context, tag uint32, height uint64, hash string, _ ...internal.ClientOption) (*api.Block, error) {
+	block, err := c.getBlock(ctx, tag, height)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block (height=%v, hash=%v): %w", height, hash, err)
+	}
+
+	if hash != block.Metadata.Hash {
+		return nil, xerrors.Errorf("failed to get block by hash: got unexpected hash (expected=%v, actual=%v)", hash, block.Metadata.Hash)
+	}
+
+	return block, nil
+}
+
+func (c *clientImpl) GetLatestHeight(ctx context.Context) (uint64, error) {
+	var response cosmos.StatusResponse
+	if err := c.call(ctx, c.getStatusMethod(), &response); err != nil {
+		return 0, xerrors.Errorf("failed to get status: %w", err)
+	}
+
+	return response.Result.SyncInfo.LatestBlockHeight.Value(), nil
+}
+
+func (c *clientImpl) UpgradeBlock(_ context.Context, _ *api.Block, _ uint32) (*api.Block, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (c *clientImpl) CanReprocess(_ uint32, _ uint64) bool {
+	return false
+}
+
+func (c *clientImpl) GetAccountProof(_ context.Context, _ *api.GetVerifiedAccountStateRequest) (*api.GetAccountProofResponse, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (c *clientImpl) getBlock(ctx context.Context, tag uint32, height uint64) (*api.Block, error) {
+	blockData, err := c.callRaw(ctx, c.getBlockMethod(height))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block: %w", err)
+	}
+
+	var block cosmos.BlockResponse
+	if err := json.Unmarshal(blockData, &block); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	if err := c.validate.Struct(block); err != nil {
+		return nil, xerrors.Errorf("failed to validate block: %w", err)
+	}
+
+	header := block.Result.Block.Header
+	if header.Height.Value() != height {
+		return nil, xerrors.Errorf("got unexpected height (expected=%v, actual=%v)", height, header.Height.Value())
+	}
+
+	metadata, err := c.getBlockMetadata(tag, block.Result.BlockId, header)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block metadata: %w", err)
+	}
+
+	// The results of a block are only available once the next block is committed;
+	// they are consistent with the block because the height is final once committed.
+	blockResultsData, err := c.callRaw(ctx, c.getBlockResultsMethod(height))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block results: %w", err)
+	}
+
+	return &api.Block{
+		Blockchain: c.config.Chain.Blockchain,
+		Network:    c.config.Chain.Network,
+		SideChain:  c.config.Chain.Sidechain,
+		Metadata:   metadata,
+		Blobdata: &api.Block_Cosmos{
+			Cosmos: &api.CosmosBlobdata{
+				Block:        blockData,
+				BlockResults: blockResultsData,
+			},
+		},
+	}, nil
+}
+
+func (c *clientImpl) getBlockMetadata(tag uint32, blockId *cosmos.BlockId, header *cosmos.Header) (*api.BlockMetadata, error) {
+	if blockId.Hash == "" {
+		return nil, xerrors.New("block hash is empty")
+	}
+
+	timestamp, err := header.GetTimestamp()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get timestamp: %w", err)
+	}
+
+	height := header.Height.Value()
+	var parentHeight uint64
+	if height > 0 {
+		parentHeight = height - 1
+	}
+
+	return &api.BlockMetadata{
+		Tag:          tag,
+		Height:       height,
+		ParentHeight: parentHeight,
+		Hash:         blockId.Hash,
+		ParentHash:   header.GetParentHash(),
+		Timestamp:    timestamp,
+	}, nil
+}
+
+// call invokes the method and unmarshals the response into result, which is validated afterwards.
+func (c *clientImpl) call(ctx context.Context, method *restapi.RequestMethod, result any) error {
+	response, err := c.callRaw(ctx, method)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(response, result); err != nil {
+		return xerrors.Errorf("failed to unmarshal response of %v: %w", method.Name, err)
+	}
+
+	if err := c.validate.Struct(result); err != nil {
+		return xerrors.Errorf("failed to validate response of %v: %w", method.Name, err)
+	}
+
+	return nil
+}
+
+// callRaw invokes the method and returns the raw response.
+func (c *clientImpl) callRaw(ctx context.Context, method *restapi.RequestMethod) ([]byte, error) {
+	response, err := c.client.Call(ctx, method, nil)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to call restapi: %w", handleCallError(err))
+	}
+
+	var errResponse rpcErrorResponse
+	if err := json.Unmarshal(response, &errResponse); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal response of %v: %w", method.Name, err)
+	}
+
+	if errResponse.Error != nil {
+		return nil, xerrors.Errorf("received rpc error: %w", translateRPCError(errResponse.Error))
+	}
+
+	return response, nil
+}
+
+func (c *clientImpl) getStatusMethod() *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetStatus",
+		ParamsPath: "/status",
+		Timeout:    5 * time.Second,
+	}
+}
+
+func (c *clientImpl) getBlockchainMethod(minHeight uint64, maxHeight uint64) *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetBlockchain",
+		ParamsPath: fmt.Sprintf("/blockchain?minHeight=%d&maxHeight=%d", minHeight, maxHeight),
+		Timeout:    5 * time.Second,
+	}
+}
+
+func (c *clientImpl) getBlockMethod(height uint64) *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetBlock",
+		ParamsPath: fmt.Sprintf("/block?height=%d", height),
+		Timeout:    10 * time.Second,
+	}
+}
+
+func (c *clientImpl) getBlockResultsMethod(height uint64) *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetBlockResults",
+		ParamsPath: fmt.Sprintf("/block_results?height=%d", height),
+		Timeout:    15 * time.Second,
+	}
+}
+
+// handleCallError translates the rpc error embedded in the http error, if any.
+func handleCallError(callErr error) error {
+	var errHTTP *restapi.HTTPError
+	if !errors.As(callErr, &errHTTP) {
+		return callErr
+	}
+
+	var errResponse rpcErrorResponse
+	if err := json.Unmarshal([]byte(errHTTP.Response), &errResponse); err != nil || errResponse.Error == nil {
+		return callErr
+	}
+
+	return xerrors.Errorf("%v: %w", callErr, translateRPCError(errResponse.Error))
+}
+
+// translateRPCError translates the error returned for a height beyond the tip into ErrBlockNotFound.
+func translateRPCError(errRPC *rpcError) error {
+	if strings.Contains(errRPC.Data, heightNotAvailableError) {
+		return xerrors.Errorf("%v: %w", errRPC, internal.ErrBlockNotFound)
+	}
+
+	return errRPC
+}
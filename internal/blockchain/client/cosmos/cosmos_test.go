@@ -0,0 +1,255 @@
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	restapimocks "github.com/coinbase/chainstorage/internal/blockchain/restapi/mocks"
+	"github.com/coinbase/chainstorage/internal/dlq"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type cosmosClientTestSuite struct {
+	suite.Suite
+
+	ctrl       *gomock.Controller
+	app        testapp.TestApp
+	restClient *restapimocks.MockClient
+	client     internal.Client
+}
+
+// The fixtures are NOT cosmoshub-4 responses: they were written by hand, and the heights, the hashes and the timestamps
+// below are made up. The block and its results are the same as the synthetic fixtures of the parser. They only exercise
+// the requests made by the client and should be replaced with the responses of a real node.
+const (
+	cosmosTag           = uint32(1)
+	cosmosHeight        = uint64(19000001)
+	cosmosParentHeight  = uint64(19000000)
+	cosmosLatestHeight  = uint64(19000100)
+	cosmosHash          = "8E5B2D3C6A0F7A8FD5B0B1E6C0C7B6E2D6E1A0F3C4B5A69788796A5B4C3D2E1F"
+	cosmosParentHash    = "5F4A3B2C1D0E9F8A7B6C5D4E3F2A1B0C9D8E7F6A5B4C3D2E1F0A9B8C7D6E5F4A"
+	cosmosNextHash      = "1A2B3C4D5E6F7A8B9C0D1E2F3A4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0F1A2B"
+	cosmosTimestamp     = "2024-01-15T08:30:12.345678901Z"
+	cosmosNextTimestamp = "2024-01-15T08:30:18.456789012Z"
+)
+
+func TestCosmosClientTestSuite(t *testing.T) {
+	suite.Run(t, new(cosmosClientTestSuite))
+}
+
+func (s *cosmosClientTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.restClient = restapimocks.NewMockClient(s.ctrl)
+
+	var result internal.ClientParams
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_COSMOS, common.Network_NETWORK_COSMOS_MAINNET),
+		Module,
+		jsonrpc.Module,
+		testRestModule(s.restClient),
+		fx.Populate(&result),
+	)
+
+	s.client = result.Master
+	s.NotNil(s.client)
+}
+
+func (s *cosmosClientTestSuite) TearDownTest() {
+	s.app.Close()
+	s.ctrl.Finish()
+}
+
+func (s *cosmosClientTestSuite) TestBatchGetBlockMetadata() {
+	require := testutil.Require(s.T())
+
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlockchain",
+		ParamsPath: "/blockchain?minHeight=19000001&maxHeight=19000002",
+		Timeout:    5 * time.Second,
+	}, gomock.Any()).Return(fixtures.MustReadFile("client/cosmos/synthetic_blockchain.json"), nil)
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), cosmosTag, cosmosHeight, cosmosHeight+2)
+	require.NoError(err)
+	require.Equal([]*api.BlockMetadata{
+		{
+			Tag:          cosmosTag,
+			Height:       cosmosHeight,
+			ParentHeight: cosmosParentHeight,
+			Hash:         cosmosHash,
+			ParentHash:   cosmosParentHash,
+			Timestamp:    testutil.MustTimestamp(cosmosTimestamp),
+		},
+		{
+			Tag:          cosmosTag,
+			Height:       cosmosHeight + 1,
+			ParentHeight: cosmosHeight,
+			Hash:         cosmosNextHash,
+			ParentHash:   cosmosHash,
+			Timestamp:    testutil.MustTimestamp(cosmosNextTimestamp),
+		},
+	}, blocks)
+}
+
+func (s *cosmosClientTestSuite) TestBatchGetBlockMetadata_Incomplete() {
+	require := testutil.Require(s.T())
+
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlockchain",
+		ParamsPath: "/blockchain?minHeight=19000001&maxHeight=19000003",
+		Timeout:    5 * time.Second,
+	}, gomock.Any()).Return(fixtures.MustReadFile("client/cosmos/synthetic_blockchain.json"), nil)
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), cosmosTag, cosmosHeight, cosmosHeight+3)
+	require.Error(err)
+	require.True(errors.Is(err, internal.ErrBlockNotFound))
+	require.Nil(blocks)
+}
+
+func (s *cosmosClientTestSuite) TestGetBlockByHeight() {
+	require := testutil.Require(s.T())
+
+	block := fixtures.MustReadFile("client/cosmos/synthetic_block.json")
+	blockResults := fixtures.MustReadFile("client/cosmos/synthetic_block_results.json")
+	s.expectBlock(cosmosHeight, block, nil)
+	s.expectBlockResults(cosmosHeight, blockResults, nil)
+
+	result, err := s.client.GetBlockByHeight(context.Background(), cosmosTag, cosmosHeight)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_COSMOS, result.Blockchain)
+	require.Equal(common.Network_NETWORK_COSMOS_MAINNET, result.Network)
+	require.Equal(&api.BlockMetadata{
+		Tag:          cosmosTag,
+		Height:       cosmosHeight,
+		ParentHeight: cosmosParentHeight,
+		Hash:         cosmosHash,
+		ParentHash:   cosmosParentHash,
+		Timestamp:    testutil.MustTimestamp(cosmosTimestamp),
+	}, result.Metadata)
+
+	blobdata := result.GetCosmos()
+	require.NotNil(blobdata)
+	require.Equal(block, blobdata.Block)
+	require.Equal(blockResults, blobdata.BlockResults)
+}
+
+func (s *cosmosClientTestSuite) TestGetBlockByHeight_NotFound() {
+	require := testutil.Require(s.T())
+
+	s.expectBlock(cosmosLatestHeight+1, nil, xerrors.Errorf("received http error: %w", &restapi.HTTPError{
+		Code:     http.StatusInternalServerError,
+		Response: `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 19000101 must be less than or equal to the current blockchain height 19000100"}}`,
+	}))
+
+	result, err := s.client.GetBlockByHeight(context.Background(), cosmosTag, cosmosLatestHeight+1)
+	require.Error(err)
+	require.True(errors.Is(err, internal.ErrBlockNotFound))
+	require.Nil(result)
+}
+
+func (s *cosmosClientTestSuite) TestGetBlockByHeight_RPCError() {
+	require := testutil.Require(s.T())
+
+	s.expectBlock(cosmosLatestHeight+1, []byte(`{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 19000101 must be less than or equal to the current blockchain height 19000100"}}`), nil)
+
+	result, err := s.client.GetBlockByHeight(context.Background(), cosmosTag, cosmosLatestHeight+1)
+	require.Error(err)
+	require.True(errors.Is(err, internal.ErrBlockNotFound))
+	require.Nil(result)
+}
+
+func (s *cosmosClientTestSuite) TestGetBlockByHash() {
+	require := testutil.Require(s.T())
+
+	s.expectBlock(cosmosHeight, fixtures.MustReadFile("client/cosmos/synthetic_block.json"), nil)
+	s.expectBlockResults(cosmosHeight, fixtures.MustReadFile("client/cosmos/synthetic_block_results.json"), nil)
+
+	result, err := s.client.GetBlockByHash(context.Background(), cosmosTag, cosmosHeight, cosmosHash)
+	require.NoError(err)
+	require.Equal(cosmosHash, result.Metadata.Hash)
+	require.Equal(cosmosHeight, result.Metadata.Height)
+	require.NotNil(result.GetCosmos())
+}
+
+func (s *cosmosClientTestSuite) TestGetBlockByHash_Mismatch() {
+	require := testutil.Require(s.T())
+
+	s.expectBlock(cosmosHeight, fixtures.MustReadFile("client/cosmos/synthetic_block.json"), nil)
+	s.expectBlockResults(cosmosHeight, fixtures.MustReadFile("client/cosmos/synthetic_block_results.json"), nil)
+
+	result, err := s.client.GetBlockByHash(context.Background(), cosmosTag, cosmosHeight, cosmosNextHash)
+	require.Error(err)
+	require.Contains(err.Error(), "got unexpected hash")
+	require.Nil(result)
+}
+
+func (s *cosmosClientTestSuite) TestGetLatestHeight() {
+	require := testutil.Require(s.T())
+
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetStatus",
+		ParamsPath: "/status",
+		Timeout:    5 * time.Second,
+	}, gomock.Any()).Return(fixtures.MustReadFile("client/cosmos/synthetic_status.json"), nil)
+
+	height, err := s.client.GetLatestHeight(context.Background())
+	require.NoError(err)
+	require.Equal(cosmosLatestHeight, height)
+}
+
+func (s *cosmosClientTestSuite) expectBlock(height uint64, response []byte, err error) {
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlock",
+		ParamsPath: fmt.Sprintf("/block?height=%d", height),
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(response, err)
+}
+
+func (s *cosmosClientTestSuite) expectBlockResults(height uint64, response []byte, err error) {
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlockResults",
+		ParamsPath: fmt.Sprintf("/block_results?height=%d", height),
+		Timeout:    15 * time.Second,
+	}, gomock.Any()).Return(response, err)
+}
+
+func testRestModule(client *restapimocks.MockClient) fx.Option {
+	return fx.Options(
+		internal.Module,
+		fx.Provide(fx.Annotated{
+			Name:   "master",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "slave",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "validator",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "consensus",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(dlq.NewNop),
+		fx.Provide(parser.NewNop),
+	)
+}
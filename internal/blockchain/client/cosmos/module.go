@@ -0,0 +1,10 @@
+package cosmos
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(fx.Annotated{
+		Name:   "cosmos/staking",
+		Target: NewClientFactory,
+	}),
+)
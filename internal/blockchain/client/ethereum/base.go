@@ -8,9 +8,16 @@ import (
 const (
 	// Before changing this constant, make sure to update the SLA config.
 	baseCommitmentLevel = types.CommitmentLevelLatest
+
+	// The Base nodes support eth_getBlockReceipts, which saves one call per transaction.
+	baseNodeType = types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS
 )
 
 func NewBaseClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
 	// Base shares the same data schema as Ethereum since it is an internal EVM chain.
-	return NewEthereumClientFactory(params, WithEthereumCommitmentLevel(baseCommitmentLevel))
+	return NewEthereumClientFactory(
+		params,
+		WithEthereumCommitmentLevel(baseCommitmentLevel),
+		WithEthereumNodeType(baseNodeType),
+	)
 }
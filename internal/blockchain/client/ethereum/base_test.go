@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -97,6 +98,10 @@ func (s *baseClientTestSuite) TestBaseTest_GetBlockByHeight_WithBestEffort() {
 	transactionResponse := &jsonrpc.Response{
 		Result: fixtures.MustReadFile("client/base/base_gettraceresponse.json"),
 	}
+	// Base supports eth_getBlockReceipts.
+	blockReceiptsResponse := &jsonrpc.Response{
+		Result: []byte(fmt.Sprintf("[%s]", fixtures.MustReadFile("client/base/base_gettransactionreceipt.json"))),
+	}
 
 	s.rpcClient.EXPECT().Call(
 		gomock.Any(), gomock.Any(), gomock.Any(),
@@ -107,6 +112,10 @@ func (s *baseClientTestSuite) TestBaseTest_GetBlockByHeight_WithBestEffort() {
 				return blockResponse, nil
 			}
 
+			if method == ethGetBlockReceiptsMethod {
+				return blockReceiptsResponse, nil
+			}
+
 			if method == ethTraceTransactionMethod {
 				opts := params[1].(map[string]string)
 				tracer := opts["tracer"]
@@ -126,15 +135,6 @@ func (s *baseClientTestSuite) TestBaseTest_GetBlockByHeight_WithBestEffort() {
 			return nil, xerrors.Errorf("unknown method: %v", method)
 		})
 
-	receiptResponse := []*jsonrpc.Response{
-		{
-			Result: fixtures.MustReadFile("client/base/base_gettransactionreceipt.json"),
-		},
-	}
-	s.rpcClient.EXPECT().BatchCall(
-		gomock.Any(), ethGetTransactionReceiptMethod, gomock.Any(),
-	).Return(receiptResponse, nil)
-
 	block, err := s.client.GetBlockByHeight(context.Background(), tag, 387634, internal.WithBestEffort())
 	require.NoError(err)
 	require.NotNil(block)
@@ -160,6 +160,10 @@ func (s *baseClientTestSuite) TestBaseTest_GetBlockByHeight_WithoutBestEffort()
 	transactionResponse := &jsonrpc.Response{
 		Result: transactionResult,
 	}
+	// Base supports eth_getBlockReceipts.
+	blockReceiptsResponse := &jsonrpc.Response{
+		Result: []byte(fmt.Sprintf("[%s]", fixtures.MustReadFile("client/base/base_gettransactionreceipt.json"))),
+	}
 
 	s.rpcClient.EXPECT().Call(
 		gomock.Any(), gomock.Any(), gomock.Any(),
@@ -170,6 +174,10 @@ func (s *baseClientTestSuite) TestBaseTest_GetBlockByHeight_WithoutBestEffort()
 				return blockResponse, nil
 			}
 
+			if method == ethGetBlockReceiptsMethod {
+				return blockReceiptsResponse, nil
+			}
+
 			if method == ethTraceBlockByHashMethod {
 				opts := params[1].(map[string]string)
 				tracer := opts["tracer"]
@@ -189,15 +197,6 @@ func (s *baseClientTestSuite) TestBaseTest_GetBlockByHeight_WithoutBestEffort()
 			return nil, xerrors.Errorf("unknown method: %v", method)
 		})
 
-	receiptResponse := []*jsonrpc.Response{
-		{
-			Result: fixtures.MustReadFile("client/base/base_gettransactionreceipt.json"),
-		},
-	}
-	s.rpcClient.EXPECT().BatchCall(
-		gomock.Any(), ethGetTransactionReceiptMethod, gomock.Any(),
-	).Return(receiptResponse, nil)
-
 	block, err := s.client.GetBlockByHash(context.Background(), tag, 387634, "0xcd2bbaef960686844a016bb301c60e5726d8e71db04ee19014ee3dfada7351b4")
 	require.NoError(err)
 	require.NotNil(block)
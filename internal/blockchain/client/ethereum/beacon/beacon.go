@@ -0,0 +1,376 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/ethereum/beacon"
+	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	"github.com/coinbase/chainstorage/internal/utils/syncgroup"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	clientImpl struct {
+		config   *config.Config
+		logger   *zap.Logger
+		client   restapi.Client
+		validate *validator.Validate
+	}
+
+	// blockResult holds the raw responses of a slot.
+	// header is nil if the slot is missed.
+	blockResult struct {
+		metadata *api.BlockMetadata
+		header   []byte
+		block    []byte
+		blobs    []byte
+	}
+)
+
+const (
+	beaconBatchMetadataParallelism = 10
+
+	// The block id of the latest block.
+	blockIdHead = "head"
+)
+
+var _ internal.Client = (*clientImpl)(nil)
+
+func NewClientFactory(params internal.RestapiClientParams) internal.ClientFactory {
+	return internal.NewRestapiClientFactory(params, func(client restapi.Client) internal.Client {
+		logger := log.WithPackage(params.Logger)
+		return &clientImpl{
+			config:   params.Config,
+			logger:   logger,
+			client:   client,
+			validate: validator.New(),
+		}
+	})
+}
+
+func (c *clientImpl) BatchGetBlockMetadata(ctx context.Context, tag uint32, from uint64, to uint64) ([]*api.BlockMetadata, error) {
+	if from >= to {
+		return nil, xerrors.Errorf("invalid height range of [%d, %d)", from, to)
+	}
+
+	// The beacon node does not support batch requests; query the headers in parallel instead.
+	numBlocks := int(to - from)
+	headers := make([]*beacon.BlockHeaderResponse, numBlocks)
+	group, groupCtx := syncgroup.New(ctx, syncgroup.WithThrottling(beaconBatchMetadataParallelism))
+	for i := 0; i < numBlocks; i++ {
+		index := i
+		group.Go(func() error {
+			height := from + uint64(index)
+			header, _, err := c.getHeader(groupCtx, fmt.Sprintf("%d", height))
+			if err != nil {
+				if errors.Is(err, internal.ErrBlockNotFound) {
+					// The slot is missed.
+					return nil
+				}
+
+				return xerrors.Errorf("failed to get header (height=%v): %w", height, err)
+			}
+
+			headers[index] = header
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, xerrors.Errorf("failed to get block headers in parallel: %w", err)
+	}
+
+	blocks := make([]*api.BlockMetadata, numBlocks)
+	for i, header := range headers {
+		height := from + uint64(i)
+		if header == nil {
+			skipped, err := c.newSkippedMetadata(ctx, tag, height)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to get metadata of missed slot (height=%v): %w", height, err)
+			}
+
+			blocks[i] = skipped
+			continue
+		}
+
+		var prevHeader *beacon.BlockHeaderResponse
+		if i > 0 {
+			prevHeader = headers[i-1]
+		}
+
+		metadata, err := c.getBlockMetadata(ctx, tag, header, prevHeader)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get block metadata (height=%v): %w", height, err)
+		}
+
+		blocks[i] = metadata
+	}
+
+	return blocks, nil
+}
+
+func (c *clientImpl) GetBlockByHeight(ctx context.Context, tag uint32, height uint64, _ ...internal.ClientOption) (*api.Block, error) {
+	result, err := c.getBlock(ctx, tag, height, fmt.Sprintf("%d", height))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block (height=%v): %w", height, err)
+	}
+
+	return c.newBlock(result), nil
+}
+
+func (c *clientImpl) GetBlockByHash(ctx context.Context, tag uint32, height uint64, hash string, _ ...internal.ClientOption) (*api.Block, error) {
+	// Missed slots do not have a hash and can only be queried by height.
+	blockId := hash
+	if hash == "" {
+		blockId = fmt.Sprintf("%d", height)
+	}
+
+	result, err := c.getBlock(ctx, tag, height, blockId)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block (height=%v, hash=%v): %w", height, hash, err)
+	}
+
+	if hash != result.metadata.Hash {
+		return nil, xerrors.Errorf("failed to get block by hash: got unexpected hash (expected=%v, actual=%v)", hash, result.metadata.Hash)
+	}
+
+	return c.newBlock(result), nil
+}
+
+func (c *clientImpl) GetLatestHeight(ctx context.Context) (uint64, error) {
+	header, _, err := c.getHeader(ctx, blockIdHead)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to get latest header: %w", err)
+	}
+
+	return header.Data.Header.Message.Slot.Value(), nil
+}
+
+func (c *clientImpl) UpgradeBlock(_ context.Context, _ *api.Block, _ uint32) (*api.Block, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (c *clientImpl) CanReprocess(_ uint32, _ uint64) bool {
+	return false
+}
+
+func (c *clientImpl) GetAccountProof(_ context.Context, _ *api.GetVerifiedAccountStateRequest) (*api.GetAccountProofResponse, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (c *clientImpl) newBlock(result *blockResult) *api.Block {
+	block := &api.Block{
+		Blockchain: c.config.Chain.Blockchain,
+		Network:    c.config.Chain.Network,
+		SideChain:  c.config.Chain.Sidechain,
+		Metadata:   result.metadata,
+	}
+
+	if result.header != nil {
+		block.Blobdata = &api.Block_EthereumBeacon{
+			EthereumBeacon: &api.EthereumBeaconBlobdata{
+				Header: result.header,
+				Block:  result.block,
+				Blobs:  result.blobs,
+			},
+		}
+	}
+
+	return block
+}
+
+func (c *clientImpl) getBlock(ctx context.Context, tag uint32, height uint64, blockId string) (*blockResult, error) {
+	header, headerData, err := c.getHeader(ctx, blockId)
+	if err != nil {
+		if !errors.Is(err, internal.ErrBlockNotFound) {
+			return nil, xerrors.Errorf("failed to get header: %w", err)
+		}
+
+		skipped, err := c.newSkippedMetadata(ctx, tag, height)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get metadata of missed slot: %w", err)
+		}
+
+		return &blockResult{metadata: skipped}, nil
+	}
+
+	metadata, err := c.getBlockMetadata(ctx, tag, header, nil)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block metadata: %w", err)
+	}
+
+	if metadata.Height != height {
+		return nil, xerrors.Errorf("got unexpected slot (expected=%v, actual=%v)", height, metadata.Height)
+	}
+
+	// Query the block by its root, so that the block is consistent with the header even if a reorg happens in between.
+	blockData, err := c.client.Call(ctx, c.getBlockMethod(metadata.Hash), nil)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block by root %v: %w", metadata.Hash, handleCallError(err))
+	}
+
+	var block beacon.BlockResponse
+	if err := json.Unmarshal(blockData, &block); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	if err := c.validate.Struct(block); err != nil {
+		return nil, xerrors.Errorf("failed to validate block: %w", err)
+	}
+
+	var blobsData []byte
+	if block.HasBlobs() {
+		blobsData, err = c.client.Call(ctx, c.getBlobsMethod(metadata.Hash), nil)
+		if err != nil {
+			err = handleCallError(err)
+			if !errors.Is(err, internal.ErrBlockNotFound) {
+				return nil, xerrors.Errorf("failed to get blobs by root %v: %w", metadata.Hash, err)
+			}
+
+			// Blob sidecars are only retained for about 18 days by the beacon nodes.
+			c.logger.Warn("blob sidecars are not available", zap.Reflect("metadata", metadata))
+			blobsData = nil
+		}
+	}
+
+	return &blockResult{
+		metadata: metadata,
+		header:   headerData,
+		block:    blockData,
+		blobs:    blobsData,
+	}, nil
+}
+
+// getBlockMetadata converts the header into BlockMetadata.
+// prevHeader is the header of the previous slot, if known, which saves the lookup of the parent slot.
+func (c *clientImpl) getBlockMetadata(ctx context.Context, tag uint32, header *beacon.BlockHeaderResponse, prevHeader *beacon.BlockHeaderResponse) (*api.BlockMetadata, error) {
+	message := header.Data.Header.Message
+	slot := message.Slot.Value()
+	timestamp, err := beacon.GetSlotTimestamp(c.config.Chain.Sidechain, slot)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get timestamp of slot %v: %w", slot, err)
+	}
+
+	parentHeight, err := c.getParentHeight(ctx, header, prevHeader)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get parent height: %w", err)
+	}
+
+	return &api.BlockMetadata{
+		Tag:          tag,
+		Height:       slot,
+		ParentHeight: parentHeight,
+		Hash:         header.Data.Root,
+		ParentHash:   message.ParentRoot,
+		Timestamp:    timestamp,
+	}, nil
+}
+
+// getParentHeight returns the slot of the parent block.
+// Because of missed slots, the parent slot is not necessarily the previous slot.
+func (c *clientImpl) getParentHeight(ctx context.Context, header *beacon.BlockHeaderResponse, prevHeader *beacon.BlockHeaderResponse) (uint64, error) {
+	message := header.Data.Header.Message
+	slot := message.Slot.Value()
+	if slot == 0 {
+		return 0, nil
+	}
+
+	if prevHeader != nil && prevHeader.Data.Root == message.ParentRoot {
+		return slot - 1, nil
+	}
+
+	parentHeader, _, err := c.getHeader(ctx, message.ParentRoot)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to get parent header by root %v: %w", message.ParentRoot, err)
+	}
+
+	return parentHeader.Data.Header.Message.Slot.Value(), nil
+}
+
+// newSkippedMetadata returns the metadata of a missed slot.
+// A slot beyond the head has not been proposed yet, in which case ErrBlockNotFound is returned.
+func (c *clientImpl) newSkippedMetadata(ctx context.Context, tag uint32, height uint64) (*api.BlockMetadata, error) {
+	latestHeight, err := c.GetLatestHeight(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get latest height: %w", err)
+	}
+
+	if height > latestHeight {
+		return nil, xerrors.Errorf("slot %v is beyond the head %v: %w", height, latestHeight, internal.ErrBlockNotFound)
+	}
+
+	return &api.BlockMetadata{
+		Tag:     tag,
+		Height:  height,
+		Skipped: true,
+	}, nil
+}
+
+// getHeader returns the parsed header and the raw response given the block id, which is either a slot or a block root.
+func (c *clientImpl) getHeader(ctx context.Context, blockId string) (*beacon.BlockHeaderResponse, []byte, error) {
+	response, err := c.client.Call(ctx, c.getHeaderMethod(blockId), nil)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("failed to call restapi: %w", handleCallError(err))
+	}
+
+	var header beacon.BlockHeaderResponse
+	if err := json.Unmarshal(response, &header); err != nil {
+		return nil, nil, xerrors.Errorf("failed to unmarshal header: %w", err)
+	}
+
+	if err := c.validate.Struct(header); err != nil {
+		return nil, nil, xerrors.Errorf("failed to validate header: %w", err)
+	}
+
+	return &header, response, nil
+}
+
+func (c *clientImpl) getHeaderMethod(blockId string) *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetBlockHeader",
+		ParamsPath: fmt.Sprintf("/eth/v1/beacon/headers/%s", blockId),
+		Timeout:    5 * time.Second,
+	}
+}
+
+func (c *clientImpl) getBlockMethod(blockId string) *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetBlock",
+		ParamsPath: fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockId),
+		Timeout:    10 * time.Second,
+	}
+}
+
+func (c *clientImpl) getBlobsMethod(blockId string) *restapi.RequestMethod {
+	return &restapi.RequestMethod{
+		Name:       "GetBlobSidecars",
+		ParamsPath: fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockId),
+		Timeout:    10 * time.Second,
+	}
+}
+
+// handleCallError translates 404 responses, which the beacon node returns for missed slots, into ErrBlockNotFound.
+func handleCallError(callErr error) error {
+	var errHTTP *restapi.HTTPError
+	if !errors.As(callErr, &errHTTP) {
+		return callErr
+	}
+
+	if errHTTP.Code == http.StatusNotFound {
+		return xerrors.Errorf("%v: %w", errHTTP.Response, internal.ErrBlockNotFound)
+	}
+
+	return callErr
+}
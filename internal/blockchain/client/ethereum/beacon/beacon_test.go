@@ -0,0 +1,282 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	restapimocks "github.com/coinbase/chainstorage/internal/blockchain/restapi/mocks"
+	"github.com/coinbase/chainstorage/internal/dlq"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type beaconClientTestSuite struct {
+	suite.Suite
+
+	ctrl       *gomock.Controller
+	app        testapp.TestApp
+	restClient *restapimocks.MockClient
+	client     internal.Client
+}
+
+const (
+	beaconTag          = uint32(1)
+	beaconHeight       = uint64(8000001)
+	beaconParentHeight = uint64(7999999)
+	beaconMissedHeight = uint64(8000000)
+	beaconHeadHeight   = uint64(8000100)
+	beaconHash         = "0x212f8151226fce768980fd6789e6c5e856204de64456c3678ae86d91146cfa3f"
+	beaconParentHash   = "0xa1081b778ca39d1253d6d0222cec9ab7f50edcd61c351a8318e2f4fdd3f7e77d"
+	beaconTimestamp    = "2023-12-17T14:40:35Z"
+)
+
+func TestBeaconClientTestSuite(t *testing.T) {
+	suite.Run(t, new(beaconClientTestSuite))
+}
+
+func (s *beaconClientTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.restClient = restapimocks.NewMockClient(s.ctrl)
+
+	var result internal.ClientParams
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetworkSidechain(common.Blockchain_BLOCKCHAIN_ETHEREUM, common.Network_NETWORK_ETHEREUM_MAINNET, api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON),
+		Module,
+		jsonrpc.Module,
+		testRestModule(s.restClient),
+		fx.Populate(&result),
+	)
+
+	s.client = result.Master
+	s.NotNil(s.client)
+}
+
+func (s *beaconClientTestSuite) TearDownTest() {
+	s.app.Close()
+	s.ctrl.Finish()
+}
+
+func (s *beaconClientTestSuite) TestBatchGetBlockMetadata() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader("8000000", nil, notFoundError())
+	s.expectHeader("8000001", fixtures.MustReadFile("client/ethereum/beacon/block_header.json"), nil)
+	s.expectHeader("head", fixtures.MustReadFile("client/ethereum/beacon/head_block_header.json"), nil)
+	// The parent slot is looked up by root, because the previous slot is missed.
+	s.expectHeader(beaconParentHash, fixtures.MustReadFile("client/ethereum/beacon/parent_block_header.json"), nil)
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), beaconTag, beaconMissedHeight, beaconHeight+1)
+	require.NoError(err)
+	require.Equal(2, len(blocks))
+
+	require.Equal(&api.BlockMetadata{
+		Tag:     beaconTag,
+		Height:  beaconMissedHeight,
+		Skipped: true,
+	}, blocks[0])
+
+	require.Equal(&api.BlockMetadata{
+		Tag:          beaconTag,
+		Height:       beaconHeight,
+		ParentHeight: beaconParentHeight,
+		Hash:         beaconHash,
+		ParentHash:   beaconParentHash,
+		Timestamp:    testutil.MustTimestamp(beaconTimestamp),
+	}, blocks[1])
+}
+
+func (s *beaconClientTestSuite) TestBatchGetBlockMetadata_Failure() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader("8000001", nil, xerrors.Errorf("received http error: %w", &restapi.HTTPError{
+		Code:     http.StatusInternalServerError,
+		Response: "internal error",
+	}))
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), beaconTag, beaconHeight, beaconHeight+1)
+	require.Error(err)
+	require.Nil(blocks)
+
+	var errHTTP *restapi.HTTPError
+	require.True(errors.As(err, &errHTTP))
+	require.Equal(http.StatusInternalServerError, errHTTP.Code)
+}
+
+func (s *beaconClientTestSuite) TestGetBlockByHeight() {
+	require := testutil.Require(s.T())
+
+	header := fixtures.MustReadFile("client/ethereum/beacon/block_header.json")
+	block := fixtures.MustReadFile("client/ethereum/beacon/block.json")
+	blobs := fixtures.MustReadFile("client/ethereum/beacon/blob_sidecars.json")
+	s.expectHeader("8000001", header, nil)
+	s.expectHeader(beaconParentHash, fixtures.MustReadFile("client/ethereum/beacon/parent_block_header.json"), nil)
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlock",
+		ParamsPath: "/eth/v2/beacon/blocks/" + beaconHash,
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(block, nil)
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlobSidecars",
+		ParamsPath: "/eth/v1/beacon/blob_sidecars/" + beaconHash,
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(blobs, nil)
+
+	result, err := s.client.GetBlockByHeight(context.Background(), beaconTag, beaconHeight)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_ETHEREUM, result.Blockchain)
+	require.Equal(common.Network_NETWORK_ETHEREUM_MAINNET, result.Network)
+	require.Equal(api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON, result.SideChain)
+	require.Equal(&api.BlockMetadata{
+		Tag:          beaconTag,
+		Height:       beaconHeight,
+		ParentHeight: beaconParentHeight,
+		Hash:         beaconHash,
+		ParentHash:   beaconParentHash,
+		Timestamp:    testutil.MustTimestamp(beaconTimestamp),
+	}, result.Metadata)
+
+	blobdata := result.GetEthereumBeacon()
+	require.NotNil(blobdata)
+	require.Equal(header, blobdata.Header)
+	require.Equal(block, blobdata.Block)
+	require.Equal(blobs, blobdata.Blobs)
+}
+
+func (s *beaconClientTestSuite) TestGetBlockByHeight_BlobsPruned() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader("8000001", fixtures.MustReadFile("client/ethereum/beacon/block_header.json"), nil)
+	s.expectHeader(beaconParentHash, fixtures.MustReadFile("client/ethereum/beacon/parent_block_header.json"), nil)
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlock",
+		ParamsPath: "/eth/v2/beacon/blocks/" + beaconHash,
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(fixtures.MustReadFile("client/ethereum/beacon/block.json"), nil)
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlobSidecars",
+		ParamsPath: "/eth/v1/beacon/blob_sidecars/" + beaconHash,
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(nil, notFoundError())
+
+	result, err := s.client.GetBlockByHeight(context.Background(), beaconTag, beaconHeight)
+	require.NoError(err)
+	require.NotNil(result.GetEthereumBeacon())
+	require.NotEmpty(result.GetEthereumBeacon().Block)
+	require.Empty(result.GetEthereumBeacon().Blobs)
+}
+
+func (s *beaconClientTestSuite) TestGetBlockByHeight_Skipped() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader("8000000", nil, notFoundError())
+	s.expectHeader("head", fixtures.MustReadFile("client/ethereum/beacon/head_block_header.json"), nil)
+
+	result, err := s.client.GetBlockByHeight(context.Background(), beaconTag, beaconMissedHeight)
+	require.NoError(err)
+	require.Equal(&api.BlockMetadata{
+		Tag:     beaconTag,
+		Height:  beaconMissedHeight,
+		Skipped: true,
+	}, result.Metadata)
+	require.Nil(result.Blobdata)
+}
+
+func (s *beaconClientTestSuite) TestGetBlockByHeight_NotFound() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader("8000101", nil, notFoundError())
+	s.expectHeader("head", fixtures.MustReadFile("client/ethereum/beacon/head_block_header.json"), nil)
+
+	result, err := s.client.GetBlockByHeight(context.Background(), beaconTag, beaconHeadHeight+1)
+	require.Error(err)
+	require.True(errors.Is(err, internal.ErrBlockNotFound))
+	require.Nil(result)
+}
+
+func (s *beaconClientTestSuite) TestGetBlockByHash() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader(beaconHash, fixtures.MustReadFile("client/ethereum/beacon/block_header.json"), nil)
+	s.expectHeader(beaconParentHash, fixtures.MustReadFile("client/ethereum/beacon/parent_block_header.json"), nil)
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlock",
+		ParamsPath: "/eth/v2/beacon/blocks/" + beaconHash,
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(fixtures.MustReadFile("client/ethereum/beacon/block.json"), nil)
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlobSidecars",
+		ParamsPath: "/eth/v1/beacon/blob_sidecars/" + beaconHash,
+		Timeout:    10 * time.Second,
+	}, gomock.Any()).Return(fixtures.MustReadFile("client/ethereum/beacon/blob_sidecars.json"), nil)
+
+	result, err := s.client.GetBlockByHash(context.Background(), beaconTag, beaconHeight, beaconHash)
+	require.NoError(err)
+	require.Equal(beaconHash, result.Metadata.Hash)
+	require.Equal(beaconHeight, result.Metadata.Height)
+	require.NotNil(result.GetEthereumBeacon())
+}
+
+func (s *beaconClientTestSuite) TestGetLatestHeight() {
+	require := testutil.Require(s.T())
+
+	s.expectHeader("head", fixtures.MustReadFile("client/ethereum/beacon/head_block_header.json"), nil)
+
+	height, err := s.client.GetLatestHeight(context.Background())
+	require.NoError(err)
+	require.Equal(beaconHeadHeight, height)
+}
+
+func (s *beaconClientTestSuite) expectHeader(blockId string, response []byte, err error) {
+	s.restClient.EXPECT().Call(gomock.Any(), &restapi.RequestMethod{
+		Name:       "GetBlockHeader",
+		ParamsPath: "/eth/v1/beacon/headers/" + blockId,
+		Timeout:    5 * time.Second,
+	}, gomock.Any()).Return(response, err)
+}
+
+func notFoundError() error {
+	return xerrors.Errorf("received http error: %w", &restapi.HTTPError{
+		Code:     http.StatusNotFound,
+		Response: `{"code":404,"message":"NOT_FOUND: beacon block"}`,
+	})
+}
+
+func testRestModule(client *restapimocks.MockClient) fx.Option {
+	return fx.Options(
+		internal.Module,
+		fx.Provide(fx.Annotated{
+			Name:   "master",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "slave",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "validator",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "consensus",
+			Target: func() restapi.Client { return client },
+		}),
+		fx.Provide(dlq.NewNop),
+		fx.Provide(parser.NewNop),
+	)
+}
@@ -0,0 +1,10 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewBlastClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	// Blast shares the same data schema as Ethereum since it is an EVM chain built on the OP stack.
+	return NewEthereumClientFactory(params)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"math/big"
 	"regexp"
+	"sync/atomic"
 	"time"
 
 	geth "github.com/ethereum/go-ethereum/common"
@@ -40,6 +41,11 @@ type (
 		nodeType        types.EthereumNodeType
 		traceType       types.TraceType
 		commitmentLevel types.CommitmentLevel
+
+		// blockReceiptsFallbackUntil is the time in unix nanoseconds until which eth_getBlockReceipts is skipped,
+		// after a node responded to it with method-not-found. The client does not know which of the endpoints
+		// responded, so the fallback expires instead of disabling the call for every endpoint for good.
+		blockReceiptsFallbackUntil atomic.Int64
 	}
 
 	EthereumClientOption func(client *EthereumClient)
@@ -53,6 +59,7 @@ type (
 		traceTransactionSuccessCounter    tally.Counter
 		traceTransactionIgnoredCounter    tally.Counter
 		transactionReceiptFakeCounter     tally.Counter
+		blockReceiptsFallbackCounter      tally.Counter
 	}
 
 	ethereumResultHolder struct {
@@ -84,6 +91,8 @@ const (
 	ethExecutionTimeoutError = "execution timeout"
 
 	ethBlockTransactionReceiptBatchSize = 100
+	ethMethodNotFoundErrCode            = -32601
+	ethBlockReceiptsFallbackDuration    = 10 * time.Minute
 
 	ethNullAddress     = "0x0000000000000000000000000000000000000000"
 	genesisBlockNumber = "0x0"
@@ -99,6 +108,7 @@ const (
 	traceBlockCounter         = "trace_block"
 	traceTransactionCounter   = "trace_transaction"
 	transactionReceiptCounter = "transaction_receipt"
+	blockReceiptsCounter      = "block_receipts"
 	resultType                = "result_type"
 	transaction_FAILED        = uint64(0)
 	optimismWhitelistError    = "TypeError: cannot read property 'toString' of undefined    in server-side tracer function 'result'"
@@ -137,6 +147,12 @@ var (
 		Timeout: time.Second * 20,
 	}
 
+	// JSON RPC method to get all the transaction receipts of an ethereum block.
+	ethGetBlockReceiptsMethod = &jsonrpc.RequestMethod{
+		Name:    "eth_getBlockReceipts",
+		Timeout: time.Second * 30,
+	}
+
 	// JSON RPC method to trace a block by its hash.
 	ethTraceBlockByHashMethod = &jsonrpc.RequestMethod{
 		Name:    "debug_traceBlockByHash",
@@ -309,6 +325,9 @@ func newEthereumClientMetrics(scope tally.Scope) *ethereumClientMetrics {
 		Counter(traceTransactionCounter)
 
 	transactionReceiptFakeCounter := scope.Counter(transactionReceiptCounter)
+	blockReceiptsFallbackCounter := scope.
+		Tagged(map[string]string{resultType: "fallback"}).
+		Counter(blockReceiptsCounter)
 
 	return &ethereumClientMetrics{
 		traceBlockSuccessCounter:          traceBlockSuccessCounter,
@@ -319,6 +338,7 @@ func newEthereumClientMetrics(scope tally.Scope) *ethereumClientMetrics {
 		traceBlockFakeCounter:             traceBlockFakeCounter,
 		traceTransactionFakeCounter:       traceTransactionFakeCounter,
 		transactionReceiptFakeCounter:     transactionReceiptFakeCounter,
+		blockReceiptsFallbackCounter:      blockReceiptsFallbackCounter,
 	}
 }
 
@@ -675,6 +695,113 @@ func (c *EthereumClient) getBlockTransactionReceipts(ctx context.Context, block
 		return nil, nil
 	}
 
+	if c.nodeType.BlockReceiptsEnabled() && time.Now().UnixNano() >= c.blockReceiptsFallbackUntil.Load() {
+		receipts, err := c.getBlockReceipts(ctx, block)
+		if err == nil {
+			return receipts, nil
+		}
+
+		var rpcErr *jsonrpc.RPCError
+		if !xerrors.As(err, &rpcErr) || rpcErr.Code != ethMethodNotFoundErrCode {
+			return nil, err
+		}
+
+		// The node does not support eth_getBlockReceipts. Fall back to fetching the receipts one transaction at a time,
+		// and remember it for a while so that the call is not attempted for every block.
+		c.blockReceiptsFallbackUntil.Store(time.Now().Add(ethBlockReceiptsFallbackDuration).UnixNano())
+		c.metrics.blockReceiptsFallbackCounter.Inc(1)
+		c.logger.Warn(
+			"eth_getBlockReceipts is not supported, falling back to eth_getTransactionReceipt",
+			zap.Uint64("height", block.Number.Value()),
+			zap.Duration("duration", ethBlockReceiptsFallbackDuration),
+			zap.Error(err),
+		)
+	}
+
+	return c.getTransactionReceipts(ctx, block)
+}
+
+// getBlockReceipts fetches all the transaction receipts of the block using a single eth_getBlockReceipts call.
+func (c *EthereumClient) getBlockReceipts(ctx context.Context, block *ethereum.EthereumBlockLit) ([][]byte, error) {
+	height := block.Number.Value()
+	blockHash := block.Hash.Value()
+	numTransactions := len(block.Transactions)
+	params := jsonrpc.Params{
+		blockHash,
+	}
+
+	return retry.WrapWithResult(ctx, func(ctx context.Context) ([][]byte, error) {
+		response, err := c.client.Call(ctx, ethGetBlockReceiptsMethod, params)
+		if err != nil {
+			return nil, xerrors.Errorf(
+				"failed to call %s (height=%v, blockHash=%v): %w",
+				ethGetBlockReceiptsMethod.Name, height, blockHash, err,
+			)
+		}
+
+		var rawReceipts []json.RawMessage
+		if err := response.Unmarshal(&rawReceipts); err != nil {
+			return nil, xerrors.Errorf(
+				"failed to unmarshal block receipts (height=%v, blockHash=%v): %w",
+				height, blockHash, err,
+			)
+		}
+
+		// The result is null if the block is unknown to the node we just queried.
+		if len(rawReceipts) != numTransactions {
+			// Return ErrBlockNotFound so that syncer may fall back to the master node.
+			return nil, retry.Retryable(xerrors.Errorf(
+				"got unexpected number of block receipts (height=%v, blockHash=%v, expected=%v, actual=%v): %w",
+				height, blockHash, numTransactions, len(rawReceipts), internal.ErrBlockNotFound,
+			))
+		}
+
+		receipts := make([][]byte, numTransactions)
+		for i, rawReceipt := range rawReceipts {
+			var receipt ethereum.EthereumTransactionReceiptLit
+			if err := json.Unmarshal(rawReceipt, &receipt); err != nil {
+				return nil, xerrors.Errorf(
+					"failed to unmarshal transaction receipt (height=%v, blockHash=%v, transactionIndex=%v, response=%v): %w",
+					height, blockHash, i, string(rawReceipt), err,
+				)
+			}
+
+			// Need to retry if the returned transaction belongs to an orphaned block.
+			if receipt.BlockHash.Value() != blockHash {
+				if !c.fakeReceiptEnabled(block) {
+					// Return ErrBlockNotFound so that syncer may fall back to the master node.
+					return nil, retry.Retryable(xerrors.Errorf(
+						"got transaction receipt from an orphaned block (height=%v, blockHash=%v, transactionIndex=%v, response=%v): %w",
+						height, blockHash, i, string(rawReceipt), internal.ErrBlockNotFound,
+					))
+				}
+
+				receipts[i], err = c.getFakeReceipt(block, i)
+				if err != nil {
+					return nil, err
+				}
+
+				continue
+			}
+
+			// Unlike eth_getTransactionReceipt, the receipts are not requested by the transaction hashes,
+			// so make sure that they are returned in the same order as the transactions in the block.
+			if expected := block.Transactions[i].Hash.Value(); receipt.TransactionHash.Value() != expected {
+				return nil, xerrors.Errorf(
+					"got unexpected transaction hash in block receipts (height=%v, blockHash=%v, transactionIndex=%v, expected=%v, actual=%v)",
+					height, blockHash, i, expected, receipt.TransactionHash.Value(),
+				)
+			}
+
+			receipts[i] = rawReceipt
+		}
+
+		return receipts, nil
+	})
+}
+
+// getTransactionReceipts fetches the transaction receipts of the block using batched eth_getTransactionReceipt calls.
+func (c *EthereumClient) getTransactionReceipts(ctx context.Context, block *ethereum.EthereumBlockLit) ([][]byte, error) {
 	height := block.Number.Value()
 	blockHash := block.Hash.Value()
 	numTransactions := len(block.Transactions)
@@ -715,24 +842,10 @@ func (c *EthereumClient) getBlockTransactionReceipts(ctx context.Context, block
 
 				// Need to retry if the returned transaction belongs to an orphaned block.
 				if receipt.BlockHash.Value() != blockHash {
-
-					// Create a fake receipt for the unsuccessful optimism transaction.
-					if c.config.Blockchain() == common.Blockchain_BLOCKCHAIN_OPTIMISM && len(block.Transactions) > 0 {
-						c.metrics.transactionReceiptFakeCounter.Inc(1)
-						status := ethereum.EthereumQuantity(transaction_FAILED)
-						blockTransaction := block.Transactions[transactionIndex]
-						fakeReceipt := ethereum.EthereumTransactionReceipt{
-							TransactionHash: blockTransaction.Hash,
-							BlockHash:       ethereum.EthereumHexString(blockHash),
-							BlockNumber:     block.Number,
-							Status:          &status,
-						}
-						resp.Result, err = json.Marshal(&fakeReceipt)
+					if c.fakeReceiptEnabled(block) {
+						resp.Result, err = c.getFakeReceipt(block, transactionIndex)
 						if err != nil {
-							return xerrors.Errorf(
-								"failed to marshal fake transaction receipt for optimism (height=%v, blockHash=%v, error=%v)",
-								height, blockHash, err,
-							)
+							return err
 						}
 					} else {
 						// Return ErrBlockNotFound so that syncer may fall back to the master node.
@@ -755,6 +868,34 @@ func (c *EthereumClient) getBlockTransactionReceipts(ctx context.Context, block
 	return c.extractResultsFromResponses(responses), nil
 }
 
+// fakeReceiptEnabled returns true if a fake receipt should be created for a transaction whose receipt belongs to another block.
+// In Optimism, the unsuccessful transactions may be duplicated in a later block, and the receipt can only be found in the earlier one.
+func (c *EthereumClient) fakeReceiptEnabled(block *ethereum.EthereumBlockLit) bool {
+	return c.config.Blockchain() == common.Blockchain_BLOCKCHAIN_OPTIMISM && len(block.Transactions) > 0
+}
+
+// getFakeReceipt creates a fake receipt for the unsuccessful optimism transaction.
+func (c *EthereumClient) getFakeReceipt(block *ethereum.EthereumBlockLit, transactionIndex int) ([]byte, error) {
+	c.metrics.transactionReceiptFakeCounter.Inc(1)
+	blockHash := block.Hash.Value()
+	status := ethereum.EthereumQuantity(transaction_FAILED)
+	fakeReceipt := ethereum.EthereumTransactionReceipt{
+		TransactionHash: block.Transactions[transactionIndex].Hash,
+		BlockHash:       ethereum.EthereumHexString(blockHash),
+		BlockNumber:     block.Number,
+		Status:          &status,
+	}
+	result, err := json.Marshal(&fakeReceipt)
+	if err != nil {
+		return nil, xerrors.Errorf(
+			"failed to marshal fake transaction receipt for optimism (height=%v, blockHash=%v, error=%v)",
+			block.Number.Value(), blockHash, err,
+		)
+	}
+
+	return result, nil
+}
+
 func (c *EthereumClient) getBlockTraces(ctx context.Context, tag uint32, block *ethereum.EthereumBlockLit) ([][]byte, error) {
 	nodeType := c.nodeType
 	traceType := c.traceType
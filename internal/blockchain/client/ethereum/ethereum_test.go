@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"strconv"
 	"testing"
+	"time"
 
 	geth "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -20,6 +21,8 @@ import (
 	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
 	jsonrpcmocks "github.com/coinbase/chainstorage/internal/blockchain/jsonrpc/mocks"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/ethereum"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/ethereum/types"
 	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
 	"github.com/coinbase/chainstorage/internal/dlq"
 	"github.com/coinbase/chainstorage/internal/utils/fixtures"
@@ -154,6 +157,35 @@ const (
 		"blockNumber":"0xacc290"
 	}`
 
+	fixtureBlockReceipts = `[
+		{
+			"transactionHash": "0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b",
+			"blockHash": "0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b",
+			"blockNumber":"0xacc290",
+			"transactionIndex":"0x0"
+		},
+		{
+			"transactionHash": "0xf5365847bff6e48d0c6bc23eee276343d2987efd9876c3c1bf597225e3d69991",
+			"blockHash": "0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b",
+			"blockNumber":"0xacc290",
+			"transactionIndex":"0x1"
+		}
+	]`
+	fixtureOrphanedBlockReceipts = `[
+		{
+			"transactionHash": "0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b",
+			"blockHash": "0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b",
+			"blockNumber":"0xacc290",
+			"transactionIndex":"0x0"
+		},
+		{
+			"transactionHash": "0xf5365847bff6e48d0c6bc23eee276343d2987efd9876c3c1bf597225e3d69991",
+			"blockHash": "0xb91edf64c8c47f199398050a1d18efc3b00725d866b875e340198f563a000575",
+			"blockNumber":"0xacc290",
+			"transactionIndex":"0x1"
+		}
+	]`
+
 	fixtureBlockTrace = `
 	[
 		{"result": {"type": "CALL"}},
@@ -1159,6 +1191,331 @@ func TestEthereumClient_RetryOrphanedTransactionReceipts_RetryLimitExceeded(t *t
 	require.True(xerrors.Is(err, internal.ErrBlockNotFound))
 }
 
+func TestEthereumClient_GetBlockReceipts(t *testing.T) {
+	require := testutil.Require(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+	blockResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlock),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockByNumberMethod, jsonrpc.Params{
+			"0xacc290",
+			true,
+		},
+	).Return(blockResponse, nil)
+
+	receiptsResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlockReceipts),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockReceiptsMethod, jsonrpc.Params{
+			ethereumHash,
+		},
+	).Return(receiptsResponse, nil)
+
+	traceResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlockTrace),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethTraceBlockByHashMethod, gomock.Any(),
+	).Return(traceResponse, nil)
+
+	var client internal.Client
+	app := testapp.New(
+		t,
+		testModule(rpcClient),
+		fx.Invoke(func(params internal.JsonrpcClientParams) {
+			client = NewEthereumClientFactory(params, WithEthereumNodeType(types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS)).Master()
+		}),
+	)
+	defer app.Close()
+	require.NotNil(client)
+
+	block, err := client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+	require.NoError(err)
+	require.Equal(ethereumHash, block.Metadata.Hash)
+
+	blobdata := block.GetEthereum()
+	require.NotNil(blobdata)
+	require.Equal(2, len(blobdata.TransactionReceipts))
+	for i, receipt := range blobdata.TransactionReceipts {
+		var actual map[string]string
+		require.NoError(json.Unmarshal(receipt, &actual))
+		require.Equal(ethereumHash, actual["blockHash"])
+		require.Equal(hexutil.EncodeUint64(uint64(i)), actual["transactionIndex"])
+	}
+	require.NotNil(blobdata.TransactionTraces)
+}
+
+func TestEthereumClient_GetBlockReceipts_RetryOrphanedBlock(t *testing.T) {
+	require := testutil.Require(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+	blockResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlock),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockByNumberMethod, jsonrpc.Params{
+			"0xacc290",
+			true,
+		},
+	).Return(blockResponse, nil)
+
+	attempts := 0
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockReceiptsMethod, gomock.Any(),
+	).Times(retry.DefaultMaxAttempts).
+		DoAndReturn(func(ctx context.Context, method *jsonrpc.RequestMethod, params jsonrpc.Params, opts ...jsonrpc.Option) (*jsonrpc.Response, error) {
+			// Return the correct receipts on the last retry attempt.
+			attempts += 1
+			if attempts < retry.DefaultMaxAttempts {
+				return &jsonrpc.Response{Result: json.RawMessage(fixtureOrphanedBlockReceipts)}, nil
+			}
+
+			return &jsonrpc.Response{Result: json.RawMessage(fixtureBlockReceipts)}, nil
+		})
+
+	traceResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlockTrace),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethTraceBlockByHashMethod, gomock.Any(),
+	).Return(traceResponse, nil)
+
+	var client internal.Client
+	app := testapp.New(
+		t,
+		testModule(rpcClient),
+		fx.Invoke(func(params internal.JsonrpcClientParams) {
+			client = NewEthereumClientFactory(params, WithEthereumNodeType(types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS)).Master()
+		}),
+	)
+	defer app.Close()
+	require.NotNil(client)
+
+	block, err := client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+	require.NoError(err)
+	require.Equal(retry.DefaultMaxAttempts, attempts)
+	require.Equal(2, len(block.GetEthereum().TransactionReceipts))
+}
+
+func TestEthereumClient_GetBlockReceipts_RetryLimitExceeded(t *testing.T) {
+	require := testutil.Require(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+	blockResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlock),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockByNumberMethod, jsonrpc.Params{
+			"0xacc290",
+			true,
+		},
+	).Return(blockResponse, nil)
+
+	// The result is null if the block is unknown to the node.
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockReceiptsMethod, gomock.Any(),
+	).Times(retry.DefaultMaxAttempts).
+		Return(&jsonrpc.Response{Result: json.RawMessage(fixtureNullReceipt)}, nil)
+
+	var client internal.Client
+	app := testapp.New(
+		t,
+		testModule(rpcClient),
+		fx.Invoke(func(params internal.JsonrpcClientParams) {
+			client = NewEthereumClientFactory(params, WithEthereumNodeType(types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS)).Master()
+		}),
+	)
+	defer app.Close()
+	require.NotNil(client)
+
+	_, err := client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+	require.Error(err)
+	require.True(xerrors.Is(err, internal.ErrBlockNotFound))
+}
+
+func TestEthereumClient_GetBlockReceipts_FallbackToTransactionReceipts(t *testing.T) {
+	require := testutil.Require(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+	blockResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlock),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockByNumberMethod, jsonrpc.Params{
+			"0xacc290",
+			true,
+		},
+	).Times(3).Return(blockResponse, nil)
+
+	// eth_getBlockReceipts is not called again until the fallback expires.
+	gomock.InOrder(
+		rpcClient.EXPECT().Call(
+			gomock.Any(), ethGetBlockReceiptsMethod, gomock.Any(),
+		).Times(1).Return(nil, xerrors.Errorf("received rpc error: %w", &jsonrpc.RPCError{
+			Code:    -32601,
+			Message: "the method eth_getBlockReceipts does not exist/is not available",
+		})),
+		rpcClient.EXPECT().Call(
+			gomock.Any(), ethGetBlockReceiptsMethod, gomock.Any(),
+		).Times(1).Return(&jsonrpc.Response{Result: json.RawMessage(fixtureBlockReceipts)}, nil),
+	)
+
+	receiptResponse := []*jsonrpc.Response{
+		{Result: json.RawMessage(fixtureReceipt)},
+		{Result: json.RawMessage(fixtureReceipt)},
+	}
+	rpcClient.EXPECT().BatchCall(
+		gomock.Any(), ethGetTransactionReceiptMethod, []jsonrpc.Params{
+			{ethereum.EthereumHexString("0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b")},
+			{ethereum.EthereumHexString("0xf5365847bff6e48d0c6bc23eee276343d2987efd9876c3c1bf597225e3d69991")},
+		},
+	).Times(2).Return(receiptResponse, nil)
+
+	traceResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlockTrace),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethTraceBlockByHashMethod, gomock.Any(),
+	).Times(3).Return(traceResponse, nil)
+
+	var client internal.Client
+	app := testapp.New(
+		t,
+		testModule(rpcClient),
+		fx.Invoke(func(params internal.JsonrpcClientParams) {
+			client = NewEthereumClientFactory(params, WithEthereumNodeType(types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS)).Master()
+		}),
+	)
+	defer app.Close()
+	require.NotNil(client)
+
+	for i := 0; i < 2; i++ {
+		block, err := client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+		require.NoError(err)
+
+		blobdata := block.GetEthereum()
+		require.NotNil(blobdata)
+		require.Equal(2, len(blobdata.TransactionReceipts))
+		require.Equal(fixtureReceipt, string(blobdata.TransactionReceipts[0]))
+		require.Equal(fixtureReceipt, string(blobdata.TransactionReceipts[1]))
+	}
+
+	// Another endpoint may support the method, so it is attempted again once the fallback expires.
+	client.(*EthereumClient).blockReceiptsFallbackUntil.Store(time.Now().Add(-time.Second).UnixNano())
+	block, err := client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+	require.NoError(err)
+	require.Equal(2, len(block.GetEthereum().TransactionReceipts))
+	require.NotEqual(fixtureReceipt, string(block.GetEthereum().TransactionReceipts[0]))
+}
+
+func TestEthereumClient_GetBlockReceipts_UnexpectedTransactionHash(t *testing.T) {
+	require := testutil.Require(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+	blockResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlock),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockByNumberMethod, jsonrpc.Params{
+			"0xacc290",
+			true,
+		},
+	).Return(blockResponse, nil)
+
+	// The receipts are returned in a different order from the transactions.
+	var receipts []json.RawMessage
+	require.NoError(json.Unmarshal([]byte(fixtureBlockReceipts), &receipts))
+	receipts[0], receipts[1] = receipts[1], receipts[0]
+	result, err := json.Marshal(receipts)
+	require.NoError(err)
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockReceiptsMethod, gomock.Any(),
+	).Return(&jsonrpc.Response{Result: result}, nil)
+
+	var client internal.Client
+	app := testapp.New(
+		t,
+		testModule(rpcClient),
+		fx.Invoke(func(params internal.JsonrpcClientParams) {
+			client = NewEthereumClientFactory(params, WithEthereumNodeType(types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS)).Master()
+		}),
+	)
+	defer app.Close()
+	require.NotNil(client)
+
+	_, err = client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+	require.Error(err)
+	require.Contains(err.Error(), "got unexpected transaction hash in block receipts")
+}
+
+func TestEthereumClient_GetBlockReceipts_OptimismFakeReceipt(t *testing.T) {
+	require := testutil.Require(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+	blockResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlock),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockByNumberMethod, jsonrpc.Params{
+			"0xacc290",
+			true,
+		},
+	).Return(blockResponse, nil)
+
+	// The receipt of the second transaction belongs to an earlier block, which is not retried in Optimism.
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethGetBlockReceiptsMethod, gomock.Any(),
+	).Return(&jsonrpc.Response{Result: json.RawMessage(fixtureOrphanedBlockReceipts)}, nil)
+
+	traceResponse := &jsonrpc.Response{
+		Result: json.RawMessage(fixtureBlockTrace),
+	}
+	rpcClient.EXPECT().Call(
+		gomock.Any(), ethTraceBlockByHashOptimismMethod, gomock.Any(),
+	).Return(traceResponse, nil)
+
+	var client internal.Client
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_OPTIMISM, common.Network_NETWORK_OPTIMISM_MAINNET),
+		testModule(rpcClient),
+		fx.Invoke(func(params internal.JsonrpcClientParams) {
+			client = NewEthereumClientFactory(params, WithEthereumNodeType(types.EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS)).Master()
+		}),
+	)
+	defer app.Close()
+	require.NotNil(client)
+
+	block, err := client.GetBlockByHeight(context.Background(), tag, ethereumHeight)
+	require.NoError(err)
+
+	blobdata := block.GetEthereum()
+	require.NotNil(blobdata)
+	require.Equal(2, len(blobdata.TransactionReceipts))
+
+	var receipt ethereum.EthereumTransactionReceipt
+	require.NoError(json.Unmarshal(blobdata.TransactionReceipts[1], &receipt))
+	require.Equal("0xf5365847bff6e48d0c6bc23eee276343d2987efd9876c3c1bf597225e3d69991", receipt.TransactionHash.Value())
+	require.Equal(ethereumHash, receipt.BlockHash.Value())
+	require.Equal(ethereumHeight, receipt.BlockNumber.Value())
+	require.Equal(uint64(transaction_FAILED), receipt.Status.Value())
+}
+
 func TestCanReprocess(t *testing.T) {
 	tests := []struct {
 		expected bool
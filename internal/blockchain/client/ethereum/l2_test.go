@@ -0,0 +1,156 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
+	jsonrpcmocks "github.com/coinbase/chainstorage/internal/blockchain/jsonrpc/mocks"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	"github.com/coinbase/chainstorage/internal/dlq"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+)
+
+// The L2 chains below share the client of Ethereum, hence they are tested against the same fixtures.
+var l2ClientTests = []struct {
+	name       string
+	blockchain common.Blockchain
+	network    common.Network
+}{
+	{
+		name:       "zksync",
+		blockchain: common.Blockchain_BLOCKCHAIN_ZKSYNC,
+		network:    common.Network_NETWORK_ZKSYNC_MAINNET,
+	},
+	{
+		name:       "linea",
+		blockchain: common.Blockchain_BLOCKCHAIN_LINEA,
+		network:    common.Network_NETWORK_LINEA_MAINNET,
+	},
+	{
+		name:       "scroll",
+		blockchain: common.Blockchain_BLOCKCHAIN_SCROLL,
+		network:    common.Network_NETWORK_SCROLL_MAINNET,
+	},
+	{
+		name:       "blast",
+		blockchain: common.Blockchain_BLOCKCHAIN_BLAST,
+		network:    common.Network_NETWORK_BLAST_MAINNET,
+	},
+}
+
+func TestL2Client_New(t *testing.T) {
+	for _, test := range l2ClientTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			var result internal.ClientParams
+			app := testapp.New(
+				t,
+				Module,
+				internal.Module,
+				jsonrpc.Module,
+				restapi.Module,
+				testapp.WithBlockchainNetwork(test.blockchain, test.network),
+				fx.Provide(dlq.NewNop),
+				fx.Provide(parser.NewNop),
+				fx.Populate(&result),
+			)
+			defer app.Close()
+
+			require.NotNil(result.Master)
+			require.NotNil(result.Slave)
+			require.NotNil(result.Validator)
+			require.NotNil(result.Consensus)
+		})
+	}
+}
+
+func TestL2Client_GetBlockByHeight(t *testing.T) {
+	for _, test := range l2ClientTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+
+			var result internal.ClientParams
+			app := testapp.New(
+				t,
+				Module,
+				testModule(rpcClient),
+				testapp.WithBlockchainNetwork(test.blockchain, test.network),
+				fx.Populate(&result),
+			)
+			defer app.Close()
+
+			client := result.Master
+			require.NotNil(client)
+
+			blockResponse := &jsonrpc.Response{
+				Result: json.RawMessage(fixtureBlock),
+			}
+			rpcClient.EXPECT().Call(
+				gomock.Any(), gomock.Any(), gomock.Any(),
+			).
+				AnyTimes().
+				DoAndReturn(func(ctx context.Context, method *jsonrpc.RequestMethod, params jsonrpc.Params, opts ...jsonrpc.Option) (*jsonrpc.Response, error) {
+					if method == ethGetBlockByNumberMethod {
+						return blockResponse, nil
+					}
+
+					if method == ethTraceTransactionMethod {
+						opts := params[1].(map[string]string)
+						tracer := opts["tracer"]
+						if tracer == ethOpCountTracer {
+							return &jsonrpc.Response{
+								Result: []byte("123"),
+							}, nil
+						}
+
+						if tracer == ethCallTracer {
+							return &jsonrpc.Response{
+								Result: []byte(fixtureTransactionTrace),
+							}, nil
+						}
+
+						return nil, xerrors.Errorf("unknown tracer: %v", tracer)
+					}
+
+					return nil, xerrors.Errorf("unknown method: %v", method)
+				})
+
+			receiptResponse := []*jsonrpc.Response{
+				{Result: json.RawMessage(fixtureReceipt)},
+				{Result: json.RawMessage(fixtureReceipt)},
+			}
+			rpcClient.EXPECT().BatchCall(
+				gomock.Any(), ethGetTransactionReceiptMethod, gomock.Any(),
+			).Return(receiptResponse, nil)
+
+			block, err := client.GetBlockByHeight(context.Background(), tag, 11322000, internal.WithBestEffort())
+			require.NoError(err)
+			require.NotNil(block)
+			require.Equal(test.blockchain, block.Blockchain)
+			require.Equal(test.network, block.Network)
+			blobdata := block.GetEthereum()
+			require.NotNil(blobdata)
+			require.NotNil(blobdata.Header)
+			require.Equal(2, len(blobdata.TransactionReceipts))
+			require.Equal(2, len(blobdata.TransactionTraces))
+			for _, transactionTrace := range blobdata.TransactionTraces {
+				require.Equal(fixtureTransactionTrace, string(transactionTrace))
+			}
+		})
+	}
+}
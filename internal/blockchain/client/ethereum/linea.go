@@ -0,0 +1,10 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewLineaClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	// Linea shares the same data schema as Ethereum since it is an EVM chain.
+	return NewEthereumClientFactory(params)
+}
@@ -17,6 +17,10 @@ var Module = fx.Options(
 		Name:   "base",
 		Target: NewBaseClientFactory,
 	}),
+	fx.Provide(fx.Annotated{
+		Name:   "blast",
+		Target: NewBlastClientFactory,
+	}),
 	fx.Provide(fx.Annotated{
 		Name:   "bsc",
 		Target: NewBscClientFactory,
@@ -29,6 +33,10 @@ var Module = fx.Options(
 		Name:   "fantom",
 		Target: NewFantomClientFactory,
 	}),
+	fx.Provide(fx.Annotated{
+		Name:   "linea",
+		Target: NewLineaClientFactory,
+	}),
 	fx.Provide(fx.Annotated{
 		Name:   "optimism",
 		Target: NewOptimismClientFactory,
@@ -37,4 +45,12 @@ var Module = fx.Options(
 		Name:   "polygon",
 		Target: NewPolygonClientFactory,
 	}),
+	fx.Provide(fx.Annotated{
+		Name:   "scroll",
+		Target: NewScrollClientFactory,
+	}),
+	fx.Provide(fx.Annotated{
+		Name:   "zksync",
+		Target: NewZksyncClientFactory,
+	}),
 )
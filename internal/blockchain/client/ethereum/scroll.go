@@ -0,0 +1,11 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewScrollClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	// Scroll shares the same data schema as Ethereum since it is an EVM chain.
+	// The L1 data fee is reported as l1Fee in the receipts.
+	return NewEthereumClientFactory(params)
+}
@@ -0,0 +1,11 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewZksyncClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	// zkSync Era shares the same data schema as Ethereum since it is an EVM-compatible chain.
+	// The zkSync specific receipt fields, e.g. l1BatchNumber and l2ToL1Logs, are handled by the parser.
+	return NewEthereumClientFactory(params)
+}
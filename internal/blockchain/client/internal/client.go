@@ -57,7 +57,9 @@ type (
 		Parser         parser.Parser
 		Bitcoin        ClientFactory `name:"bitcoin" optional:"true"`
 		Bsc            ClientFactory `name:"bsc" optional:"true"`
+		Dogecoin       ClientFactory `name:"dogecoin" optional:"true"`
 		Ethereum       ClientFactory `name:"ethereum" optional:"true"`
+		Litecoin       ClientFactory `name:"litecoin" optional:"true"`
 		Rosetta        ClientFactory `name:"rosetta" optional:"true"`
 		Solana         ClientFactory `name:"solana" optional:"true"`
 		Polygon        ClientFactory `name:"polygon" optional:"true"`
@@ -68,6 +70,11 @@ type (
 		Fantom         ClientFactory `name:"fantom" optional:"true"`
 		Aptos          ClientFactory `name:"aptos" optional:"true"`
 		EthereumBeacon ClientFactory `name:"ethereum/beacon" optional:"true"`
+		Zksync         ClientFactory `name:"zksync" optional:"true"`
+		Linea          ClientFactory `name:"linea" optional:"true"`
+		Scroll         ClientFactory `name:"scroll" optional:"true"`
+		Blast          ClientFactory `name:"blast" optional:"true"`
+		Sui            ClientFactory `name:"sui" optional:"true"`
 		CosmosStaking  ClientFactory `name:"cosmos/staking" optional:"true"`
 		CardanoStaking ClientFactory `name:"cardano/staking" optional:"true"`
 	}
@@ -113,6 +120,15 @@ func NewClient(params Params) (Result, error) {
 		switch blockchain {
 		case common.Blockchain_BLOCKCHAIN_BITCOIN:
 			factory = params.Bitcoin
+		case common.Blockchain_BLOCKCHAIN_DOGECOIN:
+			// Dogecoin used to be ingested through Rosetta; keep using it for the networks configured with Rosetta.
+			if params.Config.IsRosetta() {
+				factory = params.Rosetta
+			} else {
+				factory = params.Dogecoin
+			}
+		case common.Blockchain_BLOCKCHAIN_LITECOIN:
+			factory = params.Litecoin
 		case common.Blockchain_BLOCKCHAIN_BSC:
 			factory = params.Bsc
 		case common.Blockchain_BLOCKCHAIN_ETHEREUM:
@@ -133,11 +149,30 @@ func NewClient(params Params) (Result, error) {
 			factory = params.Base
 		case common.Blockchain_BLOCKCHAIN_APTOS:
 			factory = params.Aptos
+		case common.Blockchain_BLOCKCHAIN_ZKSYNC:
+			factory = params.Zksync
+		case common.Blockchain_BLOCKCHAIN_LINEA:
+			factory = params.Linea
+		case common.Blockchain_BLOCKCHAIN_SCROLL:
+			factory = params.Scroll
+		case common.Blockchain_BLOCKCHAIN_BLAST:
+			factory = params.Blast
+		case common.Blockchain_BLOCKCHAIN_SUI:
+			factory = params.Sui
+		case common.Blockchain_BLOCKCHAIN_COSMOS:
+			factory = params.CosmosStaking
+		case common.Blockchain_BLOCKCHAIN_CARDANO:
+			factory = params.CardanoStaking
 		default:
 			if params.Config.IsRosetta() {
 				factory = params.Rosetta
 			}
 		}
+	} else {
+		switch sidechain {
+		case api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON, api.SideChain_SIDECHAIN_ETHEREUM_HOLESKY_BEACON:
+			factory = params.EthereumBeacon
+		}
 	}
 	if factory == nil {
 		return Result{}, xerrors.Errorf("client is not implemented: blockchain(%v)-sidechain(%v)", blockchain, sidechain)
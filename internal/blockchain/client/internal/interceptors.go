@@ -127,7 +127,7 @@ func (p *parserInterceptor) validateBlock(ctx context.Context, block *api.Block,
 	}
 
 	if p.config.Chain.Feature.BlockValidationEnabled {
-		if err := p.parser.ValidateBlock(ctx, nativeBlock); err != nil {
+		if err := p.parser.ValidateRawBlock(ctx, block, nativeBlock); err != nil {
 			p.logger.Warn("block validation failed",
 				zap.Error(err),
 				zap.Reflect("metadata", metadata),
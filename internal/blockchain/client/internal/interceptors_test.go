@@ -278,7 +278,7 @@ func TestParserInterceptor_BlockValidation_Success(t *testing.T) {
 	expectedNativeBlock := &api.NativeBlock{}
 	parser := parsermocks.NewMockParser(ctrl)
 	parser.EXPECT().ParseNativeBlock(gomock.Any(), expectedBlock).Return(expectedNativeBlock, nil).Times(2)
-	parser.EXPECT().ValidateBlock(gomock.Any(), expectedNativeBlock).Return(nil).Times(2)
+	parser.EXPECT().ValidateRawBlock(gomock.Any(), expectedBlock, expectedNativeBlock).Return(nil).Times(2)
 
 	cfg, err := config.New()
 	require.NoError(err)
@@ -339,7 +339,7 @@ func TestParserInterceptor_BlockValidation_Fail(t *testing.T) {
 	expectedNativeBlock := &api.NativeBlock{}
 	parser := parsermocks.NewMockParser(ctrl)
 	parser.EXPECT().ParseNativeBlock(gomock.Any(), expectedBlock).Return(expectedNativeBlock, nil).Times(2)
-	parser.EXPECT().ValidateBlock(gomock.Any(), expectedNativeBlock).Return(errBlockValidation).Times(2)
+	parser.EXPECT().ValidateRawBlock(gomock.Any(), expectedBlock, expectedNativeBlock).Return(errBlockValidation).Times(2)
 
 	cfg, err := config.New()
 	require.NoError(err)
@@ -387,7 +387,7 @@ func TestParserInterceptor_BlockValidation_Muted(t *testing.T) {
 	expectedNativeBlock := &api.NativeBlock{}
 	parser := parsermocks.NewMockParser(ctrl)
 	parser.EXPECT().ParseNativeBlock(gomock.Any(), expectedBlock).Return(expectedNativeBlock, nil).Times(2)
-	parser.EXPECT().ValidateBlock(gomock.Any(), expectedNativeBlock).Return(errBlockValidation).Times(2)
+	parser.EXPECT().ValidateRawBlock(gomock.Any(), expectedBlock, expectedNativeBlock).Return(errBlockValidation).Times(2)
 
 	cfg, err := config.New()
 	require.NoError(err)
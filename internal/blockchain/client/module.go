@@ -5,10 +5,13 @@ import (
 
 	"github.com/coinbase/chainstorage/internal/blockchain/client/aptos"
 	"github.com/coinbase/chainstorage/internal/blockchain/client/bitcoin"
+	"github.com/coinbase/chainstorage/internal/blockchain/client/cosmos"
 	"github.com/coinbase/chainstorage/internal/blockchain/client/ethereum"
+	"github.com/coinbase/chainstorage/internal/blockchain/client/ethereum/beacon"
 	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
 	"github.com/coinbase/chainstorage/internal/blockchain/client/rosetta"
 	"github.com/coinbase/chainstorage/internal/blockchain/client/solana"
+	"github.com/coinbase/chainstorage/internal/blockchain/client/sui"
 )
 
 type (
@@ -21,9 +24,12 @@ var Module = fx.Options(
 	internal.Module,
 	aptos.Module,
 	bitcoin.Module,
+	cosmos.Module,
 	ethereum.Module,
+	beacon.Module,
 	rosetta.Module,
 	solana.Module,
+	sui.Module,
 )
 
 var (
@@ -0,0 +1,11 @@
+package rosetta
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+)
+
+func NewCardanoClientFactory(params RosettaClientParams, restApiParams internal.RestapiClientParams) internal.ClientFactory {
+	// Cardano is ingested through cardano-rosetta, which reports the staking certificates and withdrawals as operations.
+	// The native parser relies on the rosetta responses, so the rosetta client is reused as is.
+	return NewRosettaClientFactory(params, restApiParams)
+}
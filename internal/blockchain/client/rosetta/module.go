@@ -7,4 +7,8 @@ var Module = fx.Options(
 		Name:   "rosetta",
 		Target: NewRosettaClientFactory,
 	}),
+	fx.Provide(fx.Annotated{
+		Name:   "cardano/staking",
+		Target: NewCardanoClientFactory,
+	}),
 )
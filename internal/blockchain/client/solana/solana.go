@@ -2,6 +2,7 @@ package solana
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"time"
 
@@ -28,8 +29,20 @@ type (
 	getBlockResponse struct {
 		blockMetadata       *api.BlockMetadata
 		blobData            json.RawMessage
+		rawTransactions     [][]byte
 		transactionMetadata *api.TransactionMetadata
 	}
+
+	// rawBlock is the block returned by getBlock in the base64 encoding.
+	rawBlock struct {
+		BlockHash    string           `json:"blockhash"`
+		Transactions []rawTransaction `json:"transactions"`
+	}
+
+	// rawTransaction has the base64-encoded transaction followed by the encoding, i.e. ["<data>", "base64"].
+	rawTransaction struct {
+		Transaction []string `json:"transaction"`
+	}
 )
 
 const (
@@ -57,6 +70,8 @@ const (
 	// the current version is 0. We need to bump this up when the version changes and getBlock() fails with
 	// code -32015.
 	maxSupportedTransactionVersion = 0
+
+	solanaRawTransactionEncoding = "base64"
 )
 
 var (
@@ -99,6 +114,14 @@ var (
 		"maxSupportedTransactionVersion": maxSupportedTransactionVersion,
 	}
 
+	// This configuration returns the transactions in the wire format, which are needed to verify the signatures.
+	solanaGetBlockRawConfiguration = map[string]any{
+		"encoding":                       "base64",
+		"transactionDetails":             "full",
+		"rewards":                        false,
+		"maxSupportedTransactionVersion": maxSupportedTransactionVersion,
+	}
+
 	// This map is to work around missing data in Solana's long term storage (due to solanaErrorCodeLongTermStorageSlotSkipped).
 	// NOTE: the map is currently empty because the underlying issue (https://github.com/solana-labs/solana/issues/21350) has been fixed in Solana.
 	solanaBlocksMissingInLongTermStorage = map[uint64]bool{}
@@ -209,7 +232,7 @@ func (c *solanaClientImpl) GetBlockByHeight(ctx context.Context, tag uint32, hei
 		Blockchain:          c.config.Chain.Blockchain,
 		Network:             c.config.Chain.Network,
 		Metadata:            getBlockResponse.blockMetadata,
-		Blobdata:            c.getBlobdata(getBlockResponse.blobData),
+		Blobdata:            c.getBlobdata(getBlockResponse.blobData, getBlockResponse.rawTransactions),
 		TransactionMetadata: getBlockResponse.transactionMetadata,
 	}
 	return block, nil
@@ -287,14 +310,15 @@ func (c *solanaClientImpl) isSlotSkippedError(err error) bool {
 	return rpcerr.Code == solanaErrorCodeSlotSkipped || rpcerr.Code == solanaErrorCodeLongTermStorageSlotSkipped
 }
 
-func (c *solanaClientImpl) getBlobdata(header json.RawMessage) *api.Block_Solana {
+func (c *solanaClientImpl) getBlobdata(header json.RawMessage, rawTransactions [][]byte) *api.Block_Solana {
 	if header == nil {
 		return nil
 	}
 
 	return &api.Block_Solana{
 		Solana: &api.SolanaBlobdata{
-			Header: header,
+			Header:       header,
+			Transactions: rawTransactions,
 		},
 	}
 }
@@ -338,6 +362,14 @@ func (c *solanaClientImpl) getBlock(ctx context.Context, tag uint32, height uint
 		return nil, xerrors.Errorf("failed to parse header: %w", err)
 	}
 
+	var rawTransactions [][]byte
+	if c.config.Chain.Feature.RawTransactionsEnabled {
+		rawTransactions, err = c.getRawTransactions(ctx, height, header.BlockHash, len(txnList))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get raw transactions: %w", err)
+		}
+	}
+
 	blockMetadata := &api.BlockMetadata{
 		Tag:          tag,
 		Height:       height,
@@ -354,10 +386,60 @@ func (c *solanaClientImpl) getBlock(ctx context.Context, tag uint32, height uint
 	return &getBlockResponse{
 		blockMetadata:       blockMetadata,
 		blobData:            response.Result,
+		rawTransactions:     rawTransactions,
 		transactionMetadata: transactionMetadata,
 	}, nil
 }
 
+// getRawTransactions fetches the transactions of the block in the wire format.
+// Unlike the jsonParsed encoding, the base64 encoding retains the instruction data of every instruction,
+// which is needed to rebuild the signed messages and verify the signatures.
+// Since this is a second getBlock call for the same block, it is only made if the raw_transactions_enabled feature is enabled.
+func (c *solanaClientImpl) getRawTransactions(ctx context.Context, height uint64, blockHash string, numTransactions int) ([][]byte, error) {
+	params := jsonrpc.Params{
+		height,
+		solanaGetBlockRawConfiguration,
+	}
+
+	response, err := c.client.Call(ctx, solanaMethodGetBlock, params)
+	if err != nil {
+		if c.isNotFoundError(err) {
+			return nil, internal.ErrBlockNotFound
+		}
+
+		return nil, xerrors.Errorf("failed to call jsonrpc: %w", err)
+	}
+
+	var block rawBlock
+	if err := response.Unmarshal(&block); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	if block.BlockHash != blockHash {
+		return nil, xerrors.Errorf("got unexpected block hash (expected=%v, actual=%v)", blockHash, block.BlockHash)
+	}
+
+	if len(block.Transactions) != numTransactions {
+		return nil, xerrors.Errorf("got unexpected number of transactions (expected=%v, actual=%v)", numTransactions, len(block.Transactions))
+	}
+
+	rawTransactions := make([][]byte, numTransactions)
+	for i, transaction := range block.Transactions {
+		if len(transaction.Transaction) != 2 || transaction.Transaction[1] != solanaRawTransactionEncoding {
+			return nil, xerrors.Errorf("unexpected encoding of transaction %v: %v", i, transaction.Transaction)
+		}
+
+		rawTransaction, err := base64.StdEncoding.DecodeString(transaction.Transaction[0])
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode transaction %v: %w", i, err)
+		}
+
+		rawTransactions[i] = rawTransaction
+	}
+
+	return rawTransactions, nil
+}
+
 func (c *solanaClientImpl) parseHeader(response *jsonrpc.Response) (*solana.SolanaBlockLit, []string, error) {
 	var header solana.SolanaBlockLit
 	if err := response.Unmarshal(&header); err != nil {
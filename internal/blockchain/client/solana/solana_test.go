@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcutil/base58"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/fx"
 	"go.uber.org/mock/gomock"
@@ -16,6 +17,7 @@ import (
 	jsonrpcmocks "github.com/coinbase/chainstorage/internal/blockchain/jsonrpc/mocks"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser"
 	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	"github.com/coinbase/chainstorage/internal/config"
 	"github.com/coinbase/chainstorage/internal/dlq"
 	"github.com/coinbase/chainstorage/internal/utils/fixtures"
 	"github.com/coinbase/chainstorage/internal/utils/testapp"
@@ -53,10 +55,17 @@ func (s *solanaClientTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.rpcClient = jsonrpcmocks.NewMockClient(s.ctrl)
 
+	cfg, err := config.New(
+		config.WithBlockchain(common.Blockchain_BLOCKCHAIN_SOLANA),
+		config.WithNetwork(common.Network_NETWORK_SOLANA_MAINNET),
+	)
+	s.Require().NoError(err)
+	cfg.Chain.Feature.RawTransactionsEnabled = true
+
 	var result internal.ClientParams
 	s.app = testapp.New(
 		s.T(),
-		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_SOLANA, common.Network_NETWORK_SOLANA_MAINNET),
+		testapp.WithConfig(cfg),
 		Module,
 		testModule(s.rpcClient),
 		fx.Populate(&result),
@@ -241,6 +250,7 @@ func (s *solanaClientTestSuite) TestGetBlockByHeight() {
 		},
 		gomock.Any(),
 	).AnyTimes().Return(blockResponse, nil)
+	s.expectRawBlock(solanaHeight, fixtures.MustReadFile("client/solana/block_v2_base64.json"))
 
 	block, err := s.client.GetBlockByHeight(context.Background(), solanaTag, solanaHeight)
 	require.NoError(err)
@@ -263,6 +273,98 @@ func (s *solanaClientTestSuite) TestGetBlockByHeight() {
 	require.Equal("2xRnwfAMxAvv5z2eiWC1YCR6bdcPj7ebPRTKFiFZuBHvWNc8QjM33W4Ev71T8C18g3yARJcHtMzC3VWTdASDybkU", transactions[0])
 	require.Equal("37Zd6zGdAeusPm4TQkxLh5P5jUvjmz27kgCYjx6xdF8Wh4WQG61hzkWD8uw3RcVfxk9dbWccZnUiwxJZ7oNDsuoP", transactions[3])
 	require.Equal("57VvLyUYMWpLb3n63LXNWZb1KQW1n9o6r9HGYuvi5BW7Futd9BMVwChyemyMawKUbU8n3J5vSaMwBEADgYQ29GTQ", transactions[9])
+
+	// Each raw transaction starts with the number of signatures, followed by the signatures.
+	rawTransactions := block.GetSolana().GetTransactions()
+	require.Equal(10, len(rawTransactions))
+	require.Equal(byte(1), rawTransactions[0][0])
+	require.Equal(transactions[0], base58.Encode(rawTransactions[0][1:65]))
+}
+
+func TestSolanaClient_RawTransactionsDisabled(t *testing.T) {
+	require := testutil.Require(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rpcClient := jsonrpcmocks.NewMockClient(ctrl)
+
+	var result internal.ClientParams
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_SOLANA, common.Network_NETWORK_SOLANA_MAINNET),
+		Module,
+		testModule(rpcClient),
+		fx.Populate(&result),
+	)
+	defer app.Close()
+
+	// The block is fetched with a single getBlock call in the jsonParsed encoding.
+	rpcClient.EXPECT().Call(
+		gomock.Any(),
+		solanaMethodGetBlock,
+		jsonrpc.Params{
+			solanaHeight,
+			solanaGetBlockConfiguration,
+		},
+		gomock.Any(),
+	).Times(1).Return(&jsonrpc.Response{Result: fixtures.MustReadFile("client/solana/block_v2.json")}, nil)
+
+	block, err := result.Master.GetBlockByHeight(context.Background(), solanaTag, solanaHeight)
+	require.NoError(err)
+	require.Equal(solanaHash, block.Metadata.Hash)
+	require.Less(0, len(block.GetSolana().GetHeader()))
+	require.Empty(block.GetSolana().GetTransactions())
+}
+
+func (s *solanaClientTestSuite) TestGetBlockByHeight_UnexpectedRawBlockHash() {
+	require := testutil.Require(s.T())
+
+	blockResponse := &jsonrpc.Response{
+		Result: fixtures.MustReadFile("client/solana/block_v2.json"),
+	}
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		solanaMethodGetBlock,
+		jsonrpc.Params{
+			solanaHeight,
+			solanaGetBlockConfiguration,
+		},
+		gomock.Any(),
+	).AnyTimes().Return(blockResponse, nil)
+	s.expectRawBlock(solanaHeight, fixtures.MustReadFile("client/solana/block_without_parent_hash_base64.json"))
+
+	_, err := s.client.GetBlockByHeight(context.Background(), solanaTag, solanaHeight)
+	require.Error(err)
+	require.Contains(err.Error(), "got unexpected block hash")
+}
+
+func (s *solanaClientTestSuite) TestGetBlockByHeight_UnexpectedRawTransactionEncoding() {
+	require := testutil.Require(s.T())
+
+	blockResponse := &jsonrpc.Response{
+		Result: fixtures.MustReadFile("client/solana/block_v2.json"),
+	}
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		solanaMethodGetBlock,
+		jsonrpc.Params{
+			solanaHeight,
+			solanaGetBlockConfiguration,
+		},
+		gomock.Any(),
+	).AnyTimes().Return(blockResponse, nil)
+
+	var rawBlock map[string]any
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("client/solana/block_v2_base64.json"), &rawBlock))
+	transaction := rawBlock["transactions"].([]any)[3].(map[string]any)
+	transaction["transaction"].([]any)[1] = "base58"
+	rawBlockResponse, err := json.Marshal(rawBlock)
+	require.NoError(err)
+	s.expectRawBlock(solanaHeight, rawBlockResponse)
+
+	_, err = s.client.GetBlockByHeight(context.Background(), solanaTag, solanaHeight)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected encoding of transaction 3")
 }
 
 func (s *solanaClientTestSuite) TestGetBlockByHeight_NotFound() {
@@ -331,6 +433,7 @@ func (s *solanaClientTestSuite) TestGetBlockByHeight_WithoutParentHash() {
 		},
 		gomock.Any(),
 	).AnyTimes().Return(blockResponse, nil)
+	s.expectRawBlock(height, fixtures.MustReadFile("client/solana/block_without_parent_hash_base64.json"))
 
 	block, err := s.client.GetBlockByHeight(context.Background(), solanaTag, height)
 	require.NoError(err)
@@ -361,6 +464,7 @@ func (s *solanaClientTestSuite) TestGetBlockByHash() {
 		},
 		gomock.Any(),
 	).AnyTimes().Return(blockResponse, nil)
+	s.expectRawBlock(solanaHeight, fixtures.MustReadFile("client/solana/block_v2_base64.json"))
 
 	block, err := s.client.GetBlockByHash(context.Background(), solanaTag, solanaHeight, solanaHash)
 	require.NoError(err)
@@ -391,6 +495,7 @@ func (s *solanaClientTestSuite) TestGetBlockByHash_UnexpectedHash() {
 		},
 		gomock.Any(),
 	).AnyTimes().Return(blockResponse, nil)
+	s.expectRawBlock(solanaHeight, fixtures.MustReadFile("client/solana/block_v2_base64.json"))
 
 	_, err := s.client.GetBlockByHash(context.Background(), solanaTag, solanaHeight, solanaParentHash)
 	require.Error(err)
@@ -458,6 +563,18 @@ func (s *solanaClientTestSuite) TestGetBlockByHeight_TransactionListParsing_ErrS
 	require.ErrorContains(err, "transaction id is empty")
 }
 
+func (s *solanaClientTestSuite) expectRawBlock(height uint64, result []byte) {
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		solanaMethodGetBlock,
+		jsonrpc.Params{
+			height,
+			solanaGetBlockRawConfiguration,
+		},
+		gomock.Any(),
+	).AnyTimes().Return(&jsonrpc.Response{Result: result}, nil)
+}
+
 func testModule(client *jsonrpcmocks.MockClient) fx.Option {
 	return fx.Options(
 		internal.Module,
@@ -0,0 +1,10 @@
+package sui
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(fx.Annotated{
+		Name:   "sui",
+		Target: NewClientFactory,
+	}),
+)
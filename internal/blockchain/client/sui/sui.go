@@ -0,0 +1,344 @@
+package sui
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/sui"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	"github.com/coinbase/chainstorage/internal/utils/syncgroup"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	clientImpl struct {
+		config   *config.Config
+		logger   *zap.Logger
+		client   jsonrpc.Client
+		validate *validator.Validate
+	}
+
+	transactionDigest struct {
+		Digest string `json:"digest"`
+	}
+)
+
+const (
+	// The maximum number of checkpoints returned by sui_getCheckpoints.
+	getCheckpointsMaxLimit = 100
+
+	// The maximum number of digests accepted by sui_multiGetTransactionBlocks.
+	multiGetTransactionBlocksMaxDigests = 50
+
+	// The maximum number of concurrent sui_multiGetTransactionBlocks calls for a checkpoint.
+	multiGetTransactionBlocksParallelism = 4
+)
+
+var (
+	suiMethodGetCheckpoint = &jsonrpc.RequestMethod{
+		Name:    "sui_getCheckpoint",
+		Timeout: 5 * time.Second,
+	}
+
+	suiMethodGetCheckpoints = &jsonrpc.RequestMethod{
+		Name:    "sui_getCheckpoints",
+		Timeout: 10 * time.Second,
+	}
+
+	suiMethodMultiGetTransactionBlocks = &jsonrpc.RequestMethod{
+		Name:    "sui_multiGetTransactionBlocks",
+		Timeout: 30 * time.Second,
+	}
+
+	suiMethodGetLatestCheckpointSequenceNumber = &jsonrpc.RequestMethod{
+		Name:    "sui_getLatestCheckpointSequenceNumber",
+		Timeout: 5 * time.Second,
+	}
+
+	// The input is needed for the sender and the gas data; the object changes are left out.
+	suiTransactionBlockResponseOptions = map[string]bool{
+		"showInput":          true,
+		"showEffects":        true,
+		"showEvents":         true,
+		"showBalanceChanges": true,
+	}
+
+	// The error messages returned when the checkpoint has not been produced yet.
+	// See VerifiedCheckpointNotFound and VerifiedCheckpointDigestNotFound in sui-types/src/error.rs.
+	suiCheckpointNotFoundErrors = []string{
+		"Verified checkpoint not found",
+		"Verified checkpoint digest not found",
+	}
+)
+
+var _ internal.Client = (*clientImpl)(nil)
+
+func NewClientFactory(params internal.JsonrpcClientParams) internal.ClientFactory {
+	return internal.NewJsonrpcClientFactory(params, func(client jsonrpc.Client) internal.Client {
+		logger := log.WithPackage(params.Logger)
+		return &clientImpl{
+			config:   params.Config,
+			logger:   logger,
+			client:   client,
+			validate: validator.New(),
+		}
+	})
+}
+
+func (c *clientImpl) BatchGetBlockMetadata(ctx context.Context, tag uint32, from uint64, to uint64) ([]*api.BlockMetadata, error) {
+	if from >= to {
+		return nil, xerrors.Errorf("invalid height range of [%d, %d)", from, to)
+	}
+
+	blocks := make([]*api.BlockMetadata, 0, to-from)
+	for start := from; start < to; start += getCheckpointsMaxLimit {
+		limit := to - start
+		if limit > getCheckpointsMaxLimit {
+			limit = getCheckpointsMaxLimit
+		}
+
+		// The cursor is exclusive, and the first page starts from the genesis checkpoint.
+		var cursor any
+		if start > 0 {
+			cursor = strconv.FormatUint(start-1, 10)
+		}
+
+		params := jsonrpc.Params{
+			cursor,
+			limit,
+			false,
+		}
+
+		response, err := c.client.Call(ctx, suiMethodGetCheckpoints, params)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to call jsonrpc (start=%v, limit=%v): %w", start, limit, err)
+		}
+
+		var page sui.CheckpointPage
+		if err := response.Unmarshal(&page); err != nil {
+			return nil, xerrors.Errorf("failed to unmarshal checkpoints (start=%v, limit=%v): %w", start, limit, err)
+		}
+
+		if err := c.validate.Struct(page); err != nil {
+			return nil, xerrors.Errorf("failed to validate checkpoints (start=%v, limit=%v): %w", start, limit, err)
+		}
+
+		if len(page.Data) != int(limit) {
+			return nil, xerrors.Errorf(
+				"failed to get checkpoints (start=%v, limit=%v): got unexpected number of checkpoints %v: %w",
+				start, limit, len(page.Data), internal.ErrBlockNotFound,
+			)
+		}
+
+		for i, checkpoint := range page.Data {
+			height := start + uint64(i)
+			if checkpoint.SequenceNumber.Value() != height {
+				return nil, xerrors.Errorf("got unexpected height (expected=%v, actual=%v)", height, checkpoint.SequenceNumber.Value())
+			}
+
+			blocks = append(blocks, c.getBlockMetadata(tag, checkpoint))
+		}
+	}
+
+	return blocks, nil
+}
+
+func (c *clientImpl) GetBlockByHeight(ctx context.Context, tag uint32, height uint64, _ ...internal.ClientOption) (*api.Block, error) {
+	block, err := c.getBlock(ctx, tag, strconv.FormatUint(height, 10))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block (height=%v): %w", height, err)
+	}
+
+	if block.Metadata.Height != height {
+		return nil, xerrors.Errorf("got unexpected height (expected=%v, actual=%v)", height, block.Metadata.Height)
+	}
+
+	return block, nil
+}
+
+func (c *clientImpl) GetBlockByHash(ctx context.Context, tag uint32, height uint64, hash string, _ ...internal.ClientOption) (*api.Block, error) {
+	// sui_getCheckpoint accepts either the sequence number or the digest.
+	block, err := c.getBlock(ctx, tag, hash)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block (height=%v, hash=%v): %w", height, hash, err)
+	}
+
+	if block.Metadata.Height != height {
+		return nil, xerrors.Errorf("failed to get block by hash: got unexpected height (expected=%v, actual=%v)", height, block.Metadata.Height)
+	}
+
+	return block, nil
+}
+
+func (c *clientImpl) GetLatestHeight(ctx context.Context) (uint64, error) {
+	response, err := c.client.Call(ctx, suiMethodGetLatestCheckpointSequenceNumber, nil)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to call jsonrpc: %w", err)
+	}
+
+	var sequenceNumber sui.BigInt
+	if err := response.Unmarshal(&sequenceNumber); err != nil {
+		return 0, xerrors.Errorf("failed to unmarshal sequence number: %w", err)
+	}
+
+	return sequenceNumber.Value(), nil
+}
+
+func (c *clientImpl) UpgradeBlock(_ context.Context, _ *api.Block, _ uint32) (*api.Block, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (c *clientImpl) CanReprocess(_ uint32, _ uint64) bool {
+	return false
+}
+
+func (c *clientImpl) GetAccountProof(_ context.Context, _ *api.GetVerifiedAccountStateRequest) (*api.GetAccountProofResponse, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+// getBlock fetches the checkpoint by either its sequence number or its digest, followed by its transactions.
+func (c *clientImpl) getBlock(ctx context.Context, tag uint32, id string) (*api.Block, error) {
+	response, err := c.client.Call(ctx, suiMethodGetCheckpoint, jsonrpc.Params{id})
+	if err != nil {
+		if isCheckpointNotFoundError(err) {
+			return nil, xerrors.Errorf("failed to get checkpoint: %v: %w", err, internal.ErrBlockNotFound)
+		}
+
+		return nil, xerrors.Errorf("failed to call jsonrpc: %w", err)
+	}
+
+	var checkpoint sui.Checkpoint
+	if err := response.Unmarshal(&checkpoint); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+
+	if err := c.validate.Struct(checkpoint); err != nil {
+		return nil, xerrors.Errorf("failed to validate checkpoint: %w", err)
+	}
+
+	transactions, err := c.getTransactions(ctx, checkpoint.Transactions)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get transactions (checkpoint=%v): %w", checkpoint.SequenceNumber.Value(), err)
+	}
+
+	return &api.Block{
+		Blockchain: c.config.Chain.Blockchain,
+		Network:    c.config.Chain.Network,
+		SideChain:  c.config.Chain.Sidechain,
+		Metadata:   c.getBlockMetadata(tag, &checkpoint),
+		Blobdata: &api.Block_Sui{
+			Sui: &api.SuiBlobdata{
+				Checkpoint:   response.Result,
+				Transactions: transactions,
+			},
+		},
+	}, nil
+}
+
+// getTransactions fetches the transactions in batches and returns them in the same order as the digests.
+func (c *clientImpl) getTransactions(ctx context.Context, digests []string) ([][]byte, error) {
+	transactions := make([][]byte, len(digests))
+	group, ctx := syncgroup.New(ctx, syncgroup.WithThrottling(multiGetTransactionBlocksParallelism))
+	for i := 0; i < len(digests); i += multiGetTransactionBlocksMaxDigests {
+		batchStart := i
+		batchEnd := batchStart + multiGetTransactionBlocksMaxDigests
+		if batchEnd > len(digests) {
+			batchEnd = len(digests)
+		}
+
+		group.Go(func() error {
+			batch, err := c.multiGetTransactionBlocks(ctx, digests[batchStart:batchEnd])
+			if err != nil {
+				return xerrors.Errorf("failed to get transactions in batch (batchStart=%v, batchEnd=%v): %w", batchStart, batchEnd, err)
+			}
+
+			copy(transactions[batchStart:batchEnd], batch)
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, xerrors.Errorf("failed to finish group: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (c *clientImpl) multiGetTransactionBlocks(ctx context.Context, digests []string) ([][]byte, error) {
+	params := jsonrpc.Params{
+		digests,
+		suiTransactionBlockResponseOptions,
+	}
+
+	response, err := c.client.Call(ctx, suiMethodMultiGetTransactionBlocks, params)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to call jsonrpc: %w", err)
+	}
+
+	var results []json.RawMessage
+	if err := response.Unmarshal(&results); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal transactions: %w", err)
+	}
+
+	if len(results) != len(digests) {
+		return nil, xerrors.Errorf("got unexpected number of transactions (expected=%v, actual=%v)", len(digests), len(results))
+	}
+
+	transactions := make([][]byte, len(results))
+	for i, result := range results {
+		var tx transactionDigest
+		if err := json.Unmarshal(result, &tx); err != nil {
+			return nil, xerrors.Errorf("failed to unmarshal transaction digest: %w", err)
+		}
+
+		if tx.Digest != digests[i] {
+			return nil, xerrors.Errorf("got unexpected transaction digest (expected=%v, actual=%v)", digests[i], tx.Digest)
+		}
+
+		transactions[i] = result
+	}
+
+	return transactions, nil
+}
+
+func (c *clientImpl) getBlockMetadata(tag uint32, checkpoint *sui.Checkpoint) *api.BlockMetadata {
+	height := checkpoint.SequenceNumber.Value()
+	var parentHeight uint64
+	if height > 0 {
+		parentHeight = height - 1
+	}
+
+	return &api.BlockMetadata{
+		Tag:          tag,
+		Height:       height,
+		ParentHeight: parentHeight,
+		Hash:         checkpoint.Digest,
+		ParentHash:   checkpoint.PreviousDigest,
+		Timestamp:    checkpoint.GetTimestamp(),
+	}
+}
+
+func isCheckpointNotFoundError(err error) bool {
+	var rpcerr *jsonrpc.RPCError
+	if !xerrors.As(err, &rpcerr) {
+		return false
+	}
+
+	for _, message := range suiCheckpointNotFoundErrors {
+		if strings.Contains(rpcerr.Message, message) {
+			return true
+		}
+	}
+
+	return false
+}
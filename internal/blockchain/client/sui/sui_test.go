@@ -0,0 +1,305 @@
+package sui
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/client/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
+	jsonrpcmocks "github.com/coinbase/chainstorage/internal/blockchain/jsonrpc/mocks"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	"github.com/coinbase/chainstorage/internal/blockchain/restapi"
+	"github.com/coinbase/chainstorage/internal/dlq"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type suiClientTestSuite struct {
+	suite.Suite
+
+	ctrl      *gomock.Controller
+	app       testapp.TestApp
+	rpcClient *jsonrpcmocks.MockClient
+	client    internal.Client
+}
+
+// The Sui fixtures are NOT mainnet data: the checkpoint and its transactions were written by hand in the shape of the
+// JSON-RPC responses, so the digests, signatures and amounts are not real. They only exercise the decoding and the
+// consistency checks, and should be replaced with real sui_getCheckpoint and sui_multiGetTransactionBlocks responses.
+const (
+	suiTag           = uint32(1)
+	suiHeight        = uint64(50000001)
+	suiParentHeight  = uint64(50000000)
+	suiHash          = "4G1Zz82Vbq3tLXVrmtrDALCa4U1k4bauYLGnfLhvDzvY"
+	suiParentHash    = "H2ZwmDyVHXx9d8JFZVpooTHKmuGRew5KGVzFLLq7mN7Q"
+	suiNextHash      = "HkLqAedPxao9nPjqwpu6UhJ6uuFfNgjt7GoiCgMVTvqb"
+	suiTimestamp     = "2024-01-10T08:50:12.345Z"
+	suiNextTimestamp = "2024-01-10T08:50:12.596Z"
+)
+
+var suiTransactionDigests = []string{
+	"B5mPBoD75UTsWp3N5v9hsJZLKC7MuWUfuqcqtSGM9tYd",
+	"8aa3PPhXJkUJzgYy7wLaTsnKG5hGbyt6kyGPFkq2ev5g",
+	"3gKu6EJAgtcudQtcfASjvPnfyETmjqnmcLEALrreFSsk",
+}
+
+func TestSuiClientTestSuite(t *testing.T) {
+	suite.Run(t, new(suiClientTestSuite))
+}
+
+func (s *suiClientTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.rpcClient = jsonrpcmocks.NewMockClient(s.ctrl)
+
+	var result internal.ClientParams
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_SUI, common.Network_NETWORK_SUI_MAINNET),
+		Module,
+		testModule(s.rpcClient),
+		fx.Populate(&result),
+	)
+
+	s.client = result.Master
+	s.NotNil(s.client)
+}
+
+func (s *suiClientTestSuite) TearDownTest() {
+	s.app.Close()
+	s.ctrl.Finish()
+}
+
+func (s *suiClientTestSuite) TestBatchGetBlockMetadata() {
+	require := testutil.Require(s.T())
+
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodGetCheckpoints,
+		jsonrpc.Params{"50000000", uint64(2), false},
+	).Return(&jsonrpc.Response{
+		Result: fixtures.MustReadFile("client/sui/synthetic_checkpoints.json"),
+	}, nil)
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), suiTag, suiHeight, suiHeight+2)
+	require.NoError(err)
+	require.Equal([]*api.BlockMetadata{
+		{
+			Tag:          suiTag,
+			Height:       suiHeight,
+			ParentHeight: suiParentHeight,
+			Hash:         suiHash,
+			ParentHash:   suiParentHash,
+			Timestamp:    testutil.MustTimestamp(suiTimestamp),
+		},
+		{
+			Tag:          suiTag,
+			Height:       suiHeight + 1,
+			ParentHeight: suiHeight,
+			Hash:         suiNextHash,
+			ParentHash:   suiHash,
+			Timestamp:    testutil.MustTimestamp(suiNextTimestamp),
+		},
+	}, blocks)
+}
+
+func (s *suiClientTestSuite) TestBatchGetBlockMetadata_Incomplete() {
+	require := testutil.Require(s.T())
+
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodGetCheckpoints,
+		jsonrpc.Params{"50000000", uint64(3), false},
+	).Return(&jsonrpc.Response{
+		Result: fixtures.MustReadFile("client/sui/synthetic_checkpoints.json"),
+	}, nil)
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), suiTag, suiHeight, suiHeight+3)
+	require.Error(err)
+	require.True(xerrors.Is(err, internal.ErrBlockNotFound))
+	require.Nil(blocks)
+}
+
+func (s *suiClientTestSuite) TestBatchGetBlockMetadata_FromGenesis() {
+	require := testutil.Require(s.T())
+
+	// The first page is requested without a cursor.
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodGetCheckpoints,
+		jsonrpc.Params{nil, uint64(2), false},
+	).Return(&jsonrpc.Response{
+		Result: json.RawMessage(`{"data":[],"nextCursor":null,"hasNextPage":false}`),
+	}, nil)
+
+	blocks, err := s.client.BatchGetBlockMetadata(context.Background(), suiTag, 0, 2)
+	require.Error(err)
+	require.True(xerrors.Is(err, internal.ErrBlockNotFound))
+	require.Nil(blocks)
+}
+
+func (s *suiClientTestSuite) TestGetBlockByHeight() {
+	require := testutil.Require(s.T())
+
+	checkpoint := fixtures.MustReadFile("client/sui/synthetic_checkpoint.json")
+	s.expectCheckpoint("50000001", checkpoint, nil)
+	s.expectTransactions()
+
+	block, err := s.client.GetBlockByHeight(context.Background(), suiTag, suiHeight)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_SUI, block.Blockchain)
+	require.Equal(common.Network_NETWORK_SUI_MAINNET, block.Network)
+	require.Equal(&api.BlockMetadata{
+		Tag:          suiTag,
+		Height:       suiHeight,
+		ParentHeight: suiParentHeight,
+		Hash:         suiHash,
+		ParentHash:   suiParentHash,
+		Timestamp:    testutil.MustTimestamp(suiTimestamp),
+	}, block.Metadata)
+
+	blobdata := block.GetSui()
+	require.NotNil(blobdata)
+	require.Equal(checkpoint, blobdata.Checkpoint)
+	require.Equal(3, len(blobdata.Transactions))
+	for i, data := range blobdata.Transactions {
+		var tx struct {
+			Digest string `json:"digest"`
+		}
+		require.NoError(json.Unmarshal(data, &tx))
+		require.Equal(suiTransactionDigests[i], tx.Digest)
+	}
+}
+
+func (s *suiClientTestSuite) TestGetBlockByHeight_NotFound() {
+	require := testutil.Require(s.T())
+
+	s.expectCheckpoint("50000001", nil, &jsonrpc.RPCError{
+		Code:    -32602,
+		Message: "Verified checkpoint not found for sequence number: 50000001",
+	})
+
+	block, err := s.client.GetBlockByHeight(context.Background(), suiTag, suiHeight)
+	require.Error(err)
+	require.True(xerrors.Is(err, internal.ErrBlockNotFound), err.Error())
+	require.Nil(block)
+}
+
+func (s *suiClientTestSuite) TestGetBlockByHeight_UnexpectedTransaction() {
+	require := testutil.Require(s.T())
+
+	s.expectCheckpoint("50000001", fixtures.MustReadFile("client/sui/synthetic_checkpoint.json"), nil)
+
+	var transactions []json.RawMessage
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("client/sui/synthetic_transactions.json"), &transactions))
+	transactions[1], transactions[2] = transactions[2], transactions[1]
+	result, err := json.Marshal(transactions)
+	require.NoError(err)
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodMultiGetTransactionBlocks,
+		jsonrpc.Params{suiTransactionDigests, suiTransactionBlockResponseOptions},
+	).Return(&jsonrpc.Response{Result: result}, nil)
+
+	block, err := s.client.GetBlockByHeight(context.Background(), suiTag, suiHeight)
+	require.Error(err)
+	require.Contains(err.Error(), "got unexpected transaction digest")
+	require.Nil(block)
+}
+
+func (s *suiClientTestSuite) TestGetBlockByHash() {
+	require := testutil.Require(s.T())
+
+	s.expectCheckpoint(suiHash, fixtures.MustReadFile("client/sui/synthetic_checkpoint.json"), nil)
+	s.expectTransactions()
+
+	block, err := s.client.GetBlockByHash(context.Background(), suiTag, suiHeight, suiHash)
+	require.NoError(err)
+	require.Equal(suiHash, block.Metadata.Hash)
+	require.Equal(suiHeight, block.Metadata.Height)
+	require.NotNil(block.GetSui())
+}
+
+func (s *suiClientTestSuite) TestGetBlockByHash_UnexpectedHeight() {
+	require := testutil.Require(s.T())
+
+	s.expectCheckpoint(suiHash, fixtures.MustReadFile("client/sui/synthetic_checkpoint.json"), nil)
+	s.expectTransactions()
+
+	block, err := s.client.GetBlockByHash(context.Background(), suiTag, suiHeight+1, suiHash)
+	require.Error(err)
+	require.Contains(err.Error(), "got unexpected height")
+	require.Nil(block)
+}
+
+func (s *suiClientTestSuite) TestGetLatestHeight() {
+	require := testutil.Require(s.T())
+
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodGetLatestCheckpointSequenceNumber,
+		nil,
+	).Return(&jsonrpc.Response{
+		Result: json.RawMessage(`"50000100"`),
+	}, nil)
+
+	height, err := s.client.GetLatestHeight(context.Background())
+	require.NoError(err)
+	require.Equal(uint64(50000100), height)
+}
+
+func (s *suiClientTestSuite) expectCheckpoint(id string, result []byte, err error) {
+	var response *jsonrpc.Response
+	if result != nil {
+		response = &jsonrpc.Response{Result: result}
+	}
+
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodGetCheckpoint,
+		jsonrpc.Params{id},
+	).Return(response, err)
+}
+
+func (s *suiClientTestSuite) expectTransactions() {
+	s.rpcClient.EXPECT().Call(
+		gomock.Any(),
+		suiMethodMultiGetTransactionBlocks,
+		jsonrpc.Params{suiTransactionDigests, suiTransactionBlockResponseOptions},
+	).Return(&jsonrpc.Response{
+		Result: fixtures.MustReadFile("client/sui/synthetic_transactions.json"),
+	}, nil)
+}
+
+func testModule(client *jsonrpcmocks.MockClient) fx.Option {
+	return fx.Options(
+		internal.Module,
+		restapi.Module,
+		fx.Provide(fx.Annotated{
+			Name:   "master",
+			Target: func() jsonrpc.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "slave",
+			Target: func() jsonrpc.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "validator",
+			Target: func() jsonrpc.Client { return client },
+		}),
+		fx.Provide(fx.Annotated{
+			Name:   "consensus",
+			Target: func() jsonrpc.Client { return client },
+		}),
+		fx.Provide(dlq.NewNop),
+		fx.Provide(parser.NewNop),
+	)
+}
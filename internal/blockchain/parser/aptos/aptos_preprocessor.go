@@ -0,0 +1,130 @@
+package aptos
+
+import (
+	"context"
+	"math/big"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+	rosetta "github.com/coinbase/chainstorage/protos/coinbase/crypto/rosetta/types"
+)
+
+type (
+	aptosPreProcessor   struct{}
+	aptosRosettaChecker struct{}
+)
+
+func NewAptosChecker(params internal.ParserParams) (internal.Checker, error) {
+	return internal.NewChecker(params, &aptosPreProcessor{}, &aptosRosettaChecker{})
+}
+
+func (p *aptosPreProcessor) PreProcessNativeBlock(expected, actual *api.NativeBlock) error {
+	return nil
+}
+
+func (c *aptosRosettaChecker) ValidateRosettaBlock(ctx context.Context, req *api.ValidateRosettaBlockRequest, actualRosettaBlock *api.RosettaBlock) error {
+	nativeBlock := req.GetNativeBlock()
+	if nativeBlock == nil {
+		return xerrors.New("native block not set")
+	}
+
+	// validate block metadata
+	if nativeBlock.Hash != actualRosettaBlock.Block.BlockIdentifier.Hash {
+		return xerrors.Errorf("block hash mismatch, expected=%s, actual=%s", nativeBlock.Hash, actualRosettaBlock.Block.BlockIdentifier.Hash)
+	}
+
+	if nativeBlock.Height != uint64(actualRosettaBlock.Block.BlockIdentifier.Index) {
+		return xerrors.Errorf("block height mismatch, expected=%d, actual=%d", nativeBlock.Height, actualRosettaBlock.Block.BlockIdentifier.Index)
+	}
+
+	if nativeBlock.ParentHash != actualRosettaBlock.Block.ParentBlockIdentifier.Hash {
+		return xerrors.Errorf("block parent hash mismatch, expected=%s, actual=%s", nativeBlock.ParentHash, actualRosettaBlock.Block.ParentBlockIdentifier.Hash)
+	}
+
+	if nativeBlock.ParentHeight != uint64(actualRosettaBlock.Block.ParentBlockIdentifier.Index) {
+		return xerrors.Errorf("block parent height mismatch, expected=%d, actual=%d", nativeBlock.ParentHeight, actualRosettaBlock.Block.ParentBlockIdentifier.Index)
+	}
+
+	if !nativeBlock.Timestamp.AsTime().Equal(actualRosettaBlock.Block.Timestamp.AsTime()) {
+		return xerrors.Errorf("block timestamp mismatch, expected=%s, actual=%s", nativeBlock.Timestamp.AsTime(), actualRosettaBlock.Block.Timestamp.AsTime())
+	}
+
+	// validate transactions
+	rosettaTxs := actualRosettaBlock.GetBlock().GetTransactions()
+	nativeTxs := nativeBlock.GetAptos().GetTransactions()
+	if len(rosettaTxs) != len(nativeTxs) {
+		return xerrors.Errorf("block mismatching number of transactions, expected=%d, actual=%d", len(nativeTxs), len(rosettaTxs))
+	}
+
+	for i := 0; i < len(nativeTxs); i++ {
+		if err := c.validateRosettaTransaction(nativeTxs[i], rosettaTxs[i]); err != nil {
+			return xerrors.Errorf("failed to validate rosetta transaction(tx=%s): %w", nativeTxs[i].GetInfo().GetHash(), err)
+		}
+	}
+
+	return nil
+}
+
+func (c *aptosRosettaChecker) validateRosettaTransaction(
+	nativeTx *api.AptosTransaction,
+	rosettaTx *rosetta.Transaction,
+) error {
+	if nativeTx.GetInfo().GetHash() != rosettaTx.GetTransactionIdentifier().GetHash() {
+		return xerrors.Errorf("transaction hash mismatch, expected=%s, actual=%s", nativeTx.GetInfo().GetHash(), rosettaTx.GetTransactionIdentifier().GetHash())
+	}
+
+	// The total fee charged from the fee payer should match the gas used by the transaction.
+	expectedFee := big.NewInt(0)
+	var feePayer string
+	if user := nativeTx.GetUser(); user != nil {
+		request := user.GetRequest()
+		feePayer = getFeePayer(request)
+		expectedFee.Mul(new(big.Int).SetUint64(nativeTx.GetInfo().GetGasUsed()), new(big.Int).SetUint64(request.GetGasUnitPrice()))
+	}
+
+	actualFee := big.NewInt(0)
+	for i, op := range rosettaTx.GetOperations() {
+		if op.OperationIdentifier.Index != int64(i) {
+			return xerrors.Errorf("invalid transaction operation index, expected=%d, actual=%d", i, op.OperationIdentifier.Index)
+		}
+
+		if len(op.GetAccount().GetAddress()) == 0 {
+			return xerrors.New("invalid account address")
+		}
+
+		amount, ok := new(big.Int).SetString(op.GetAmount().GetValue(), 10)
+		if !ok {
+			return xerrors.Errorf("invalid amount=%s", op.GetAmount().GetValue())
+		}
+
+		switch op.Type {
+		case OpTypeFee:
+			if op.Account.Address != feePayer {
+				return xerrors.Errorf("fee operation account mismatch, expected=%s, actual=%s", feePayer, op.Account.Address)
+			}
+
+			// The refund of the storage fee is not part of the gas fee.
+			if amount.Sign() < 0 {
+				actualFee.Sub(actualFee, amount)
+			}
+		case OpTypeWithdraw:
+			if amount.Sign() > 0 {
+				return xerrors.Errorf("invalid withdraw amount=%s", op.Amount.Value)
+			}
+		case OpTypeDeposit:
+			if amount.Sign() < 0 {
+				return xerrors.Errorf("invalid deposit amount=%s", op.Amount.Value)
+			}
+		default:
+			return xerrors.Errorf("unknown operation type=%s", op.Type)
+		}
+	}
+
+	if expectedFee.Cmp(actualFee) != 0 {
+		return xerrors.Errorf("fee mismatch, expected=%d, actual=%d", expectedFee, actualFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package aptos
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/fx"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+func TestAptosRosettaChecker_Success(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newAptosParser(t)
+	ctx := context.Background()
+
+	block := newAptosRosettaBlock()
+	nativeBlock, err := parser.ParseNativeBlock(ctx, block)
+	require.NoError(err)
+	rosettaBlock, err := parser.ParseRosettaBlock(ctx, block)
+	require.NoError(err)
+
+	err = parser.ValidateRosettaBlock(ctx, &api.ValidateRosettaBlockRequest{NativeBlock: nativeBlock}, rosettaBlock)
+	require.NoError(err)
+}
+
+func TestAptosRosettaChecker_Failure(t *testing.T) {
+	parser := newAptosParser(t)
+	ctx := context.Background()
+
+	block := newAptosRosettaBlock()
+	tests := []struct {
+		name   string
+		mutate func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock)
+	}{
+		{
+			name: "blockHash",
+			mutate: func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock) {
+				rosettaBlock.Block.BlockIdentifier.Hash = "0x0"
+			},
+		},
+		{
+			name: "missingTransaction",
+			mutate: func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock) {
+				rosettaBlock.Block.Transactions = rosettaBlock.Block.Transactions[1:]
+			},
+		},
+		{
+			name: "gasUsed",
+			mutate: func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock) {
+				nativeBlock.GetAptos().Transactions[1].Info.GasUsed += 1
+			},
+		},
+		{
+			name: "feePayer",
+			mutate: func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock) {
+				rosettaBlock.Block.Transactions[1].Operations[0].Account.Address = aptosReceiver
+			},
+		},
+		{
+			name: "withdrawAmount",
+			mutate: func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock) {
+				rosettaBlock.Block.Transactions[1].Operations[1].Amount.Value = "100000000"
+			},
+		},
+		{
+			name: "operationIndex",
+			mutate: func(nativeBlock *api.NativeBlock, rosettaBlock *api.RosettaBlock) {
+				rosettaBlock.Block.Transactions[1].Operations[2].OperationIdentifier.Index = 1
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			rosettaBlock, err := parser.ParseRosettaBlock(ctx, block)
+			require.NoError(err)
+			expected, err := parser.ParseNativeBlock(ctx, block)
+			require.NoError(err)
+
+			test.mutate(expected, rosettaBlock)
+			err = parser.ValidateRosettaBlock(ctx, &api.ValidateRosettaBlockRequest{NativeBlock: expected}, rosettaBlock)
+			require.Error(err)
+		})
+	}
+}
+
+func TestAptosChecker_CompareNativeBlocks(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newAptosParser(t)
+	ctx := context.Background()
+
+	expected, err := parser.ParseNativeBlock(ctx, newAptosRosettaBlock())
+	require.NoError(err)
+	actual, err := parser.ParseNativeBlock(ctx, newAptosRosettaBlock())
+	require.NoError(err)
+
+	err = parser.CompareNativeBlocks(ctx, aptosRosettaHeight, expected, actual)
+	require.NoError(err)
+
+	actual.GetAptos().Transactions[1].Info.GasUsed += 1
+	err = parser.CompareNativeBlocks(ctx, aptosRosettaHeight, expected, actual)
+	require.Error(err)
+	var parityErr *internal.ParityCheckFailedError
+	require.True(xerrors.As(err, &parityErr))
+}
+
+func newAptosParser(t *testing.T) internal.Parser {
+	var parser internal.Parser
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_APTOS, common.Network_NETWORK_APTOS_MAINNET),
+		Module,
+		internal.Module,
+		fx.Populate(&parser),
+	)
+	t.Cleanup(app.Close)
+	require := testutil.Require(t)
+	require.NotNil(parser)
+	return parser
+}
@@ -0,0 +1,485 @@
+package aptos
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+	rosetta "github.com/coinbase/chainstorage/protos/coinbase/crypto/rosetta/types"
+)
+
+const (
+	OpStatusFailure = "FAILURE"
+	OpStatusSuccess = "SUCCESS"
+
+	OpTypeFee      = "FEE"
+	OpTypeWithdraw = "WITHDRAW"
+	OpTypeDeposit  = "DEPOSIT"
+
+	NativeSymbol          = "APT"
+	UnknownCurrencySymbol = "UNKNOWN_CURRENCY"
+
+	ContractAddressAmountMetadataKey = "contract_address"
+
+	// The native coin can be either held in a coin store, or in a fungible store with the metadata at 0xa.
+	nativeCoinType              = "0x1::aptos_coin::AptosCoin"
+	nativeFungibleAssetMetadata = "0xa"
+
+	// The events emitted by the coin store of an account. The coin type is defined by the coin store owning the event handle.
+	coinWithdrawEventType = "0x1::coin::WithdrawEvent"
+	coinDepositEventType  = "0x1::coin::DepositEvent"
+
+	// The module events of the coin module, which replace the event handles of the coin store.
+	coinWithdrawModuleEventType = "0x1::coin::CoinWithdraw"
+	coinDepositModuleEventType  = "0x1::coin::CoinDeposit"
+
+	// The events emitted by a fungible store. The owner and the metadata are defined by the store object.
+	fungibleAssetWithdrawEventType = "0x1::fungible_asset::Withdraw"
+	fungibleAssetDepositEventType  = "0x1::fungible_asset::Deposit"
+
+	feeStatementEventType = "0x1::transaction_fee::FeeStatement"
+
+	coinStoreResourcePrefix = "0x1::coin::CoinStore<"
+	fungibleStoreResource   = "0x1::fungible_asset::FungibleStore"
+	objectCoreResource      = "0x1::object::ObjectCore"
+)
+
+type (
+	aptosRosettaParserImpl struct {
+		logger       *zap.Logger
+		config       *config.Config
+		nativeParser internal.NativeParser
+	}
+
+	// aptosWriteSet indexes the resources written by a transaction,
+	// which are used to find the account and the currency of the balance-changing events.
+	aptosWriteSet struct {
+		coinTypes     map[aptosEventKey]string
+		storeMetadata map[string]string
+		objectOwners  map[string]string
+	}
+
+	aptosEventKey struct {
+		accountAddress string
+		creationNumber uint64
+	}
+
+	AptosEventHandle struct {
+		Guid struct {
+			Id struct {
+				Addr        string        `json:"addr"`
+				CreationNum AptosQuantity `json:"creation_num"`
+			} `json:"id"`
+		} `json:"guid"`
+	}
+
+	AptosCoinStore struct {
+		DepositEvents  AptosEventHandle `json:"deposit_events"`
+		WithdrawEvents AptosEventHandle `json:"withdraw_events"`
+	}
+
+	AptosFungibleStore struct {
+		Metadata struct {
+			Inner string `json:"inner"`
+		} `json:"metadata"`
+	}
+
+	AptosObjectCore struct {
+		Owner string `json:"owner"`
+	}
+
+	AptosCoinEvent struct {
+		Amount AptosQuantity `json:"amount"`
+	}
+
+	AptosCoinModuleEvent struct {
+		Account  string        `json:"account"`
+		Amount   AptosQuantity `json:"amount"`
+		CoinType string        `json:"coin_type"`
+	}
+
+	AptosFungibleAssetEvent struct {
+		Store  string        `json:"store"`
+		Amount AptosQuantity `json:"amount"`
+	}
+
+	AptosFeeStatement struct {
+		StorageFeeRefundOctas AptosQuantity `json:"storage_fee_refund_octas"`
+	}
+)
+
+var (
+	nativeRosettaCurrency = rosetta.Currency{
+		Symbol:   NativeSymbol,
+		Decimals: 8, // 1 APT = 10^8 Octas
+	}
+)
+
+func NewAptosRosettaParser(
+	params internal.ParserParams,
+	nativeParser internal.NativeParser,
+	opts ...internal.ParserFactoryOption,
+) (internal.RosettaParser, error) {
+	return &aptosRosettaParserImpl{
+		logger:       log.WithPackage(params.Logger),
+		config:       params.Config,
+		nativeParser: nativeParser,
+	}, nil
+}
+
+func (p *aptosRosettaParserImpl) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.RosettaBlock, error) {
+	metadata := rawBlock.GetMetadata()
+	if metadata == nil {
+		return nil, xerrors.New("metadata not found")
+	}
+
+	nativeBlock, err := p.nativeParser.ParseBlock(ctx, rawBlock)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse block into native format: %w", err)
+	}
+
+	block := nativeBlock.GetAptos()
+	if block == nil {
+		return nil, xerrors.New("failed to find aptos block")
+	}
+
+	blockIdentifier := &rosetta.BlockIdentifier{
+		Index: int64(metadata.GetHeight()),
+		Hash:  metadata.GetHash(),
+	}
+
+	parentBlockIdentifier := &rosetta.BlockIdentifier{
+		Index: int64(metadata.GetParentHeight()),
+		Hash:  metadata.GetParentHash(),
+	}
+
+	transactions, err := p.getRosettaTransactions(block)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse block transactions: %w", err)
+	}
+
+	return &api.RosettaBlock{
+		Block: &rosetta.Block{
+			BlockIdentifier:       blockIdentifier,
+			ParentBlockIdentifier: parentBlockIdentifier,
+			Timestamp:             block.GetHeader().GetBlockTime(),
+			Transactions:          transactions,
+		},
+	}, nil
+}
+
+func (p *aptosRosettaParserImpl) getRosettaTransactions(block *api.AptosBlock) ([]*rosetta.Transaction, error) {
+	rosettaTransactions := make([]*rosetta.Transaction, len(block.GetTransactions()))
+	for i, tx := range block.GetTransactions() {
+		var ops []*rosetta.Operation
+		status := OpStatusSuccess
+		if !tx.GetInfo().GetSuccess() {
+			status = OpStatusFailure
+		}
+
+		// Block metadata and state checkpoint transactions do not change any balance.
+		var events []*api.AptosEvent
+		switch txnData := tx.GetTxnData().(type) {
+		case *api.AptosTransaction_User:
+			feeOps, err := p.feeOps(txnData.User, tx.GetInfo())
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse fee operations for tx=%v: %w", tx.GetInfo().GetHash(), err)
+			}
+
+			ops = append(ops, feeOps...)
+			events = txnData.User.GetEvents()
+		case *api.AptosTransaction_Genesis:
+			events = txnData.Genesis.GetEvents()
+		}
+
+		if len(events) > 0 {
+			writeSet, err := p.parseWriteSet(tx.GetInfo().GetChanges())
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse write set for tx=%v: %w", tx.GetInfo().GetHash(), err)
+			}
+
+			eventOps, err := p.eventOps(events, writeSet, status, len(ops))
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse event operations for tx=%v: %w", tx.GetInfo().GetHash(), err)
+			}
+
+			ops = append(ops, eventOps...)
+		}
+
+		rosettaTransactions[i] = &rosetta.Transaction{
+			TransactionIdentifier: &rosetta.TransactionIdentifier{
+				Hash: tx.GetInfo().GetHash(),
+			},
+			Operations: ops,
+		}
+	}
+
+	return rosettaTransactions, nil
+}
+
+// feeOps returns the gas fee charged from the fee payer, which is the sender unless a fee payer signature is provided.
+// The gas fee is charged no matter whether the transaction is successful or not.
+func (p *aptosRosettaParserImpl) feeOps(tx *api.AptosUserTransaction, info *api.AptosTransactionInfo) ([]*rosetta.Operation, error) {
+	request := tx.GetRequest()
+	payer := getFeePayer(request)
+	if payer == "" {
+		return nil, xerrors.New("fee payer not found")
+	}
+
+	var ops []*rosetta.Operation
+	fee := new(big.Int).Mul(
+		new(big.Int).SetUint64(info.GetGasUsed()),
+		new(big.Int).SetUint64(request.GetGasUnitPrice()),
+	)
+	if fee.Sign() > 0 {
+		ops = append(ops, p.operation(len(ops), OpTypeFee, OpStatusSuccess, payer, "", new(big.Int).Neg(fee), &nativeRosettaCurrency))
+	}
+
+	// The refund of the storage fee, e.g. when a resource is deleted, is paid back to the fee payer.
+	for _, event := range tx.GetEvents() {
+		if event.GetType() != feeStatementEventType {
+			continue
+		}
+
+		var feeStatement AptosFeeStatement
+		if err := json.Unmarshal([]byte(event.GetData()), &feeStatement); err != nil {
+			return nil, xerrors.Errorf("failed to unmarshal fee statement: %w", err)
+		}
+
+		if refund := feeStatement.StorageFeeRefundOctas.Value(); refund > 0 {
+			ops = append(ops, p.operation(len(ops), OpTypeFee, OpStatusSuccess, payer, "", new(big.Int).SetUint64(refund), &nativeRosettaCurrency))
+		}
+	}
+
+	return ops, nil
+}
+
+func (p *aptosRosettaParserImpl) eventOps(events []*api.AptosEvent, writeSet *aptosWriteSet, status string, startIndex int) ([]*rosetta.Operation, error) {
+	var ops []*rosetta.Operation
+	for _, event := range events {
+		var opType string
+		switch event.GetType() {
+		case coinWithdrawEventType, coinWithdrawModuleEventType, fungibleAssetWithdrawEventType:
+			opType = OpTypeWithdraw
+		case coinDepositEventType, coinDepositModuleEventType, fungibleAssetDepositEventType:
+			opType = OpTypeDeposit
+		default:
+			continue
+		}
+
+		var account string
+		var store string
+		var amount uint64
+		var currency *rosetta.Currency
+		switch event.GetType() {
+		case coinWithdrawEventType, coinDepositEventType:
+			var data AptosCoinEvent
+			if err := json.Unmarshal([]byte(event.GetData()), &data); err != nil {
+				return nil, xerrors.Errorf("failed to unmarshal coin event: %w", err)
+			}
+
+			key := aptosEventKey{
+				accountAddress: event.GetKey().GetAccountAddress(),
+				creationNumber: event.GetKey().GetCreationNumber(),
+			}
+			coinType, ok := writeSet.coinTypes[key]
+			if !ok {
+				return nil, xerrors.Errorf("failed to find coin store for event (key=%+v)", key)
+			}
+
+			var err error
+			account = key.accountAddress
+			amount = data.Amount.Value()
+			currency, err = p.coinCurrency(coinType)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to get currency for coin type %v: %w", coinType, err)
+			}
+		case coinWithdrawModuleEventType, coinDepositModuleEventType:
+			var data AptosCoinModuleEvent
+			if err := json.Unmarshal([]byte(event.GetData()), &data); err != nil {
+				return nil, xerrors.Errorf("failed to unmarshal coin module event: %w", err)
+			}
+
+			var err error
+			account = data.Account
+			amount = data.Amount.Value()
+			currency, err = p.coinCurrency(data.CoinType)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to get currency for coin type %v: %w", data.CoinType, err)
+			}
+		case fungibleAssetWithdrawEventType, fungibleAssetDepositEventType:
+			var data AptosFungibleAssetEvent
+			if err := json.Unmarshal([]byte(event.GetData()), &data); err != nil {
+				return nil, xerrors.Errorf("failed to unmarshal fungible asset event: %w", err)
+			}
+
+			var err error
+			store = data.Store
+			amount = data.Amount.Value()
+			account = store
+			if owner, ok := writeSet.objectOwners[store]; ok {
+				// The owner of a store is only known when the store object is written by the transaction.
+				// Otherwise, the balance change is attributed to the store itself.
+				account = owner
+			}
+
+			currency, err = p.fungibleAssetCurrency(writeSet.storeMetadata[store])
+			if err != nil {
+				return nil, xerrors.Errorf("failed to get currency for fungible store %v: %w", store, err)
+			}
+		}
+
+		ops = append(ops, p.operation(startIndex+len(ops), opType, status, account, store, p.signedAmount(opType, amount), currency))
+	}
+
+	return ops, nil
+}
+
+func (p *aptosRosettaParserImpl) parseWriteSet(changes []*api.AptosWriteSetChange) (*aptosWriteSet, error) {
+	writeSet := &aptosWriteSet{
+		coinTypes:     make(map[aptosEventKey]string),
+		storeMetadata: make(map[string]string),
+		objectOwners:  make(map[string]string),
+	}
+
+	for _, change := range changes {
+		resource := change.GetWriteResource()
+		if resource == nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(resource.TypeStr, coinStoreResourcePrefix):
+			var coinStore AptosCoinStore
+			if err := json.Unmarshal([]byte(resource.Data), &coinStore); err != nil {
+				return nil, xerrors.Errorf("failed to unmarshal coin store: %w", err)
+			}
+
+			coinType := strings.TrimSuffix(strings.TrimPrefix(resource.TypeStr, coinStoreResourcePrefix), ">")
+			for _, handle := range []AptosEventHandle{coinStore.WithdrawEvents, coinStore.DepositEvents} {
+				key := aptosEventKey{
+					accountAddress: handle.Guid.Id.Addr,
+					creationNumber: handle.Guid.Id.CreationNum.Value(),
+				}
+				writeSet.coinTypes[key] = coinType
+			}
+		case resource.TypeStr == fungibleStoreResource:
+			var fungibleStore AptosFungibleStore
+			if err := json.Unmarshal([]byte(resource.Data), &fungibleStore); err != nil {
+				return nil, xerrors.Errorf("failed to unmarshal fungible store: %w", err)
+			}
+
+			writeSet.storeMetadata[resource.Address] = fungibleStore.Metadata.Inner
+		case resource.TypeStr == objectCoreResource:
+			var objectCore AptosObjectCore
+			if err := json.Unmarshal([]byte(resource.Data), &objectCore); err != nil {
+				return nil, xerrors.Errorf("failed to unmarshal object core: %w", err)
+			}
+
+			writeSet.objectOwners[resource.Address] = objectCore.Owner
+		}
+	}
+
+	return writeSet, nil
+}
+
+func (p *aptosRosettaParserImpl) coinCurrency(coinType string) (*rosetta.Currency, error) {
+	if coinType == "" {
+		return nil, xerrors.New("coin type is empty")
+	}
+
+	if coinType == nativeCoinType {
+		return &nativeRosettaCurrency, nil
+	}
+
+	return p.unknownCurrency(coinType)
+}
+
+func (p *aptosRosettaParserImpl) fungibleAssetCurrency(metadataAddress string) (*rosetta.Currency, error) {
+	if metadataAddress == nativeFungibleAssetMetadata {
+		return &nativeRosettaCurrency, nil
+	}
+
+	if metadataAddress == "" {
+		// The fungible store is not written when the balance is tracked by a concurrent balance resource.
+		return &rosetta.Currency{
+			Symbol: UnknownCurrencySymbol,
+		}, nil
+	}
+
+	return p.unknownCurrency(metadataAddress)
+}
+
+// unknownCurrency returns the currency of a coin or a fungible asset other than APT.
+// The symbol and decimals are defined by the coin info and the fungible asset metadata resources,
+// which are not available in the block, hence the contract address is used to identify the currency.
+func (p *aptosRosettaParserImpl) unknownCurrency(contractAddress string) (*rosetta.Currency, error) {
+	metadata, err := rosetta.FromSDKMetadata(map[string]any{
+		ContractAddressAmountMetadataKey: contractAddress,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to marshal currency metadata for contractAddress %v: %w", contractAddress, err)
+	}
+
+	return &rosetta.Currency{
+		Symbol:   UnknownCurrencySymbol,
+		Metadata: metadata,
+	}, nil
+}
+
+func (p *aptosRosettaParserImpl) signedAmount(opType string, amount uint64) *big.Int {
+	value := new(big.Int).SetUint64(amount)
+	if opType == OpTypeWithdraw {
+		value.Neg(value)
+	}
+
+	return value
+}
+
+func (p *aptosRosettaParserImpl) operation(
+	index int,
+	opType string,
+	status string,
+	address string,
+	subAccount string,
+	amount *big.Int,
+	currency *rosetta.Currency,
+) *rosetta.Operation {
+	account := &rosetta.AccountIdentifier{
+		Address: address,
+	}
+	if subAccount != "" && subAccount != address {
+		account.SubAccount = &rosetta.SubAccountIdentifier{
+			Address: subAccount,
+		}
+	}
+
+	return &rosetta.Operation{
+		OperationIdentifier: &rosetta.OperationIdentifier{
+			Index: int64(index),
+		},
+		Type:    opType,
+		Status:  status,
+		Account: account,
+		Amount: &rosetta.Amount{
+			Value:    amount.String(),
+			Currency: currency,
+		},
+	}
+}
+
+func getFeePayer(request *api.AptosUserTransactionRequest) string {
+	if feePayer := request.GetSignature().GetFeePayer(); feePayer != nil {
+		return feePayer.GetFeePayerAddress()
+	}
+
+	return request.GetSender()
+}
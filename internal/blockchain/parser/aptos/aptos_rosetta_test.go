@@ -0,0 +1,230 @@
+package aptos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+	rosetta "github.com/coinbase/chainstorage/protos/coinbase/crypto/rosetta/types"
+)
+
+type aptosRosettaParserTestSuite struct {
+	suite.Suite
+	app          testapp.TestApp
+	parser       internal.RosettaParser
+	nativeParser internal.NativeParser
+}
+
+const (
+	// A synthetic block with coin, fungible asset and failed transactions.
+	aptosRosettaHeight    = uint64(200000)
+	aptosRosettaHash      = "0x496aca80e4d8f29fb8e8cd816c3afb48d3f103970b3a2ee1600c08ca67326dee"
+	aptosRosettaTimestamp = "2023-11-14T22:13:20Z"
+
+	aptosSender        = "0x2bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90"
+	aptosReceiver      = "0x81b637d8fcd2c6da6359e6963113a1170de795e4b725b84d1e0b4cfd9ec58ce9"
+	aptosSenderStore   = "0x30bf1aceea44111eb6e5bcdfd7d4721ea6d9789aecb9ab5eae772e15f86d0308"
+	aptosReceiverStore = "0x049d80af9f1b95274410c083bf77b814f0f468181878caef1bce4f3ca355fdc0"
+	aptosUSDCCoinType  = "0xf22bede237a07e121b56d91a491eb7bcdfd1f5907926a9e58338f964a01b17fa::asset::USDC"
+	aptosUSDTMetadata  = "0x357b0b74bc833e95a115ad22604854d6b0fca151cecd94111770e5d6ffc9dc2b"
+)
+
+func TestAptosRosettaParserTestSuite(t *testing.T) {
+	suite.Run(t, new(aptosRosettaParserTestSuite))
+}
+
+func (s *aptosRosettaParserTestSuite) SetupTest() {
+	s.app = testapp.New(s.T(),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_APTOS, common.Network_NETWORK_APTOS_MAINNET),
+		fx.Provide(NewAptosRosettaParser),
+		fx.Provide(NewAptosNativeParser),
+		fx.Populate(&s.parser),
+		fx.Populate(&s.nativeParser),
+	)
+	s.NotNil(s.parser)
+}
+
+func (s *aptosRosettaParserTestSuite) TearDownTest() {
+	s.app.Close()
+}
+
+func (s *aptosRosettaParserTestSuite) TestParseBlock() {
+	require := testutil.Require(s.T())
+
+	block := newAptosRosettaBlock()
+	rosettaBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	require.Equal(&rosetta.BlockIdentifier{
+		Index: int64(aptosRosettaHeight),
+		Hash:  aptosRosettaHash,
+	}, rosettaBlock.Block.BlockIdentifier)
+	require.Equal(&rosetta.BlockIdentifier{
+		Index: int64(aptosRosettaHeight - 1),
+	}, rosettaBlock.Block.ParentBlockIdentifier)
+	require.Equal(testutil.MustTimestamp(aptosRosettaTimestamp), rosettaBlock.Block.Timestamp)
+
+	transactions := rosettaBlock.Block.Transactions
+	require.Equal(6, len(transactions))
+
+	// Block metadata and state checkpoint transactions do not change any balance.
+	require.Equal("0xfffe1fe6343c0a0908d4f51d752321f45b35fbe94bb7beee4c7e3c784862e355", transactions[0].TransactionIdentifier.Hash)
+	require.Empty(transactions[0].Operations)
+	require.Equal("0x81ae62c0ca668c37dc9dc68d09042f963f681dc05465e1a9cf906a2216ba375b", transactions[5].TransactionIdentifier.Hash)
+	require.Empty(transactions[5].Operations)
+
+	// APT transfer with the coin store events.
+	require.Equal("0x57ea7f7b1f0806c471a2bf7f4a3e87a2c576b220420e86f9fb351842481f9d57", transactions[1].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		newAptosOperation(0, OpTypeFee, OpStatusSuccess, aptosSender, "", "-900", &nativeRosettaCurrency),
+		newAptosOperation(1, OpTypeWithdraw, OpStatusSuccess, aptosSender, "", "-100000000", &nativeRosettaCurrency),
+		newAptosOperation(2, OpTypeDeposit, OpStatusSuccess, aptosReceiver, "", "100000000", &nativeRosettaCurrency),
+	}, transactions[1].Operations)
+
+	// USDC transfer with the coin module events.
+	usdc := s.newUnknownCurrency(aptosUSDCCoinType)
+	require.Equal("0x10d4dd5f1abb7ddc5026d2b93a06d17c14a54c3ca768f799117ee1a2ff3b3c8b", transactions[2].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		newAptosOperation(0, OpTypeFee, OpStatusSuccess, aptosSender, "", "-700", &nativeRosettaCurrency),
+		newAptosOperation(1, OpTypeWithdraw, OpStatusSuccess, aptosSender, "", "-1000000", usdc),
+		newAptosOperation(2, OpTypeDeposit, OpStatusSuccess, aptosReceiver, "", "1000000", usdc),
+	}, transactions[2].Operations)
+
+	// USDT transfer with the fungible asset events.
+	// The storage fee is refunded, and the owner of the receiving store is unknown as the store object is not written.
+	usdt := s.newUnknownCurrency(aptosUSDTMetadata)
+	require.Equal("0x1c2cc78101461f107587d78c54d0f80522ef2cdf02614300fd3f86b1ebd0e6df", transactions[3].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		newAptosOperation(0, OpTypeFee, OpStatusSuccess, aptosSender, "", "-1200", &nativeRosettaCurrency),
+		newAptosOperation(1, OpTypeFee, OpStatusSuccess, aptosSender, "", "40000", &nativeRosettaCurrency),
+		newAptosOperation(2, OpTypeWithdraw, OpStatusSuccess, aptosSender, aptosSenderStore, "-5000000", usdt),
+		newAptosOperation(3, OpTypeDeposit, OpStatusSuccess, aptosReceiverStore, "", "5000000", usdt),
+	}, transactions[3].Operations)
+
+	// Failed transaction only pays the gas fee.
+	require.Equal("0xcea280cad95e358becf348d4df9564c14c6a33e29c7db808d40ab143aa49ddab", transactions[4].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		newAptosOperation(0, OpTypeFee, OpStatusSuccess, aptosSender, "", "-400", &nativeRosettaCurrency),
+	}, transactions[4].Operations)
+}
+
+func (s *aptosRosettaParserTestSuite) TestParseBlock_FeePayer() {
+	require := testutil.Require(s.T())
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_APTOS,
+		Network:    common.Network_NETWORK_APTOS_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          aptosTag,
+			Hash:         aptosHash4,
+			ParentHash:   aptosParentHash4,
+			Height:       aptosHeight4,
+			ParentHeight: aptosParentHeight4,
+		},
+		Blobdata: &api.Block_Aptos{
+			Aptos: &api.AptosBlobdata{
+				Block: fixtures.MustReadFile("parser/aptos/fee_payer_block.json"),
+			},
+		},
+	}
+
+	rosettaBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	transactions := rosettaBlock.Block.Transactions
+	require.Equal(1, len(transactions))
+	require.Equal("0x3cc7c7561dea396868d7e1d1706987996ed40f717f2107a8c72d2fa4d40e2337", transactions[0].TransactionIdentifier.Hash)
+
+	// The gas fee is charged from the fee payer instead of the sender.
+	require.Equal([]*rosetta.Operation{
+		newAptosOperation(0, OpTypeFee, OpStatusSuccess, "0xf5feedac06f7fa0d8a0de62ce00ec66bd0aaf3fb56c2ac9f986eda2efac65062", "", "-2400", &nativeRosettaCurrency),
+	}, transactions[0].Operations)
+}
+
+func (s *aptosRosettaParserTestSuite) TestParseBlock_MissingCoinStore() {
+	require := testutil.Require(s.T())
+
+	// The coin store owning the event handle of the withdraw event is not written.
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_APTOS,
+		Network:    common.Network_NETWORK_APTOS_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          aptosTag,
+			Hash:         aptosHash1,
+			ParentHash:   aptosParentHash1,
+			Height:       aptosHeight1,
+			ParentHeight: aptosParentHeight1,
+		},
+		Blobdata: &api.Block_Aptos{
+			Aptos: &api.AptosBlobdata{
+				Block: fixtures.MustReadFile("parser/aptos/simplified_user_block.json"),
+			},
+		},
+	}
+
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "failed to find coin store for event")
+}
+
+func (s *aptosRosettaParserTestSuite) newUnknownCurrency(contractAddress string) *rosetta.Currency {
+	metadata, err := rosetta.FromSDKMetadata(map[string]any{
+		ContractAddressAmountMetadataKey: contractAddress,
+	})
+	s.Require().NoError(err)
+
+	return &rosetta.Currency{
+		Symbol:   UnknownCurrencySymbol,
+		Metadata: metadata,
+	}
+}
+
+func newAptosRosettaBlock() *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_APTOS,
+		Network:    common.Network_NETWORK_APTOS_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          aptosTag,
+			Hash:         aptosRosettaHash,
+			Height:       aptosRosettaHeight,
+			ParentHeight: aptosRosettaHeight - 1,
+		},
+		Blobdata: &api.Block_Aptos{
+			Aptos: &api.AptosBlobdata{
+				Block: fixtures.MustReadFile("parser/aptos/rosetta_block.json"),
+			},
+		},
+	}
+}
+
+func newAptosOperation(index int64, opType string, status string, address string, subAccount string, value string, currency *rosetta.Currency) *rosetta.Operation {
+	account := &rosetta.AccountIdentifier{
+		Address: address,
+	}
+	if subAccount != "" {
+		account.SubAccount = &rosetta.SubAccountIdentifier{
+			Address: subAccount,
+		}
+	}
+
+	return &rosetta.Operation{
+		OperationIdentifier: &rosetta.OperationIdentifier{
+			Index: index,
+		},
+		Type:    opType,
+		Status:  status,
+		Account: account,
+		Amount: &rosetta.Amount{
+			Value:    value,
+			Currency: currency,
+		},
+	}
+}
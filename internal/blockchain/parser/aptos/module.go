@@ -8,5 +8,7 @@ import (
 
 var Module = fx.Options(
 	internal.NewParserBuilder("aptos", NewAptosNativeParser).
+		SetRosettaParserFactory(NewAptosRosettaParser).
+		SetCheckerFactory(NewAptosChecker).
 		Build(),
 )
@@ -0,0 +1,100 @@
+package bitcoin
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+)
+
+type (
+	// UtxoChainParams defines the parameters that differ among the UTXO blockchains forked from Bitcoin.
+	// Since these blockchains share the same RPC interface, they are all ingested by the bitcoin client and parser.
+	UtxoChainParams struct {
+		// PubKeyHashAddrID is the version byte of the base58-encoded P2PKH addresses.
+		PubKeyHashAddrID byte
+		// ScriptHashAddrID is the version byte of the base58-encoded P2SH addresses.
+		ScriptHashAddrID byte
+		// Bech32HRPSegwit is the human-readable part of the bech32-encoded segwit addresses.
+		// It is empty if segwit is not activated on the blockchain.
+		Bech32HRPSegwit string
+		// ScryptPoW is true if the proof of work is checked against the scrypt hash of the block header,
+		// instead of the block hash.
+		ScryptPoW bool
+		// AuxPowChainID is the chain id committed to by merge-mined blocks.
+		// It is zero if merged mining is not supported.
+		AuxPowChainID uint32
+	}
+)
+
+var (
+	BitcoinMainnetParams = &UtxoChainParams{
+		PubKeyHashAddrID: 0x00,
+		ScriptHashAddrID: 0x05,
+		Bech32HRPSegwit:  "bc",
+	}
+
+	BitcoinTestnetParams = &UtxoChainParams{
+		PubKeyHashAddrID: 0x6f,
+		ScriptHashAddrID: 0xc4,
+		Bech32HRPSegwit:  "tb",
+	}
+
+	// https://github.com/dogecoin/dogecoin/blob/master/src/chainparams.cpp
+	DogecoinMainnetParams = &UtxoChainParams{
+		PubKeyHashAddrID: 0x1e,
+		ScriptHashAddrID: 0x16,
+		ScryptPoW:        true,
+		AuxPowChainID:    0x62,
+	}
+
+	DogecoinTestnetParams = &UtxoChainParams{
+		PubKeyHashAddrID: 0x71,
+		ScriptHashAddrID: 0xc4,
+		ScryptPoW:        true,
+		AuxPowChainID:    0x62,
+	}
+
+	// https://github.com/litecoin-project/litecoin/blob/master/src/chainparams.cpp
+	LitecoinMainnetParams = &UtxoChainParams{
+		PubKeyHashAddrID: 0x30,
+		ScriptHashAddrID: 0x32,
+		Bech32HRPSegwit:  "ltc",
+		ScryptPoW:        true,
+	}
+
+	LitecoinTestnetParams = &UtxoChainParams{
+		PubKeyHashAddrID: 0x6f,
+		ScriptHashAddrID: 0x3a,
+		Bech32HRPSegwit:  "tltc",
+		ScryptPoW:        true,
+	}
+
+	utxoChainParamsByNetwork = map[common.Network]*UtxoChainParams{
+		common.Network_NETWORK_BITCOIN_MAINNET:  BitcoinMainnetParams,
+		common.Network_NETWORK_BITCOIN_TESTNET:  BitcoinTestnetParams,
+		common.Network_NETWORK_DOGECOIN_MAINNET: DogecoinMainnetParams,
+		common.Network_NETWORK_DOGECOIN_TESTNET: DogecoinTestnetParams,
+		common.Network_NETWORK_LITECOIN_MAINNET: LitecoinMainnetParams,
+		common.Network_NETWORK_LITECOIN_TESTNET: LitecoinTestnetParams,
+	}
+)
+
+// GetUtxoChainParams returns the chain params of the given network.
+func GetUtxoChainParams(network common.Network) (*UtxoChainParams, error) {
+	params, ok := utxoChainParamsByNetwork[network]
+	if !ok {
+		return nil, xerrors.Errorf("chain params not found for network %v", network)
+	}
+
+	return params, nil
+}
+
+// HasSegwit returns true if segwit is activated on the blockchain.
+func (p *UtxoChainParams) HasSegwit() bool {
+	return p.Bech32HRPSegwit != ""
+}
+
+// HasAuxPow returns true if the blockchain may be merge-mined with a parent blockchain.
+func (p *UtxoChainParams) HasAuxPow() bool {
+	return p.AuxPowChainID != 0
+}
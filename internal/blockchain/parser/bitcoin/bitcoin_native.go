@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/base58"
@@ -35,6 +36,11 @@ const (
 	bitcoinScriptTypeNullData            string = "nulldata"
 	bitcoinScriptTypeWitnessUnknown      string = "witness_unknown"
 	bitcoinScriptTypeWitnessV1Taproot    string = "witness_v1_taproot"
+
+	// Litecoin MWEB script types, which do not have an address.
+	// https://github.com/litecoin-project/litecoin/blob/master/src/script/standard.cpp
+	bitcoinScriptTypeWitnessMwebPegin   string = "witness_mweb_pegin"
+	bitcoinScriptTypeWitnessMwebHogAddr string = "witness_mweb_hogaddr"
 )
 
 type (
@@ -64,6 +70,18 @@ type (
 		NTx               BitcoinQuantity        `json:"nTx"`
 		PreviousBlockHash BitcoinHexString       `json:"previousblockhash" validate:"required_with=Height"`
 		NextBlockHash     BitcoinHexString       `json:"nextblockhash"`
+		AuxPow            *BitcoinAuxPow         `json:"auxpow"`
+	}
+
+	// BitcoinAuxPow is only returned by the blockchains supporting merged mining, e.g. Dogecoin.
+	// https://github.com/dogecoin/dogecoin/blob/master/src/rpc/blockchain.cpp
+	BitcoinAuxPow struct {
+		Tx                *BitcoinTransaction `json:"tx" validate:"required"`
+		Index             BitcoinQuantity     `json:"index"`
+		ChainIndex        BitcoinQuantity     `json:"chainindex"`
+		MerkleBranch      []BitcoinHexString  `json:"merklebranch"`
+		ChainMerkleBranch []BitcoinHexString  `json:"chainmerklebranch"`
+		ParentBlock       BitcoinHexString    `json:"parentblock" validate:"required"`
 	}
 
 	// BitcoinTransaction https://developer.bitcoin.org/reference/rpc/getrawtransaction.html
@@ -147,8 +165,9 @@ type (
 	}
 
 	bitcoinNativeParserImpl struct {
-		logger   *zap.Logger
-		validate *validator.Validate
+		logger      *zap.Logger
+		validate    *validator.Validate
+		chainParams *UtxoChainParams
 	}
 )
 
@@ -156,55 +175,91 @@ var _ internal.NativeParser = (*bitcoinNativeParserImpl)(nil)
 
 var pubKeyScriptRegexp = regexp.MustCompile("^([[:xdigit:]]*) OP_CHECKSIG$")
 
-func validateBitcoinScriptPubKey(sl validator.StructLevel) {
-	pubKey := sl.Current().Interface().(BitcoinScriptPubKey)
+// newBitcoinScriptPubKeyValidation returns the struct-level validation of BitcoinScriptPubKey,
+// which checks the address against the script type and the address encoding of the blockchain.
+func newBitcoinScriptPubKeyValidation(chainParams *UtxoChainParams) validator.StructLevelFunc {
+	return func(sl validator.StructLevel) {
+		pubKey := sl.Current().Interface().(BitcoinScriptPubKey)
 
-	// Addresses is deprecated: https://github.com/bitcoin/bitcoin/pull/20286
-	// we need it here for backcompat with already ingested data
-	address := pubKey.Address
-	if len(pubKey.Addresses) > 0 && len(address) == 0 {
-		address = pubKey.Addresses[0]
-	}
-
-	switch pubKey.Type.Value() {
-	// The `nonstandard` and `nulldata` script types do not contain address
-	case bitcoinScriptTypeNullData, bitcoinScriptTypeNonstandard:
-		if len(address) > 0 {
-			sl.ReportError(address, "Address[null]", "Address[null]", "bspk_an", "")
+		// Addresses is deprecated: https://github.com/bitcoin/bitcoin/pull/20286
+		// we need it here for backcompat with already ingested data
+		address := pubKey.Address
+		if len(pubKey.Addresses) > 0 && len(address) == 0 {
+			address = pubKey.Addresses[0]
 		}
-	// The `multisig` script types can have anywhere from 0 to many addresses.
-	// We do not record a canonical "address" for these script pub keys
-	case bitcoinScriptTypeMultisig:
-	// Bitcoin core has stopped returning address for pubkey scripts since https://github.com/bitcoin/bitcoin/pull/16725.
-	// addresses and regSigs are deprecated: https://github.com/bitcoin/bitcoin/pull/20286
-	case bitcoinScriptTypePubKey:
-		if len(address) == 0 {
-			match := pubKeyScriptRegexp.FindStringSubmatch(pubKey.Asm.Value())
-			if len(match) == 0 {
-				sl.ReportError(address, "Address[pubkey:m]", "Address[pubkey:m]", "bspk_apm", "")
+
+		switch pubKey.Type.Value() {
+		// The `nonstandard` and `nulldata` script types do not contain address
+		case bitcoinScriptTypeNullData, bitcoinScriptTypeNonstandard:
+			if len(address) > 0 {
+				sl.ReportError(address, "Address[null]", "Address[null]", "bspk_an", "")
 			}
+		// The `multisig` script types can have anywhere from 0 to many addresses.
+		// We do not record a canonical "address" for these script pub keys
+		case bitcoinScriptTypeMultisig:
+		// The MWEB script types do not contain address either.
+		case bitcoinScriptTypeWitnessMwebPegin, bitcoinScriptTypeWitnessMwebHogAddr:
+		// Bitcoin core has stopped returning address for pubkey scripts since https://github.com/bitcoin/bitcoin/pull/16725.
+		// addresses and regSigs are deprecated: https://github.com/bitcoin/bitcoin/pull/20286
+		case bitcoinScriptTypePubKey:
+			if len(address) == 0 {
+				match := pubKeyScriptRegexp.FindStringSubmatch(pubKey.Asm.Value())
+				if len(match) == 0 {
+					sl.ReportError(address, "Address[pubkey:m]", "Address[pubkey:m]", "bspk_apm", "")
+				}
 
-			_, err := hex.DecodeString(match[1])
-			if err != nil {
-				sl.ReportError(address, "Address[pubkey:d]", "Address[pubkey:d]", "bspk_apd", "")
+				_, err := hex.DecodeString(match[1])
+				if err != nil {
+					sl.ReportError(address, "Address[pubkey:d]", "Address[pubkey:d]", "bspk_apd", "")
+				}
 			}
+		// Types that we expect to be able to parse address for
+		case bitcoinScriptTypePubKeyHash:
+			validateBase58Address(sl, address, chainParams.PubKeyHashAddrID)
+		case bitcoinScriptTypeScriptHash:
+			validateBase58Address(sl, address, chainParams.ScriptHashAddrID)
+		case bitcoinScriptTypeWitnessV0PubKeyHash, bitcoinScriptTypeWitnessV0ScriptHash, bitcoinScriptTypeWitnessUnknown, bitcoinScriptTypeWitnessV1Taproot:
+			// Without segwit, the witness programs are anyone-can-spend scripts and cannot be encoded as addresses.
+			if !chainParams.HasSegwit() {
+				break
+			}
+
+			if len(address) == 0 {
+				sl.ReportError(address, "Address[main]", "Address[main]", "bspk_a", "")
+			} else if !strings.HasPrefix(address.Value(), chainParams.Bech32HRPSegwit+"1") {
+				sl.ReportError(address, "Address[hrp]", "Address[hrp]", "bspk_ah", "")
+			}
+		default:
+			sl.ReportError(address, "Address[unsupported]", "Address[unsupported]", "bspk_as", "")
 		}
-	// Types that we expect to be able to parse address for
-	case bitcoinScriptTypePubKeyHash, bitcoinScriptTypeScriptHash, bitcoinScriptTypeWitnessV0PubKeyHash, bitcoinScriptTypeWitnessV0ScriptHash, bitcoinScriptTypeWitnessUnknown, bitcoinScriptTypeWitnessV1Taproot:
-		if len(address) == 0 {
-			sl.ReportError(address, "Address[main]", "Address[main]", "bspk_a", "")
-		}
-	default:
-		sl.ReportError(address, "Address[unsupported]", "Address[unsupported]", "bspk_as", "")
+	}
+}
+
+// validateBase58Address checks that the address is present and encoded with the expected version byte.
+func validateBase58Address(sl validator.StructLevel, address BitcoinString, expectedVersion byte) {
+	if len(address) == 0 {
+		sl.ReportError(address, "Address[main]", "Address[main]", "bspk_a", "")
+		return
+	}
+
+	_, version, err := base58.CheckDecode(address.Value())
+	if err != nil || version != expectedVersion {
+		sl.ReportError(address, "Address[version]", "Address[version]", "bspk_av", "")
 	}
 }
 
 func NewBitcoinNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	chainParams, err := GetUtxoChainParams(params.Config.Network())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get chain params: %w", err)
+	}
+
 	v := validator.New()
-	v.RegisterStructValidation(validateBitcoinScriptPubKey, BitcoinScriptPubKey{})
+	v.RegisterStructValidation(newBitcoinScriptPubKeyValidation(chainParams), BitcoinScriptPubKey{})
 	return &bitcoinNativeParserImpl{
-		logger:   log.WithPackage(params.Logger),
-		validate: v,
+		logger:      log.WithPackage(params.Logger),
+		validate:    v,
+		chainParams: chainParams,
 	}, nil
 }
 
@@ -228,7 +283,11 @@ func (b *bitcoinNativeParserImpl) ParseBlock(ctx context.Context, rawBlock *api.
 		return nil, xerrors.Errorf("failed to validate bitcoin block %+v: %w", metadata, err)
 	}
 
-	header := block.GetApiBitcoinHeader()
+	header, err := block.GetApiBitcoinHeader(b.chainParams)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse header for %+v: %w", metadata, err)
+	}
+
 	transactions, err := b.parseTransactions(blobdata, block.Tx)
 	if err != nil {
 		return nil, xerrors.Errorf("parseTransactions failed for %+v: %w", metadata, err)
@@ -281,7 +340,7 @@ func (b *bitcoinNativeParserImpl) parseTransactions(
 				metadataMap[inputTxId] = make([]*api.BitcoinTransactionOutput, 0)
 			}
 
-			outputTx, err := inputTx.Vout[0].ToApiBitcoinTransactionOutput()
+			outputTx, err := inputTx.Vout[0].ToApiBitcoinTransactionOutput(b.chainParams)
 			if err != nil {
 				return nil, xerrors.Errorf("failed to convert to transaction output: %w", err)
 			}
@@ -303,7 +362,7 @@ func (b *bitcoinNativeParserImpl) parseApiBitcoinTransactions(
 ) ([]*api.BitcoinTransaction, error) {
 	transactions := make([]*api.BitcoinTransaction, len(rawTransactions))
 	for i, rawTx := range rawTransactions {
-		transaction, err := rawTx.ToApiBitcoinTransaction(i, metadataMap)
+		transaction, err := rawTx.ToApiBitcoinTransaction(i, metadataMap, b.chainParams)
 		if err != nil {
 			return nil, err
 		}
@@ -323,9 +382,14 @@ func (b *bitcoinNativeParserImpl) validateStruct(s any) error {
 	return nil
 }
 
-func (b *BitcoinBlock) GetApiBitcoinHeader() *api.BitcoinHeader {
+func (b *BitcoinBlock) GetApiBitcoinHeader(chainParams *UtxoChainParams) (*api.BitcoinHeader, error) {
 	if b == nil {
-		return nil
+		return nil, nil
+	}
+
+	auxPow, err := b.AuxPow.ToApiBitcoinAuxPow(chainParams)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to convert aux pow: %w", err)
 	}
 
 	return &api.BitcoinHeader{
@@ -349,16 +413,40 @@ func (b *BitcoinBlock) GetApiBitcoinHeader() *api.BitcoinHeader {
 		Timestamp: &timestamp.Timestamp{
 			Seconds: int64(b.Time.Value()),
 		},
+		AuxPow: auxPow,
+	}, nil
+}
+
+func (a *BitcoinAuxPow) ToApiBitcoinAuxPow(chainParams *UtxoChainParams) (*api.BitcoinAuxPow, error) {
+	if a == nil {
+		return nil, nil
 	}
+
+	// The coinbase transaction of the parent block does not spend any output.
+	coinbaseTransaction, err := a.Tx.ToApiBitcoinTransaction(0, nil, chainParams)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to convert coinbase transaction: %w", err)
+	}
+
+	return &api.BitcoinAuxPow{
+		CoinbaseTransaction: coinbaseTransaction,
+		MerkleBranch:        hexStringsToStrings(a.MerkleBranch),
+		Index:               a.Index.Value(),
+		ChainMerkleBranch:   hexStringsToStrings(a.ChainMerkleBranch),
+		ChainIndex:          a.ChainIndex.Value(),
+		ParentBlock:         a.ParentBlock.Value(),
+	}, nil
 }
 
-func (t *BitcoinTransaction) ToApiBitcoinTransaction(index int, metadataMap map[string][]*api.BitcoinTransactionOutput) (*api.BitcoinTransaction, error) {
+func (t *BitcoinTransaction) ToApiBitcoinTransaction(
+	index int, metadataMap map[string][]*api.BitcoinTransactionOutput, chainParams *UtxoChainParams,
+) (*api.BitcoinTransaction, error) {
 	vin, err := parseVin(t.Vin, metadataMap)
 	if err != nil {
 		return nil, err
 	}
 
-	vout, err := parseVout(t.Vout)
+	vout, err := parseVout(t.Vout, chainParams)
 	if err != nil {
 		return nil, err
 	}
@@ -463,10 +551,10 @@ func hexStringsToStrings(hexStrings []BitcoinHexString) []string {
 	return result
 }
 
-func parseVout(vout []*BitcoinTransactionOutput) ([]*api.BitcoinTransactionOutput, error) {
+func parseVout(vout []*BitcoinTransactionOutput, chainParams *UtxoChainParams) ([]*api.BitcoinTransactionOutput, error) {
 	outputs := make([]*api.BitcoinTransactionOutput, len(vout))
 	for i, outputTx := range vout {
-		output, err := outputTx.ToApiBitcoinTransactionOutput()
+		output, err := outputTx.ToApiBitcoinTransactionOutput(chainParams)
 		if err != nil {
 			return nil, xerrors.Errorf("failed to convert transaction output: %w", err)
 		}
@@ -477,13 +565,13 @@ func parseVout(vout []*BitcoinTransactionOutput) ([]*api.BitcoinTransactionOutpu
 	return outputs, nil
 }
 
-func (o *BitcoinTransactionOutput) ToApiBitcoinTransactionOutput() (*api.BitcoinTransactionOutput, error) {
+func (o *BitcoinTransactionOutput) ToApiBitcoinTransactionOutput(chainParams *UtxoChainParams) (*api.BitcoinTransactionOutput, error) {
 	value, err := btcutil.NewAmount(o.Value.Value())
 	if err != nil {
 		return nil, xerrors.Errorf("failed to convert value %v to btc amount: %w", o.Value.Value(), err)
 	}
 
-	scriptPubKey, err := o.ScriptPubKey.ToApiBitcoinScriptPublicKey()
+	scriptPubKey, err := o.ScriptPubKey.ToApiBitcoinScriptPublicKey(chainParams)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to convert script public key: %w", err)
 	}
@@ -495,7 +583,7 @@ func (o *BitcoinTransactionOutput) ToApiBitcoinTransactionOutput() (*api.Bitcoin
 	}, nil
 }
 
-func (k *BitcoinScriptPubKey) ToApiBitcoinScriptPublicKey() (*api.BitcoinScriptPublicKey, error) {
+func (k *BitcoinScriptPubKey) ToApiBitcoinScriptPublicKey(chainParams *UtxoChainParams) (*api.BitcoinScriptPublicKey, error) {
 	if k == nil {
 		return nil, nil
 	}
@@ -516,7 +604,7 @@ func (k *BitcoinScriptPubKey) ToApiBitcoinScriptPublicKey() (*api.BitcoinScriptP
 		Address:  k.Address.Value(),
 	}
 
-	transformedScriptPublicKey, err := transformScriptPublicKey(scriptPublicKey)
+	transformedScriptPublicKey, err := transformScriptPublicKey(scriptPublicKey, chainParams)
 	if err != nil {
 		return nil, err
 	}
@@ -577,7 +665,7 @@ func (v *BitcoinTransactionLit) UnmarshalJSON(input []byte) error {
 }
 
 func transformScriptPublicKey(
-	scriptPubKey *api.BitcoinScriptPublicKey,
+	scriptPubKey *api.BitcoinScriptPublicKey, chainParams *UtxoChainParams,
 ) (*api.BitcoinScriptPublicKey, error) {
 	switch scriptPubKey.Type {
 	case bitcoinScriptTypePubKey:
@@ -586,7 +674,7 @@ func transformScriptPublicKey(
 		}
 
 		// Attempt to fall back to parsing the address from the script.
-		account, err := parseAccountFromPubKeyScript(scriptPubKey)
+		account, err := parseAccountFromPubKeyScript(scriptPubKey, chainParams)
 		if err != nil {
 			return nil, err
 		}
@@ -598,8 +686,10 @@ func transformScriptPublicKey(
 	}
 }
 
+// parseAccountFromPubKeyScript derives the P2PKH address of the public key in the script,
+// using the address encoding of the blockchain.
 func parseAccountFromPubKeyScript(
-	scriptPubKey *api.BitcoinScriptPublicKey,
+	scriptPubKey *api.BitcoinScriptPublicKey, chainParams *UtxoChainParams,
 ) (string, error) {
 	if scriptPubKey.Type != bitcoinScriptTypePubKey {
 		return "", xerrors.New("not of type pubkey")
@@ -620,7 +710,7 @@ func parseAccountFromPubKeyScript(
 	_, _ = sha256Hash.Write(pubKey)
 	ripemd160Hash := ripemd160.New()
 	_, _ = ripemd160Hash.Write(sha256Hash.Sum(nil))
-	address := base58.CheckEncode(ripemd160Hash.Sum(nil), 0)
+	address := base58.CheckEncode(ripemd160Hash.Sum(nil), chainParams.PubKeyHashAddrID)
 
 	return address, nil
 }
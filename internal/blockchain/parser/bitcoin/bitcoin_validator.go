@@ -0,0 +1,600 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	bitcoinValidator struct {
+		config      *config.Config
+		logger      *zap.Logger
+		chainParams *UtxoChainParams
+	}
+)
+
+const (
+	bitcoinHeaderSize = 80
+
+	// The witness reserved value is the single item in the witness of the coinbase input.
+	bitcoinWitnessReservedValueSize = 32
+
+	// The parameters of the scrypt proof of work, where the block header is used as both the password and the salt.
+	// https://litecoin.info/index.php/Scrypt
+	scryptN      = 1024
+	scryptR      = 1
+	scryptP      = 1
+	scryptKeyLen = 32
+
+	// Merge-mined blocks set the aux pow flag in the version, and store the chain id in the upper 16 bits.
+	// https://github.com/dogecoin/dogecoin/blob/master/src/primitives/pureheader.h
+	bitcoinAuxPowVersionFlag          = 1 << 8
+	bitcoinAuxPowChainIDShift         = 16
+	bitcoinAuxPowMaxChainMerkleHeight = 30
+)
+
+var (
+	_ internal.TrustlessValidator = (*bitcoinValidator)(nil)
+
+	ErrInvalidBlockHash         = xerrors.New("invalid block hash")
+	ErrInvalidProofOfWork       = xerrors.New("invalid proof of work")
+	ErrInvalidMerkleRoot        = xerrors.New("invalid merkle root")
+	ErrInvalidTransactionHash   = xerrors.New("invalid transaction hash")
+	ErrInvalidWitnessCommitment = xerrors.New("invalid witness commitment")
+	ErrInvalidAuxPow            = xerrors.New("invalid aux pow")
+
+	// The witness commitment is stored in a coinbase output whose script starts with
+	// OP_RETURN, OP_PUSHBYTES_36 and the commitment header 0xaa21a9ed.
+	// https://github.com/bitcoin/bips/blob/master/bip-0141.mediawiki#commitment-structure
+	bitcoinWitnessCommitmentHeader = []byte{0x6a, 0x24, 0xaa, 0x21, 0xa9, 0xed}
+
+	// The merged mining header precedes the aux chain merkle root in the coinbase script of the parent block.
+	// https://en.bitcoin.it/wiki/Merged_mining_specification#Merged_mining_coinbase
+	bitcoinAuxPowMergedMiningHeader = []byte{0xfa, 0xbe, 0x6d, 0x6d}
+)
+
+func NewBitcoinValidator(params internal.ParserParams) internal.TrustlessValidator {
+	return &bitcoinValidator{
+		config:      params.Config,
+		logger:      log.WithPackage(params.Logger),
+		chainParams: getValidatorChainParams(params),
+	}
+}
+
+// getValidatorChainParams returns the chain params of the configured network.
+// Since the validator factory cannot fail, it falls back to the bitcoin mainnet params;
+// note that the unsupported networks are rejected by NewBitcoinNativeParser in the first place.
+func getValidatorChainParams(params internal.ParserParams) *UtxoChainParams {
+	chainParams, err := GetUtxoChainParams(params.Config.Network())
+	if err != nil {
+		return BitcoinMainnetParams
+	}
+
+	return chainParams
+}
+
+// ValidateBlock verifies a bitcoin block with cryptographic algorithm.
+// It performs verification for three main structures in a block
+// 1. block header
+// 2. the transaction merkle tree
+// 3. the witness commitment, if the block contains segwit transactions
+//
+// For 1, we recompute the block header hash, compare that with the block hash, and check the proof of work against the nBits target.
+// Depending on the chain params, the proof of work is either the block hash or the scrypt hash of the header,
+// and it may be provided by the parent block of a merge-mined block instead.
+// For 2, we deserialize the hex of every transaction, which is therefore required, check its transaction id and hash,
+// and compare the merkle root recomputed from the transaction ids with the merkle root in the header.
+// For 3, we recompute the merkle root from the witness transaction ids, and compare the commitment derived from it
+// with the one stored in the outputs of the deserialized coinbase transaction.
+func (v *bitcoinValidator) ValidateBlock(ctx context.Context, block *api.NativeBlock) error {
+	if block.Skipped {
+		// By definition skipped blocks do not need to be validated.
+		return nil
+	}
+
+	bitcoinBlock := block.GetBitcoin()
+	if bitcoinBlock == nil {
+		return xerrors.New("not a bitcoin block")
+	}
+
+	header := bitcoinBlock.GetHeader()
+	if header == nil {
+		return xerrors.New("header is missing")
+	}
+
+	if err := v.validateBlockHeader(block.Hash, header); err != nil {
+		return xerrors.Errorf("failed to validate block header: %w", err)
+	}
+
+	rawTransactions, err := v.validateTransactions(header, bitcoinBlock.Transactions)
+	if err != nil {
+		return xerrors.Errorf("failed to validate transactions: %w", err)
+	}
+
+	if err := v.validateWitnessCommitment(rawTransactions); err != nil {
+		return xerrors.Errorf("failed to validate witness commitment: %w", err)
+	}
+
+	return nil
+}
+
+func (v *bitcoinValidator) ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+// validateBlockHeader serializes the block header, recomputes its hash, and checks the hash against the nBits target.
+// https://developer.bitcoin.org/reference/block_chain.html#block-headers
+func (v *bitcoinValidator) validateBlockHeader(expectedHash string, header *api.BitcoinHeader) error {
+	previousBlockHash, err := v.parseHash(header.PreviousBlockHash)
+	if err != nil {
+		return xerrors.Errorf("failed to parse previous block hash: %w", err)
+	}
+
+	merkleRoot, err := v.parseHash(header.MerkleRoot)
+	if err != nil {
+		return xerrors.Errorf("failed to parse merkle root: %w", err)
+	}
+
+	bits, err := strconv.ParseUint(header.Bits, 16, 32)
+	if err != nil {
+		return xerrors.Errorf("failed to parse bits (%v): %w", header.Bits, err)
+	}
+
+	data := make([]byte, 0, bitcoinHeaderSize)
+	data = binary.LittleEndian.AppendUint32(data, uint32(header.Version))
+	data = append(data, previousBlockHash[:]...)
+	data = append(data, merkleRoot[:]...)
+	data = binary.LittleEndian.AppendUint32(data, uint32(header.Time))
+	data = binary.LittleEndian.AppendUint32(data, uint32(bits))
+	data = binary.LittleEndian.AppendUint32(data, uint32(header.Nonce))
+
+	hash := chainhash.DoubleHashH(data)
+	if hash.String() != header.Hash || hash.String() != expectedHash {
+		return xerrors.Errorf("unexpected block hash (expected=%v, header=%v, actual=%v): %w", expectedHash, header.Hash, hash.String(), ErrInvalidBlockHash)
+	}
+
+	target, err := v.compactToBig(uint32(bits))
+	if err != nil {
+		return xerrors.Errorf("failed to decode bits (%v): %w", header.Bits, err)
+	}
+
+	if v.chainParams.HasAuxPow() && header.Version&bitcoinAuxPowVersionFlag != 0 {
+		// The proof of work of a merge-mined block is provided by its parent block.
+		if err := v.validateAuxPow(hash, header, target); err != nil {
+			return xerrors.Errorf("failed to validate aux pow of block %v: %w", hash.String(), err)
+		}
+
+		return nil
+	}
+
+	if header.AuxPow != nil {
+		return xerrors.Errorf("unexpected aux pow in block %v without the aux pow flag: %w", hash.String(), ErrInvalidAuxPow)
+	}
+
+	if err := v.validateProofOfWork(data, target); err != nil {
+		return xerrors.Errorf("failed to validate proof of work of block %v: %w", hash.String(), err)
+	}
+
+	return nil
+}
+
+// validateProofOfWork checks the proof of work of the serialized block header against the target.
+func (v *bitcoinValidator) validateProofOfWork(header []byte, target *big.Int) error {
+	powHash := chainhash.DoubleHashB(header)
+	if v.chainParams.ScryptPoW {
+		var err error
+		powHash, err = scrypt.Key(header, header, scryptN, scryptR, scryptP, scryptKeyLen)
+		if err != nil {
+			return xerrors.Errorf("failed to compute scrypt hash: %w", err)
+		}
+	}
+
+	// The hash is stored in little-endian, while it is compared with the target as a big-endian number.
+	powHash = v.reverse(powHash)
+	hashNum := new(big.Int).SetBytes(powHash)
+	if hashNum.Cmp(target) > 0 {
+		return xerrors.Errorf("proof of work hash %x is above the target %064x: %w", powHash, target, ErrInvalidProofOfWork)
+	}
+
+	return nil
+}
+
+// validateAuxPow verifies the auxiliary proof of work of a merge-mined block, which consists of
+// 1. the header of the parent block, whose proof of work meets the target of the merge-mined block;
+// 2. the coinbase transaction of the parent block, which is proven to be in the parent block by the merkle branch;
+// 3. the merged mining commitment in the coinbase script, which is the root of the aux chain merkle tree,
+// and is proven to commit to the merge-mined block by the chain merkle branch.
+// https://en.bitcoin.it/wiki/Merged_mining_specification
+// https://github.com/dogecoin/dogecoin/blob/master/src/auxpow.cpp
+func (v *bitcoinValidator) validateAuxPow(blockHash chainhash.Hash, header *api.BitcoinHeader, target *big.Int) error {
+	auxPow := header.AuxPow
+	if auxPow == nil {
+		return xerrors.Errorf("aux pow is missing: %w", ErrInvalidAuxPow)
+	}
+
+	chainID := v.chainParams.AuxPowChainID
+	if actual := uint32(header.Version) >> bitcoinAuxPowChainIDShift; actual != chainID {
+		return xerrors.Errorf("unexpected chain id (expected=%v, actual=%v): %w", chainID, actual, ErrInvalidAuxPow)
+	}
+
+	parentHeader, err := hex.DecodeString(auxPow.ParentBlock)
+	if err != nil {
+		return xerrors.Errorf("failed to decode parent block: %w", err)
+	}
+
+	if len(parentHeader) != bitcoinHeaderSize {
+		return xerrors.Errorf("unexpected size of parent block header (%v): %w", len(parentHeader), ErrInvalidAuxPow)
+	}
+
+	if binary.LittleEndian.Uint32(parentHeader)>>bitcoinAuxPowChainIDShift == chainID {
+		return xerrors.Errorf("parent block must not have the same chain id: %w", ErrInvalidAuxPow)
+	}
+
+	if auxPow.Index != 0 {
+		return xerrors.Errorf("coinbase transaction must be the first transaction of the parent block (index=%v): %w", auxPow.Index, ErrInvalidAuxPow)
+	}
+
+	merkleHeight := len(auxPow.ChainMerkleBranch)
+	if merkleHeight > bitcoinAuxPowMaxChainMerkleHeight {
+		return xerrors.Errorf("chain merkle branch is too long (%v): %w", merkleHeight, ErrInvalidAuxPow)
+	}
+
+	if auxPow.CoinbaseTransaction == nil {
+		return xerrors.Errorf("coinbase transaction is missing: %w", ErrInvalidAuxPow)
+	}
+
+	raw, err := hex.DecodeString(auxPow.CoinbaseTransaction.Hex)
+	if err != nil {
+		return xerrors.Errorf("failed to decode hex of coinbase transaction: %w", err)
+	}
+
+	var coinbase wire.MsgTx
+	if err := coinbase.Deserialize(bytes.NewReader(raw)); err != nil {
+		return xerrors.Errorf("failed to deserialize coinbase transaction: %w", err)
+	}
+
+	if len(coinbase.TxIn) == 0 {
+		return xerrors.Errorf("coinbase transaction has no input: %w", ErrInvalidAuxPow)
+	}
+
+	// The merkle root of the parent block is stored at offset 36 of the header, after the version and the previous block hash.
+	parentMerkleRoot := parentHeader[4+chainhash.HashSize : 4+2*chainhash.HashSize]
+	merkleRoot, err := v.checkMerkleBranch(coinbase.TxHash(), auxPow.MerkleBranch, auxPow.Index)
+	if err != nil {
+		return xerrors.Errorf("failed to check merkle branch: %w", err)
+	}
+
+	if !bytes.Equal(merkleRoot[:], parentMerkleRoot) {
+		return xerrors.Errorf("coinbase transaction is not in the parent block (expected=%x, actual=%x): %w", parentMerkleRoot, merkleRoot[:], ErrInvalidAuxPow)
+	}
+
+	chainMerkleRoot, err := v.checkMerkleBranch(blockHash, auxPow.ChainMerkleBranch, auxPow.ChainIndex)
+	if err != nil {
+		return xerrors.Errorf("failed to check chain merkle branch: %w", err)
+	}
+
+	if err := v.validateMergedMiningCommitment(coinbase.TxIn[0].SignatureScript, chainMerkleRoot, merkleHeight, auxPow.ChainIndex); err != nil {
+		return xerrors.Errorf("failed to validate merged mining commitment: %w", err)
+	}
+
+	if err := v.validateProofOfWork(parentHeader, target); err != nil {
+		return xerrors.Errorf("failed to validate proof of work of parent block: %w", err)
+	}
+
+	return nil
+}
+
+// validateMergedMiningCommitment verifies that the coinbase script commits to the aux chain merkle root,
+// followed by the size of the aux chain merkle tree and the nonce that determines the chain index.
+func (v *bitcoinValidator) validateMergedMiningCommitment(script []byte, chainMerkleRoot chainhash.Hash, merkleHeight int, chainIndex uint64) error {
+	// Unlike the other hashes, the root is committed in big-endian.
+	root := v.reverse(chainMerkleRoot[:])
+	pos := bytes.Index(script, root)
+	if pos < 0 {
+		return xerrors.Errorf("chain merkle root %x is not found in coinbase script: %w", root, ErrInvalidAuxPow)
+	}
+
+	headerPos := bytes.Index(script, bitcoinAuxPowMergedMiningHeader)
+	if headerPos >= 0 {
+		// Only a single chain merkle root is allowed, which immediately follows the merged mining header.
+		if bytes.Contains(script[headerPos+1:], bitcoinAuxPowMergedMiningHeader) {
+			return xerrors.Errorf("multiple merged mining headers in coinbase script: %w", ErrInvalidAuxPow)
+		}
+
+		if headerPos+len(bitcoinAuxPowMergedMiningHeader) != pos {
+			return xerrors.Errorf("merged mining header is not just before chain merkle root: %w", ErrInvalidAuxPow)
+		}
+	} else if pos > 20 {
+		// For backward compatibility, the chain merkle root without the header must start within the first 20 bytes.
+		return xerrors.Errorf("chain merkle root must start in the first 20 bytes of coinbase script: %w", ErrInvalidAuxPow)
+	}
+
+	pos += len(root)
+	if len(script)-pos < 8 {
+		return xerrors.Errorf("chain merkle tree size and nonce are missing in coinbase script: %w", ErrInvalidAuxPow)
+	}
+
+	size := binary.LittleEndian.Uint32(script[pos:])
+	if size != 1<<merkleHeight {
+		return xerrors.Errorf("unexpected chain merkle tree size (expected=%v, actual=%v): %w", 1<<merkleHeight, size, ErrInvalidAuxPow)
+	}
+
+	nonce := binary.LittleEndian.Uint32(script[pos+4:])
+	if expected := v.getExpectedChainIndex(nonce, merkleHeight); chainIndex != expected {
+		return xerrors.Errorf("unexpected chain index (expected=%v, actual=%v): %w", expected, chainIndex, ErrInvalidAuxPow)
+	}
+
+	return nil
+}
+
+// getExpectedChainIndex derives the slot of the blockchain in the aux chain merkle tree from the nonce and the chain id,
+// so that a parent block cannot commit to multiple blocks of the same blockchain.
+func (v *bitcoinValidator) getExpectedChainIndex(nonce uint32, merkleHeight int) uint64 {
+	rand := nonce
+	rand = rand*1103515245 + 12345
+	rand += v.chainParams.AuxPowChainID
+	rand = rand*1103515245 + 12345
+	return uint64(rand % (1 << merkleHeight))
+}
+
+// checkMerkleBranch computes the merkle root from the leaf hash and its merkle branch.
+// The index is the position of the leaf, whose bits determine whether the sibling at each level is on the left or the right.
+func (v *bitcoinValidator) checkMerkleBranch(hash chainhash.Hash, branch []string, index uint64) (chainhash.Hash, error) {
+	for i, s := range branch {
+		sibling, err := v.parseHash(s)
+		if err != nil {
+			return chainhash.Hash{}, xerrors.Errorf("failed to parse hash %v of merkle branch: %w", i, err)
+		}
+
+		var pair [chainhash.HashSize * 2]byte
+		if index&1 != 0 {
+			copy(pair[:chainhash.HashSize], sibling[:])
+			copy(pair[chainhash.HashSize:], hash[:])
+		} else {
+			copy(pair[:chainhash.HashSize], hash[:])
+			copy(pair[chainhash.HashSize:], sibling[:])
+		}
+
+		hash = chainhash.DoubleHashH(pair[:])
+		index >>= 1
+	}
+
+	return hash, nil
+}
+
+// validateTransactions deserializes the raw transactions, verifies the transaction ids and hashes against them,
+// and recomputes the merkle root from the computed transaction ids.
+// The deserialized transactions are returned in the block order, for the validation of the witness commitment.
+func (v *bitcoinValidator) validateTransactions(header *api.BitcoinHeader, transactions []*api.BitcoinTransaction) ([]*wire.MsgTx, error) {
+	if len(transactions) == 0 {
+		return nil, xerrors.Errorf("block has no transaction: %w", ErrInvalidMerkleRoot)
+	}
+
+	if uint64(len(transactions)) != header.NumberOfTransactions {
+		return nil, xerrors.Errorf("unexpected number of transactions (expected=%v, actual=%v): %w", header.NumberOfTransactions, len(transactions), ErrInvalidMerkleRoot)
+	}
+
+	rawTransactions := make([]*wire.MsgTx, len(transactions))
+	txids := make([]chainhash.Hash, len(transactions))
+	for i, transaction := range transactions {
+		rawTransaction, err := v.deserializeTransaction(transaction.Hex)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to deserialize transaction %v: %w", i, err)
+		}
+		rawTransactions[i] = rawTransaction
+
+		// The transaction id excludes the witness data, while the transaction hash includes it.
+		txid := rawTransaction.TxHash()
+		if txid.String() != transaction.TransactionId {
+			return nil, xerrors.Errorf("unexpected id of transaction %v (expected=%v, actual=%v): %w", i, transaction.TransactionId, txid.String(), ErrInvalidTransactionHash)
+		}
+
+		wtxid := rawTransaction.WitnessHash()
+		if wtxid.String() != transaction.Hash {
+			return nil, xerrors.Errorf("unexpected hash of transaction %v (expected=%v, actual=%v): %w", i, transaction.Hash, wtxid.String(), ErrInvalidTransactionHash)
+		}
+
+		txids[i] = txid
+	}
+
+	merkleRoot := v.merkleRoot(txids)
+	if merkleRoot.String() != header.MerkleRoot {
+		return nil, xerrors.Errorf("unexpected merkle root (expected=%v, actual=%v): %w", header.MerkleRoot, merkleRoot.String(), ErrInvalidMerkleRoot)
+	}
+
+	return rawTransactions, nil
+}
+
+// deserializeTransaction decodes the hex of a transaction, which is required since the hashes are computed from it.
+func (v *bitcoinValidator) deserializeTransaction(s string) (*wire.MsgTx, error) {
+	if s == "" {
+		return nil, xerrors.Errorf("hex is missing: %w", ErrInvalidTransactionHash)
+	}
+
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode hex: %w", err)
+	}
+
+	reader := bytes.NewReader(raw)
+	var transaction wire.MsgTx
+	if err := transaction.Deserialize(reader); err != nil {
+		return nil, xerrors.Errorf("failed to deserialize hex: %v: %w", err, ErrInvalidTransactionHash)
+	}
+
+	if reader.Len() != 0 {
+		return nil, xerrors.Errorf("unexpected %v trailing bytes in hex: %w", reader.Len(), ErrInvalidTransactionHash)
+	}
+
+	return &transaction, nil
+}
+
+// validateWitnessCommitment recomputes the witness commitment from the witness transaction ids,
+// and compares it with the commitment in the coinbase transaction.
+// Both are derived from the deserialized transactions rather than the parsed fields.
+// https://github.com/bitcoin/bips/blob/master/bip-0141.mediawiki#commitment-structure
+func (v *bitcoinValidator) validateWitnessCommitment(transactions []*wire.MsgTx) error {
+	coinbase := transactions[0]
+	if !v.isCoinbase(coinbase) {
+		return xerrors.Errorf("first transaction is not a coinbase transaction: %w", ErrInvalidWitnessCommitment)
+	}
+
+	if !v.chainParams.HasSegwit() {
+		// Witness data is not allowed if segwit is not activated.
+		return v.validateNoWitnessData(transactions)
+	}
+
+	commitment := v.getWitnessCommitment(coinbase)
+	if commitment == nil {
+		// Blocks without a witness commitment must not contain any witness data.
+		return v.validateNoWitnessData(transactions)
+	}
+
+	witness := coinbase.TxIn[0].Witness
+	if len(witness) != 1 {
+		return xerrors.Errorf("coinbase transaction must have a single witness item: %w", ErrInvalidWitnessCommitment)
+	}
+
+	reservedValue := witness[0]
+	if len(reservedValue) != bitcoinWitnessReservedValueSize {
+		return xerrors.Errorf("unexpected size of witness reserved value (%v): %w", len(reservedValue), ErrInvalidWitnessCommitment)
+	}
+
+	// The witness transaction id of the coinbase transaction is assumed to be 0x00...00.
+	wtxids := make([]chainhash.Hash, len(transactions))
+	for i := 1; i < len(transactions); i++ {
+		wtxids[i] = transactions[i].WitnessHash()
+	}
+
+	witnessRoot := v.merkleRoot(wtxids)
+	actual := chainhash.DoubleHashB(append(witnessRoot[:], reservedValue...))
+	if !bytes.Equal(actual, commitment) {
+		return xerrors.Errorf("unexpected witness commitment (expected=%x, actual=%x): %w", commitment, actual, ErrInvalidWitnessCommitment)
+	}
+
+	return nil
+}
+
+func (v *bitcoinValidator) validateNoWitnessData(transactions []*wire.MsgTx) error {
+	for i, transaction := range transactions {
+		if transaction.HasWitness() {
+			return xerrors.Errorf("unexpected witness data in transaction %v without witness commitment: %w", i, ErrInvalidWitnessCommitment)
+		}
+	}
+
+	return nil
+}
+
+// isCoinbase returns true if the transaction has a single input spending the null outpoint.
+func (v *bitcoinValidator) isCoinbase(transaction *wire.MsgTx) bool {
+	if len(transaction.TxIn) != 1 {
+		return false
+	}
+
+	previousOutPoint := transaction.TxIn[0].PreviousOutPoint
+	return previousOutPoint.Index == wire.MaxPrevOutIndex && previousOutPoint.Hash == chainhash.Hash{}
+}
+
+// getWitnessCommitment returns the witness commitment in the coinbase transaction, or nil if there is none.
+// If there are multiple outputs matching the pattern, the one with the highest output index is used.
+func (v *bitcoinValidator) getWitnessCommitment(coinbase *wire.MsgTx) []byte {
+	headerSize := len(bitcoinWitnessCommitmentHeader)
+	for i := len(coinbase.TxOut) - 1; i >= 0; i-- {
+		script := coinbase.TxOut[i].PkScript
+		if len(script) >= headerSize+chainhash.HashSize && bytes.HasPrefix(script, bitcoinWitnessCommitmentHeader) {
+			return script[headerSize : headerSize+chainhash.HashSize]
+		}
+	}
+
+	return nil
+}
+
+// merkleRoot computes the merkle root of the given hashes.
+// If a level has an odd number of hashes, the last hash is duplicated.
+func (v *bitcoinValidator) merkleRoot(hashes []chainhash.Hash) chainhash.Hash {
+	level := make([]chainhash.Hash, len(hashes))
+	copy(level, hashes)
+	for len(level) > 1 {
+		if len(level)%2 != 0 {
+			level = append(level, level[len(level)-1])
+		}
+
+		next := make([]chainhash.Hash, len(level)/2)
+		for i := range next {
+			var pair [chainhash.HashSize * 2]byte
+			copy(pair[:chainhash.HashSize], level[2*i][:])
+			copy(pair[chainhash.HashSize:], level[2*i+1][:])
+			next[i] = chainhash.DoubleHashH(pair[:])
+		}
+
+		level = next
+	}
+
+	return level[0]
+}
+
+// parseHash parses a hash in the byte-reversed hex format returned by bitcoind.
+// An empty string, e.g. the previous block hash of the genesis block, is parsed as 0x00...00.
+func (v *bitcoinValidator) parseHash(s string) (chainhash.Hash, error) {
+	if s == "" {
+		return chainhash.Hash{}, nil
+	}
+
+	if len(s) != chainhash.MaxHashStringSize {
+		return chainhash.Hash{}, xerrors.Errorf("invalid hash length (%v)", s)
+	}
+
+	hash, err := chainhash.NewHashFromStr(s)
+	if err != nil {
+		return chainhash.Hash{}, xerrors.Errorf("failed to decode hash (%v): %w", s, err)
+	}
+
+	return *hash, nil
+}
+
+// compactToBig decodes the target from the compact representation stored in nBits.
+// https://developer.bitcoin.org/reference/block_chain.html#target-nbits
+func (v *bitcoinValidator) compactToBig(bits uint32) (*big.Int, error) {
+	mantissa := bits & 0x007fffff
+	exponent := uint(bits >> 24)
+	if bits&0x00800000 != 0 && mantissa != 0 {
+		return nil, xerrors.New("target must not be negative")
+	}
+
+	var target *big.Int
+	if exponent <= 3 {
+		target = big.NewInt(int64(mantissa >> (8 * (3 - exponent))))
+	} else {
+		target = new(big.Int).Lsh(big.NewInt(int64(mantissa)), 8*(exponent-3))
+	}
+
+	if target.Sign() == 0 {
+		return nil, xerrors.New("target must not be zero")
+	}
+
+	return target, nil
+}
+
+func (v *bitcoinValidator) reverse(b []byte) []byte {
+	reversed := make([]byte, len(b))
+	for i := range b {
+		reversed[len(b)-1-i] = b[i]
+	}
+
+	return reversed
+}
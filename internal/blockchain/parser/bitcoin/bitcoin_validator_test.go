@@ -0,0 +1,340 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"go.uber.org/fx"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+func TestBitcoinValidator_Success(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newBitcoinParser(t)
+	ctx := context.Background()
+
+	// For this test, we cover multiple types of blocks:
+	// 1. block 0: the genesis block without a previous block hash.
+	// 2. block 91880: a block with a duplicate coinbase transaction.
+	// 3. block 200000: a block with 388 transactions.
+	blocks := []string{"raw_block_0", "raw_block_91880", "raw_block_200000"}
+	for _, b := range blocks {
+		rawBlock, err := testutil.LoadRawBlock("parser/bitcoin/" + b + ".json")
+		require.NoError(err)
+
+		nativeBlock, err := parser.ParseNativeBlock(ctx, rawBlock)
+		require.NoError(err)
+
+		err = parser.ValidateBlock(ctx, nativeBlock)
+		require.NoError(err, b)
+	}
+}
+
+func TestBitcoinValidator_SegwitBlock(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newBitcoinParser(t)
+	ctx := context.Background()
+
+	// The fixture is a constructed regtest-style block with a segwit coinbase transaction and a segwit P2WPKH spend,
+	// which keeps the whole block small enough to verify the merkle root and the witness commitment.
+	// See TestBitcoinValidator_MainnetSegwitBlock for the mainnet data.
+	var block api.NativeBlock
+	fixtures.MustUnmarshalPB("parser/bitcoin/native_block_segwit.json", &block)
+	require.NotEqual(block.GetBitcoin().Transactions[1].TransactionId, block.GetBitcoin().Transactions[1].Hash)
+
+	err := parser.ValidateBlock(ctx, &block)
+	require.NoError(err)
+}
+
+func TestBitcoinValidator_MainnetSegwitBlock(t *testing.T) {
+	require := testutil.Require(t)
+
+	validator := newBitcoinValidator(t)
+
+	// The fixture has the header and the segwit coinbase transaction of the mainnet block 696402.
+	// Since only two of the 2512 transactions are included, the merkle root and the witness commitment cannot be recomputed.
+	var block api.BitcoinBlock
+	fixtures.MustUnmarshalJSON("parser/bitcoin/get_block_native.json", &block)
+	header := block.Header
+	require.Equal(uint64(696402), header.Height)
+
+	err := validator.validateBlockHeader(header.Hash, header)
+	require.NoError(err)
+
+	// The transaction hash covers the witness data, while the transaction id does not.
+	coinbase := block.Transactions[0]
+	require.True(coinbase.IsCoinbase)
+	raw, err := hex.DecodeString(coinbase.Hex)
+	require.NoError(err)
+	require.Equal(coinbase.Hash, chainhash.DoubleHashH(raw).String())
+	var tx wire.MsgTx
+	require.NoError(tx.Deserialize(bytes.NewReader(raw)))
+	require.True(tx.HasWitness())
+	require.Equal(coinbase.TransactionId, tx.TxHash().String())
+	require.NotEqual(coinbase.TransactionId, coinbase.Hash)
+
+	require.True(validator.isCoinbase(&tx))
+	commitment := validator.getWitnessCommitment(&tx)
+	require.Equal(chainhash.HashSize, len(commitment))
+
+	// The transaction hashes are verified before the merkle root.
+	header.NumberOfTransactions = uint64(len(block.Transactions))
+	_, err = validator.validateTransactions(header, block.Transactions)
+	require.Error(err)
+	require.True(xerrors.Is(err, ErrInvalidMerkleRoot), err.Error())
+
+	coinbase.Hash = coinbase.TransactionId
+	_, err = validator.validateTransactions(header, block.Transactions)
+	require.Error(err)
+	require.True(xerrors.Is(err, ErrInvalidTransactionHash), err.Error())
+
+	// Any change to the header invalidates the hash.
+	header.Nonce += 1
+	err = validator.validateBlockHeader(header.Hash, header)
+	require.Error(err)
+	require.True(xerrors.Is(err, ErrInvalidBlockHash), err.Error())
+}
+
+func TestBitcoinValidator_Skipped(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newBitcoinParser(t)
+	err := parser.ValidateBlock(context.Background(), &api.NativeBlock{
+		Blockchain: common.Blockchain_BLOCKCHAIN_BITCOIN,
+		Network:    common.Network_NETWORK_BITCOIN_MAINNET,
+		Skipped:    true,
+	})
+	require.NoError(err)
+}
+
+func TestBitcoinValidator_Failures(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newBitcoinParser(t)
+	ctx := context.Background()
+
+	rawBlock, err := testutil.LoadRawBlock("parser/bitcoin/raw_block_200000.json")
+	require.NoError(err)
+	nativeBlock, err := parser.ParseNativeBlock(ctx, rawBlock)
+	require.NoError(err)
+
+	var segwitBlock api.NativeBlock
+	fixtures.MustUnmarshalPB("parser/bitcoin/native_block_segwit.json", &segwitBlock)
+
+	tests := []struct {
+		name     string
+		block    *api.NativeBlock
+		mutate   func(block *api.NativeBlock)
+		expected error
+	}{
+		{
+			name:  "nonce",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Header.Nonce += 1
+			},
+			expected: ErrInvalidBlockHash,
+		},
+		{
+			name:  "timestamp",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Header.Time += 1
+			},
+			expected: ErrInvalidBlockHash,
+		},
+		{
+			name:  "merkleRoot",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Header.MerkleRoot = block.GetBitcoin().Transactions[0].TransactionId
+			},
+			expected: ErrInvalidBlockHash,
+		},
+		{
+			name:  "proofOfWork",
+			block: &segwitBlock,
+			mutate: func(block *api.NativeBlock) {
+				// The header is consistent with the hash, but the hash is above the target.
+				block.GetBitcoin().Header.Bits = "1d00ffff"
+				block.GetBitcoin().Header.Hash = "75bbb38c058e83305232175b5ccaaf4531192dda421cd5117dc8b39133064fdb"
+				block.Hash = block.GetBitcoin().Header.Hash
+			},
+			expected: ErrInvalidProofOfWork,
+		},
+		{
+			name:  "transactionOrder",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				transactions := block.GetBitcoin().Transactions
+				transactions[1], transactions[2] = transactions[2], transactions[1]
+			},
+			expected: ErrInvalidMerkleRoot,
+		},
+		{
+			name:  "missingTransaction",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				bitcoinBlock := block.GetBitcoin()
+				bitcoinBlock.Transactions = bitcoinBlock.Transactions[:len(bitcoinBlock.Transactions)-1]
+				bitcoinBlock.Header.NumberOfTransactions -= 1
+			},
+			expected: ErrInvalidMerkleRoot,
+		},
+		{
+			name:  "transactionId",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Transactions[1].TransactionId = block.GetBitcoin().Transactions[2].TransactionId
+			},
+			expected: ErrInvalidTransactionHash,
+		},
+		{
+			name:  "transactionHex",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Transactions[1].Hex = block.GetBitcoin().Transactions[2].Hex
+			},
+			expected: ErrInvalidTransactionHash,
+		},
+		{
+			name:  "missingTransactionHex",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Transactions[1].Hex = ""
+			},
+			expected: ErrInvalidTransactionHash,
+		},
+		{
+			name:  "trailingTransactionHex",
+			block: nativeBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Transactions[1].Hex += "00"
+			},
+			expected: ErrInvalidTransactionHash,
+		},
+		{
+			name:  "witnessHash",
+			block: &segwitBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetBitcoin().Transactions[1].Hash = block.GetBitcoin().Transactions[1].TransactionId
+			},
+			expected: ErrInvalidTransactionHash,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			block := proto.Clone(test.block).(*api.NativeBlock)
+			test.mutate(block)
+			err := parser.ValidateBlock(ctx, block)
+			require.Error(err)
+			require.True(xerrors.Is(err, test.expected), err.Error())
+		})
+	}
+}
+
+func TestBitcoinValidator_WitnessCommitmentFailures(t *testing.T) {
+	validator := newBitcoinValidator(t)
+
+	var block api.NativeBlock
+	fixtures.MustUnmarshalPB("parser/bitcoin/native_block_segwit.json", &block)
+	header := block.GetBitcoin().Header
+
+	// Any change to the raw transactions would invalidate the merkle root first,
+	// hence the deserialized transactions are mutated instead.
+	tests := []struct {
+		name   string
+		mutate func(transactions []*wire.MsgTx)
+	}{
+		{
+			name: "witnessData",
+			mutate: func(transactions []*wire.MsgTx) {
+				transactions[1].TxIn[0].Witness = append(transactions[1].TxIn[0].Witness, []byte{0x01})
+			},
+		},
+		{
+			name: "witnessReservedValue",
+			mutate: func(transactions []*wire.MsgTx) {
+				transactions[0].TxIn[0].Witness[0] = bytes.Repeat([]byte{0x01}, bitcoinWitnessReservedValueSize)
+			},
+		},
+		{
+			name: "witnessReservedValueSize",
+			mutate: func(transactions []*wire.MsgTx) {
+				transactions[0].TxIn[0].Witness[0] = transactions[0].TxIn[0].Witness[0][1:]
+			},
+		},
+		{
+			name: "witnessCommitmentRemoved",
+			mutate: func(transactions []*wire.MsgTx) {
+				// Witness data is not allowed without a witness commitment.
+				transactions[0].TxOut = transactions[0].TxOut[:1]
+			},
+		},
+		{
+			name: "coinbase",
+			mutate: func(transactions []*wire.MsgTx) {
+				transactions[0].TxIn[0].PreviousOutPoint.Index = 0
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			transactions, err := validator.validateTransactions(header, block.GetBitcoin().Transactions)
+			require.NoError(err)
+			require.NoError(validator.validateWitnessCommitment(transactions))
+
+			test.mutate(transactions)
+			err = validator.validateWitnessCommitment(transactions)
+			require.Error(err)
+			require.True(xerrors.Is(err, ErrInvalidWitnessCommitment), err.Error())
+		})
+	}
+}
+
+func newBitcoinValidator(t *testing.T) *bitcoinValidator {
+	var validator internal.TrustlessValidator
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_BITCOIN, common.Network_NETWORK_BITCOIN_MAINNET),
+		fx.Provide(NewBitcoinValidator),
+		fx.Populate(&validator),
+	)
+	t.Cleanup(app.Close)
+	require := testutil.Require(t)
+	require.NotNil(validator)
+	return validator.(*bitcoinValidator)
+}
+
+func newBitcoinParser(t *testing.T) internal.Parser {
+	var parser internal.Parser
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_BITCOIN, common.Network_NETWORK_BITCOIN_MAINNET),
+		Module,
+		internal.Module,
+		fx.Populate(&parser),
+	)
+	t.Cleanup(app.Close)
+	require := testutil.Require(t)
+	require.NotNil(parser)
+	return parser
+}
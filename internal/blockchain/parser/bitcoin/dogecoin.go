@@ -0,0 +1,15 @@
+package bitcoin
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+func NewDogecoinNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	// Dogecoin shares the same data schema as Bitcoin.
+	// The differences, e.g. address encoding and aux pow, are captured by the chain params of the network.
+	return NewBitcoinNativeParser(params, opts...)
+}
+
+func NewDogecoinValidator(params internal.ParserParams) internal.TrustlessValidator {
+	return NewBitcoinValidator(params)
+}
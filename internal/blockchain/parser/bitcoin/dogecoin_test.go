@@ -0,0 +1,205 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"go.uber.org/fx"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+const (
+	dogecoinGenesisHash = "1a91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691"
+	dogecoinMinerAddr   = "DFAGLseSCfJhK3DjSZRzoAs8yjWhRBP3R8"
+
+	// The AuxPoW fixture is NOT a mainnet block: it was constructed by hand so that the parent block, the merkle
+	// branch and the coinbase commitment are consistent with each other, and its height of 100000 predates the
+	// activation of AuxPoW at 371337. It only exercises the parser and the validator, which check AuxPoW by the
+	// version flag rather than the height, and should be replaced with the `getblock <hash> 2` output of a real
+	// merge-mined block.
+	dogecoinSyntheticAuxPowHash = "0c62d0549eeafa6616e3b12cde83406b3706bc31b84188ab8592c8e875174373"
+)
+
+func TestDogecoinParser_GenesisBlock(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser, validator := newDogecoinParser(t)
+	ctx := context.Background()
+
+	rawBlock, err := testutil.LoadRawBlock("parser/dogecoin/raw_block_0.json")
+	require.NoError(err)
+
+	nativeBlock, err := parser.ParseBlock(ctx, rawBlock)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_DOGECOIN, nativeBlock.Blockchain)
+	require.Equal(dogecoinGenesisHash, nativeBlock.Hash)
+
+	block := nativeBlock.GetBitcoin()
+	require.Nil(block.Header.AuxPow)
+	require.Len(block.Transactions, 1)
+	output := block.Transactions[0].Outputs[0]
+	require.Equal(uint64(8_800_000_000), output.Value)
+	require.Equal(bitcoinScriptTypePubKey, output.ScriptPublicKey.Type)
+	require.Equal("DQmCZQo3thCvTxkyAhPHfY7DVLqFtJ2ji6", output.ScriptPublicKey.Address)
+
+	// The block hash is above the target; the proof of work is the scrypt hash of the header.
+	err = validator.ValidateBlock(ctx, nativeBlock)
+	require.NoError(err)
+}
+
+func TestDogecoinParser_AuxPow(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser, validator := newDogecoinParser(t)
+	ctx := context.Background()
+
+	rawBlock, err := testutil.LoadRawBlock("parser/dogecoin/synthetic_block_auxpow.json")
+	require.NoError(err)
+
+	nativeBlock, err := parser.ParseBlock(ctx, rawBlock)
+	require.NoError(err)
+	require.Equal(dogecoinSyntheticAuxPowHash, nativeBlock.Hash)
+
+	block := nativeBlock.GetBitcoin()
+	require.Equal(dogecoinMinerAddr, block.Transactions[0].Outputs[0].ScriptPublicKey.Address)
+
+	auxPow := block.Header.AuxPow
+	require.NotNil(auxPow)
+	require.Equal(uint64(0), auxPow.Index)
+	require.Equal(uint64(0), auxPow.ChainIndex)
+	require.Len(auxPow.MerkleBranch, 1)
+	require.Empty(auxPow.ChainMerkleBranch)
+	require.Len(auxPow.ParentBlock, bitcoinHeaderSize*2)
+	require.NotEmpty(auxPow.CoinbaseTransaction.Hex)
+	require.Equal(dogecoinMinerAddr, auxPow.CoinbaseTransaction.Outputs[0].ScriptPublicKey.Address)
+
+	err = validator.ValidateBlock(ctx, nativeBlock)
+	require.NoError(err)
+}
+
+func TestDogecoinParser_InvalidAddress(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser, _ := newDogecoinParser(t)
+
+	rawBlock, err := testutil.LoadRawBlock("parser/dogecoin/synthetic_block_auxpow.json")
+	require.NoError(err)
+
+	// Replace the address with the bitcoin address of the same public key hash.
+	blobdata := rawBlock.GetBitcoin()
+	blobdata.Header = bytes.ReplaceAll(blobdata.Header, []byte(dogecoinMinerAddr), []byte("1B2AochnuFQQn338hySSFQhY6bnQ7Sd7kx"))
+
+	_, err = parser.ParseBlock(context.Background(), rawBlock)
+	require.Error(err)
+	require.Contains(err.Error(), "bspk_av")
+}
+
+func TestDogecoinValidator_AuxPowFailures(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser, validator := newDogecoinParser(t)
+	ctx := context.Background()
+
+	rawBlock, err := testutil.LoadRawBlock("parser/dogecoin/synthetic_block_auxpow.json")
+	require.NoError(err)
+	nativeBlock, err := parser.ParseBlock(ctx, rawBlock)
+	require.NoError(err)
+
+	tests := []struct {
+		name     string
+		mutate   func(block *api.BitcoinBlock)
+		expected error
+	}{
+		{
+			name: "missingAuxPow",
+			mutate: func(block *api.BitcoinBlock) {
+				block.Header.AuxPow = nil
+			},
+			expected: ErrInvalidAuxPow,
+		},
+		{
+			name: "parentNonce",
+			mutate: func(block *api.BitcoinBlock) {
+				// The scrypt hash of the parent block is above the target once the nonce is changed.
+				parentBlock := block.Header.AuxPow.ParentBlock
+				block.Header.AuxPow.ParentBlock = parentBlock[:len(parentBlock)-8] + "6b1c0000"
+			},
+			expected: ErrInvalidProofOfWork,
+		},
+		{
+			name: "parentMerkleRoot",
+			mutate: func(block *api.BitcoinBlock) {
+				block.Header.AuxPow.MerkleBranch[0] = block.Transactions[0].TransactionId
+			},
+			expected: ErrInvalidAuxPow,
+		},
+		{
+			name: "coinbaseIndex",
+			mutate: func(block *api.BitcoinBlock) {
+				block.Header.AuxPow.Index = 1
+			},
+			expected: ErrInvalidAuxPow,
+		},
+		{
+			name: "chainIndex",
+			mutate: func(block *api.BitcoinBlock) {
+				block.Header.AuxPow.ChainIndex = 1
+			},
+			expected: ErrInvalidAuxPow,
+		},
+		{
+			name: "chainMerkleBranch",
+			mutate: func(block *api.BitcoinBlock) {
+				// The coinbase script commits to the block hash rather than a chain merkle root.
+				block.Header.AuxPow.ChainMerkleBranch = []string{block.Transactions[0].TransactionId}
+			},
+			expected: ErrInvalidAuxPow,
+		},
+		{
+			name: "coinbaseTransaction",
+			mutate: func(block *api.BitcoinBlock) {
+				block.Header.AuxPow.CoinbaseTransaction.Hex = block.Transactions[0].Hex
+			},
+			expected: ErrInvalidAuxPow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			block := proto.Clone(nativeBlock).(*api.NativeBlock)
+			test.mutate(block.GetBitcoin())
+			err := validator.ValidateBlock(ctx, block)
+			require.Error(err)
+			require.True(xerrors.Is(err, test.expected), err.Error())
+		})
+	}
+}
+
+func newDogecoinParser(t *testing.T) (internal.NativeParser, internal.TrustlessValidator) {
+	var (
+		parser    internal.NativeParser
+		validator internal.TrustlessValidator
+	)
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_DOGECOIN, common.Network_NETWORK_DOGECOIN_MAINNET),
+		fx.Provide(NewDogecoinNativeParser),
+		fx.Provide(NewDogecoinValidator),
+		fx.Populate(&parser),
+		fx.Populate(&validator),
+	)
+	t.Cleanup(app.Close)
+	require := testutil.Require(t)
+	require.NotNil(parser)
+	require.NotNil(validator)
+	return parser, validator
+}
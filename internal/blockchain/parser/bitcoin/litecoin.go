@@ -0,0 +1,15 @@
+package bitcoin
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+func NewLitecoinNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	// Litecoin shares the same data schema as Bitcoin.
+	// The differences, e.g. address encoding and scrypt pow, are captured by the chain params of the network.
+	return NewBitcoinNativeParser(params, opts...)
+}
+
+func NewLitecoinValidator(params internal.ParserParams) internal.TrustlessValidator {
+	return NewBitcoinValidator(params)
+}
@@ -0,0 +1,94 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"go.uber.org/fx"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+)
+
+func TestLitecoinParser_GenesisBlock(t *testing.T) {
+	require := testutil.Require(t)
+
+	var (
+		parser    internal.NativeParser
+		validator internal.TrustlessValidator
+	)
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_LITECOIN, common.Network_NETWORK_LITECOIN_MAINNET),
+		fx.Provide(NewLitecoinNativeParser),
+		fx.Provide(NewLitecoinValidator),
+		fx.Populate(&parser),
+		fx.Populate(&validator),
+	)
+	defer app.Close()
+
+	ctx := context.Background()
+	rawBlock, err := testutil.LoadRawBlock("parser/litecoin/raw_block_0.json")
+	require.NoError(err)
+
+	nativeBlock, err := parser.ParseBlock(ctx, rawBlock)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_LITECOIN, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_LITECOIN_MAINNET, nativeBlock.Network)
+	require.Equal("12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2", nativeBlock.Hash)
+
+	// The node does not return the address of the pubkey script, which is derived with the litecoin version byte.
+	output := nativeBlock.GetBitcoin().Transactions[0].Outputs[0]
+	require.Equal(uint64(5_000_000_000), output.Value)
+	require.Equal(bitcoinScriptTypePubKey, output.ScriptPublicKey.Type)
+	require.Equal("Ler4HNAEfwYhBmGXcFP2Po1NpRUEiK8km2", output.ScriptPublicKey.Address)
+
+	err = validator.ValidateBlock(ctx, nativeBlock)
+	require.NoError(err)
+
+	// The block hash itself does not meet the target.
+	err = newBitcoinParser(t).ValidateBlock(ctx, nativeBlock)
+	require.Error(err)
+	require.True(xerrors.Is(err, ErrInvalidProofOfWork), err.Error())
+}
+
+func TestLitecoinParser_MwebScriptTypes(t *testing.T) {
+	// There is no post-MWEB block among the fixtures yet, so the script type of the genesis output is rewritten instead.
+	// This only covers the script types returned by the node since MWEB; a real block after the activation should
+	// be added once captured.
+	tests := []string{
+		bitcoinScriptTypeWitnessMwebPegin,
+		bitcoinScriptTypeWitnessMwebHogAddr,
+	}
+	for _, scriptType := range tests {
+		t.Run(scriptType, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			var parser internal.NativeParser
+			app := testapp.New(
+				t,
+				testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_LITECOIN, common.Network_NETWORK_LITECOIN_MAINNET),
+				fx.Provide(NewLitecoinNativeParser),
+				fx.Populate(&parser),
+			)
+			defer app.Close()
+
+			rawBlock, err := testutil.LoadRawBlock("parser/litecoin/raw_block_0.json")
+			require.NoError(err)
+			blobdata := rawBlock.GetBitcoin()
+			blobdata.Header = bytes.ReplaceAll(blobdata.Header, []byte(`"type":"pubkey"`), []byte(`"type":"`+scriptType+`"`))
+
+			nativeBlock, err := parser.ParseBlock(context.Background(), rawBlock)
+			require.NoError(err)
+
+			// The MWEB scripts do not have an address.
+			output := nativeBlock.GetBitcoin().Transactions[0].Outputs[0]
+			require.Equal(scriptType, output.ScriptPublicKey.Type)
+			require.Empty(output.ScriptPublicKey.Address)
+		})
+	}
+}
@@ -10,5 +10,14 @@ var Module = fx.Options(
 	internal.NewParserBuilder("bitcoin", NewBitcoinNativeParser).
 		SetCheckerFactory(NewBitcoinChecker).
 		SetRosettaParserFactory(NewBitcoinRosettaParser).
+		SetValidatorFactory(NewBitcoinValidator).
+		Build(),
+	internal.NewParserBuilder("dogecoin", NewDogecoinNativeParser).
+		SetCheckerFactory(NewBitcoinChecker).
+		SetValidatorFactory(NewDogecoinValidator).
+		Build(),
+	internal.NewParserBuilder("litecoin", NewLitecoinNativeParser).
+		SetCheckerFactory(NewBitcoinChecker).
+		SetValidatorFactory(NewLitecoinValidator).
 		Build(),
 )
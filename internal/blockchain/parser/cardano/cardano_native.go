@@ -0,0 +1,411 @@
+package cardano
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+// The blocks are fetched from cardano-rosetta (https://github.com/cardano-foundation/cardano-rosetta),
+// which reports the UTXO inputs/outputs, the staking certificates and the reward withdrawals as operations.
+// Only the fields used by the parser are declared below.
+
+type (
+	nativeParserImpl struct {
+		logger   *zap.Logger
+		validate *validator.Validate
+	}
+
+	// BlockResponse is the response of /block.
+	BlockResponse struct {
+		Block *Block `json:"block" validate:"required"`
+	}
+
+	Block struct {
+		BlockIdentifier       *BlockIdentifier `json:"block_identifier" validate:"required"`
+		ParentBlockIdentifier *BlockIdentifier `json:"parent_block_identifier" validate:"required"`
+		// Timestamp is in milliseconds.
+		Timestamp    int64          `json:"timestamp"`
+		Transactions []*Transaction `json:"transactions" validate:"dive"`
+		Metadata     *BlockMetadata `json:"metadata" validate:"required"`
+	}
+
+	BlockIdentifier struct {
+		Index uint64 `json:"index"`
+		Hash  string `json:"hash" validate:"required"`
+	}
+
+	BlockMetadata struct {
+		TransactionsCount uint64 `json:"transactionsCount"`
+		CreatedBy         string `json:"createdBy"`
+		Size              uint64 `json:"size"`
+		EpochNo           uint64 `json:"epochNo"`
+		SlotNo            uint64 `json:"slotNo"`
+	}
+
+	// BlockTransactionResponse is the response of /block/transaction,
+	// which is used for the transactions not included in the block response.
+	BlockTransactionResponse struct {
+		Transaction *Transaction `json:"transaction" validate:"required"`
+	}
+
+	Transaction struct {
+		TransactionIdentifier *TransactionIdentifier `json:"transaction_identifier" validate:"required"`
+		Operations            []*Operation           `json:"operations" validate:"dive"`
+		Metadata              *TransactionMetadata   `json:"metadata"`
+	}
+
+	TransactionIdentifier struct {
+		Hash string `json:"hash" validate:"required"`
+	}
+
+	TransactionMetadata struct {
+		Size       uint64 `json:"size"`
+		ScriptSize uint64 `json:"scriptSize"`
+	}
+
+	Operation struct {
+		OperationIdentifier *OperationIdentifier `json:"operation_identifier" validate:"required"`
+		Type                string               `json:"type" validate:"required"`
+		Account             *AccountIdentifier   `json:"account"`
+		Amount              *Amount              `json:"amount"`
+		CoinChange          *CoinChange          `json:"coin_change"`
+		Metadata            *OperationMetadata   `json:"metadata"`
+	}
+
+	OperationIdentifier struct {
+		Index uint64 `json:"index"`
+	}
+
+	AccountIdentifier struct {
+		Address string `json:"address"`
+	}
+
+	Amount struct {
+		// Value is a signed integer encoded as a decimal string, e.g. the inputs have negative values.
+		Value string `json:"value"`
+	}
+
+	CoinChange struct {
+		CoinIdentifier *CoinIdentifier `json:"coin_identifier"`
+	}
+
+	CoinIdentifier struct {
+		Identifier string `json:"identifier"`
+	}
+
+	OperationMetadata struct {
+		StakingCredential *PublicKey `json:"staking_credential"`
+		PoolKeyHash       string     `json:"pool_key_hash"`
+		DepositAmount     *Amount    `json:"depositAmount"`
+		RefundAmount      *Amount    `json:"refundAmount"`
+		WithdrawalAmount  *Amount    `json:"withdrawalAmount"`
+		Epoch             uint64     `json:"epoch"`
+	}
+
+	PublicKey struct {
+		HexBytes string `json:"hex_bytes"`
+	}
+)
+
+const (
+	OperationTypeInput                    = "input"
+	OperationTypeOutput                   = "output"
+	OperationTypeStakeKeyRegistration     = "stakeKeyRegistration"
+	OperationTypeStakeKeyDeregistration   = "stakeKeyDeregistration"
+	OperationTypeStakeDelegation          = "stakeDelegation"
+	OperationTypeWithdrawal               = "withdrawal"
+	OperationTypePoolRegistration         = "poolRegistration"
+	OperationTypePoolRegistrationWithCert = "poolRegistrationWithCert"
+	OperationTypePoolRetirement           = "poolRetirement"
+)
+
+func NewNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	return &nativeParserImpl{
+		logger:   log.WithPackage(params.Logger),
+		validate: validator.New(),
+	}, nil
+}
+
+// GetValue returns the absolute value of the amount in lovelace.
+func (a *Amount) GetValue() (uint64, error) {
+	if a == nil {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(strings.TrimPrefix(a.Value, "-"), 10, 64)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to parse amount %v: %w", a.Value, err)
+	}
+
+	return value, nil
+}
+
+func (o *Operation) GetAddress() string {
+	if o.Account == nil {
+		return ""
+	}
+
+	return o.Account.Address
+}
+
+func (o *Operation) GetCoinIdentifier() string {
+	if o.CoinChange == nil || o.CoinChange.CoinIdentifier == nil {
+		return ""
+	}
+
+	return o.CoinChange.CoinIdentifier.Identifier
+}
+
+func (o *Operation) GetStakingCredential() string {
+	if o.Metadata == nil || o.Metadata.StakingCredential == nil {
+		return ""
+	}
+
+	return o.Metadata.StakingCredential.HexBytes
+}
+
+func (p *nativeParserImpl) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+	metadata := rawBlock.GetMetadata()
+	if metadata == nil {
+		return nil, xerrors.New("metadata not found")
+	}
+
+	blobdata := rawBlock.GetRosetta()
+	if blobdata == nil {
+		return nil, xerrors.Errorf("blobdata not found (metadata={%+v})", metadata)
+	}
+
+	var response BlockResponse
+	if err := json.Unmarshal(blobdata.Header, &response); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block (metadata={%+v}): %w", metadata, err)
+	}
+
+	if err := p.validate.Struct(response); err != nil {
+		return nil, xerrors.Errorf("failed to validate block (metadata={%+v}): %w", metadata, err)
+	}
+
+	block := response.Block
+	header, err := p.parseHeader(block, metadata)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse header (metadata={%+v}): %w", metadata, err)
+	}
+
+	rawTransactions := block.Transactions
+	for i, data := range blobdata.OtherTransactions {
+		var transaction BlockTransactionResponse
+		if err := json.Unmarshal(data, &transaction); err != nil {
+			return nil, xerrors.Errorf("failed to unmarshal other transaction %v (metadata={%+v}): %w", i, metadata, err)
+		}
+
+		if err := p.validate.Struct(transaction); err != nil {
+			return nil, xerrors.Errorf("failed to validate other transaction %v (metadata={%+v}): %w", i, metadata, err)
+		}
+
+		rawTransactions = append(rawTransactions, transaction.Transaction)
+	}
+
+	if uint64(len(rawTransactions)) != header.TransactionsCount {
+		return nil, xerrors.Errorf(
+			"unexpected number of transactions (metadata={%+v}): expected=%v, actual=%v",
+			metadata, header.TransactionsCount, len(rawTransactions),
+		)
+	}
+
+	transactions := make([]*api.CardanoTransaction, len(rawTransactions))
+	for i, rawTransaction := range rawTransactions {
+		transaction, err := p.parseTransaction(rawTransaction, uint64(i))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to parse transaction %v (metadata={%+v}): %w", i, metadata, err)
+		}
+
+		transactions[i] = transaction
+	}
+
+	return &api.NativeBlock{
+		Blockchain:      rawBlock.Blockchain,
+		Network:         rawBlock.Network,
+		SideChain:       rawBlock.SideChain,
+		Tag:             metadata.Tag,
+		Hash:            metadata.Hash,
+		ParentHash:      metadata.ParentHash,
+		Height:          metadata.Height,
+		ParentHeight:    metadata.ParentHeight,
+		Timestamp:       metadata.Timestamp,
+		NumTransactions: uint64(len(transactions)),
+		Block: &api.NativeBlock_Cardano{
+			Cardano: &api.CardanoBlock{
+				Header:       header,
+				Transactions: transactions,
+			},
+		},
+	}, nil
+}
+
+func (p *nativeParserImpl) GetTransaction(ctx context.Context, nativeBlock *api.NativeBlock, transactionHash string) (*api.NativeTransaction, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (p *nativeParserImpl) parseHeader(block *Block, metadata *api.BlockMetadata) (*api.CardanoHeader, error) {
+	height := block.BlockIdentifier.Index
+	if height != metadata.Height {
+		return nil, xerrors.Errorf("unexpected height in header: expected=%v, actual=%v", metadata.Height, height)
+	}
+
+	hash := block.BlockIdentifier.Hash
+	if hash != metadata.Hash {
+		return nil, xerrors.Errorf("unexpected hash in header: expected=%v, actual=%v", metadata.Hash, hash)
+	}
+
+	return &api.CardanoHeader{
+		Hash:              hash,
+		Height:            height,
+		ParentHash:        block.ParentBlockIdentifier.Hash,
+		Timestamp:         timestamppb.New(time.UnixMilli(block.Timestamp)),
+		Epoch:             block.Metadata.EpochNo,
+		Slot:              block.Metadata.SlotNo,
+		CreatedBy:         block.Metadata.CreatedBy,
+		Size:              block.Metadata.Size,
+		TransactionsCount: block.Metadata.TransactionsCount,
+	}, nil
+}
+
+func (p *nativeParserImpl) parseTransaction(rawTransaction *Transaction, index uint64) (*api.CardanoTransaction, error) {
+	transaction := &api.CardanoTransaction{
+		Hash:  rawTransaction.TransactionIdentifier.Hash,
+		Index: index,
+	}
+
+	if rawTransaction.Metadata != nil {
+		transaction.Size = rawTransaction.Metadata.Size
+		transaction.ScriptSize = rawTransaction.Metadata.ScriptSize
+	}
+
+	for _, operation := range rawTransaction.Operations {
+		if err := p.parseOperation(operation, transaction); err != nil {
+			return nil, xerrors.Errorf("failed to parse operation %v of type %v: %w", operation.OperationIdentifier.Index, operation.Type, err)
+		}
+	}
+
+	return transaction, nil
+}
+
+func (p *nativeParserImpl) parseOperation(operation *Operation, transaction *api.CardanoTransaction) error {
+	opMetadata := operation.Metadata
+	if opMetadata == nil {
+		opMetadata = &OperationMetadata{}
+	}
+
+	switch operation.Type {
+	case OperationTypeInput:
+		amount, err := operation.Amount.GetValue()
+		if err != nil {
+			return err
+		}
+
+		transaction.Inputs = append(transaction.Inputs, &api.CardanoTransactionInput{
+			CoinIdentifier: operation.GetCoinIdentifier(),
+			Address:        operation.GetAddress(),
+			Amount:         amount,
+		})
+	case OperationTypeOutput:
+		amount, err := operation.Amount.GetValue()
+		if err != nil {
+			return err
+		}
+
+		transaction.Outputs = append(transaction.Outputs, &api.CardanoTransactionOutput{
+			CoinIdentifier: operation.GetCoinIdentifier(),
+			Address:        operation.GetAddress(),
+			Amount:         amount,
+		})
+	case OperationTypeWithdrawal:
+		// Older versions of cardano-rosetta report the withdrawn rewards in the amount field.
+		withdrawalAmount := opMetadata.WithdrawalAmount
+		if withdrawalAmount == nil {
+			withdrawalAmount = operation.Amount
+		}
+
+		amount, err := withdrawalAmount.GetValue()
+		if err != nil {
+			return err
+		}
+
+		transaction.Withdrawals = append(transaction.Withdrawals, &api.CardanoWithdrawal{
+			Index:             operation.OperationIdentifier.Index,
+			StakeAddress:      operation.GetAddress(),
+			StakingCredential: operation.GetStakingCredential(),
+			Amount:            amount,
+		})
+	case OperationTypeStakeKeyRegistration:
+		deposit, err := opMetadata.DepositAmount.GetValue()
+		if err != nil {
+			return err
+		}
+
+		transaction.Certificates = append(transaction.Certificates, &api.CardanoCertificate{
+			Type:              api.CardanoCertificate_STAKE_KEY_REGISTRATION,
+			Index:             operation.OperationIdentifier.Index,
+			StakeAddress:      operation.GetAddress(),
+			StakingCredential: operation.GetStakingCredential(),
+			Deposit:           deposit,
+		})
+	case OperationTypeStakeKeyDeregistration:
+		refund, err := opMetadata.RefundAmount.GetValue()
+		if err != nil {
+			return err
+		}
+
+		transaction.Certificates = append(transaction.Certificates, &api.CardanoCertificate{
+			Type:              api.CardanoCertificate_STAKE_KEY_DEREGISTRATION,
+			Index:             operation.OperationIdentifier.Index,
+			StakeAddress:      operation.GetAddress(),
+			StakingCredential: operation.GetStakingCredential(),
+			Refund:            refund,
+		})
+	case OperationTypeStakeDelegation:
+		transaction.Certificates = append(transaction.Certificates, &api.CardanoCertificate{
+			Type:              api.CardanoCertificate_STAKE_DELEGATION,
+			Index:             operation.OperationIdentifier.Index,
+			StakeAddress:      operation.GetAddress(),
+			StakingCredential: operation.GetStakingCredential(),
+			PoolKeyHash:       opMetadata.PoolKeyHash,
+		})
+	case OperationTypePoolRegistration, OperationTypePoolRegistrationWithCert:
+		// The account of the pool operations is the pool key hash.
+		deposit, err := opMetadata.DepositAmount.GetValue()
+		if err != nil {
+			return err
+		}
+
+		transaction.Certificates = append(transaction.Certificates, &api.CardanoCertificate{
+			Type:        api.CardanoCertificate_POOL_REGISTRATION,
+			Index:       operation.OperationIdentifier.Index,
+			PoolKeyHash: operation.GetAddress(),
+			Deposit:     deposit,
+		})
+	case OperationTypePoolRetirement:
+		transaction.Certificates = append(transaction.Certificates, &api.CardanoCertificate{
+			Type:            api.CardanoCertificate_POOL_RETIREMENT,
+			Index:           operation.OperationIdentifier.Index,
+			PoolKeyHash:     operation.GetAddress(),
+			RetirementEpoch: opMetadata.Epoch,
+		})
+	default:
+		// Other operations, e.g. the vote registrations, are not indexed.
+		p.logger.Debug("skipping unsupported operation", zap.String("type", operation.Type), zap.String("hash", transaction.Hash))
+	}
+
+	return nil
+}
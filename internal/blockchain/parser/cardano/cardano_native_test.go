@@ -0,0 +1,217 @@
+package cardano
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type cardanoNativeParserTestSuite struct {
+	suite.Suite
+
+	app    testapp.TestApp
+	parser internal.NativeParser
+}
+
+// The fixtures are NOT a mainnet block: the cardano-rosetta responses of the block and of its other transaction were
+// written by hand, and the heights, the hashes and the timestamps below are made up. They only exercise the parsing of
+// the operations and should be replaced with the `/block` and `/block/transaction` responses of a real block.
+const (
+	cardanoTag          = uint32(1)
+	cardanoHeight       = uint64(10000001)
+	cardanoParentHeight = uint64(10000000)
+	cardanoHash         = "9e2b1f4c6d8a0e3b5f7c9a1d3e5b7f9c1a3e5d7b9f1c3a5e7d9b1f3c5a7e9d1b"
+	cardanoParentHash   = "4c2e8a6b0d4f2e6c8a0b2d4f6e8c0a2b4d6f8e0c2a4b6d8f0e2c4a6b8d0f2e4c"
+	cardanoTimestamp    = "2024-01-15T08:30:12Z"
+
+	cardanoAddress      = "addr1q9ld26v2lv8wvrxxmvg90pn8n8n5k6tdst06q2s856rwmvnueldzuuqmnsye359fqrk8hwvenjnqultn7djtrlft7jnq7dy7wv"
+	cardanoStakeAddress = "stake1u8e8m9w6gzp7t4gxrtzk8ya9cfrqzqmqqdvs6rxnhkd6e8qhp0nzc"
+	cardanoCredential   = "1b400d60aaf34eaf6dcbab9bba46001a23497886cf11066f7846933d30e5ad3f"
+)
+
+func TestCardanoNativeParserTestSuite(t *testing.T) {
+	suite.Run(t, new(cardanoNativeParserTestSuite))
+}
+
+func (s *cardanoNativeParserTestSuite) SetupTest() {
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_CARDANO, common.Network_NETWORK_CARDANO_MAINNET),
+		fx.Provide(NewNativeParser),
+		fx.Populate(&s.parser),
+	)
+	s.NotNil(s.parser)
+}
+
+func (s *cardanoNativeParserTestSuite) TearDownTest() {
+	s.app.Close()
+}
+
+func (s *cardanoNativeParserTestSuite) TestParseBlock() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/cardano/synthetic_block.json"), fixtures.MustReadFile("parser/cardano/synthetic_other_transaction.json"))
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	expectedTimestamp := testutil.MustTimestamp(cardanoTimestamp)
+	require.Equal(common.Blockchain_BLOCKCHAIN_CARDANO, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_CARDANO_MAINNET, nativeBlock.Network)
+	require.Equal(cardanoTag, nativeBlock.Tag)
+	require.Equal(cardanoHeight, nativeBlock.Height)
+	require.Equal(cardanoParentHeight, nativeBlock.ParentHeight)
+	require.Equal(cardanoHash, nativeBlock.Hash)
+	require.Equal(cardanoParentHash, nativeBlock.ParentHash)
+	require.Equal(expectedTimestamp, nativeBlock.Timestamp)
+	require.Equal(uint64(3), nativeBlock.NumTransactions)
+	require.False(nativeBlock.Skipped)
+
+	cardanoBlock := nativeBlock.GetCardano()
+	require.NotNil(cardanoBlock)
+	require.Equal(&api.CardanoHeader{
+		Hash:              cardanoHash,
+		Height:            cardanoHeight,
+		ParentHash:        cardanoParentHash,
+		Timestamp:         expectedTimestamp,
+		Epoch:             462,
+		Slot:              114741321,
+		CreatedBy:         "pool1xj0dk0aejyhyqmfnhf0rkcq6yd3tdwlnfqfvsxf7lgqzq5zhc8v",
+		Size:              2048,
+		TransactionsCount: 3,
+	}, cardanoBlock.Header)
+
+	transactions := cardanoBlock.Transactions
+	require.Equal(3, len(transactions))
+
+	// Register a stake key and delegate it to a pool.
+	tx := transactions[0]
+	require.Equal("3a5b6b4b8f2cce8e7e2a5a4d4f1e3c6f0a5c2d1e9b8a7c6d5e4f3a2b1c0d9e8f", tx.Hash)
+	require.Equal(uint64(0), tx.Index)
+	require.Equal(uint64(430), tx.Size)
+	require.Equal([]*api.CardanoTransactionInput{
+		{
+			CoinIdentifier: "0b8e6c4a2e0d8f6b4a2c0e8d6f4b2a0c8e6d4f2b0a8c6e4d2f0b8a6c4e2d0f8b:1",
+			Address:        cardanoAddress,
+			Amount:         12000000,
+		},
+	}, tx.Inputs)
+	require.Equal([]*api.CardanoTransactionOutput{
+		{
+			CoinIdentifier: "3a5b6b4b8f2cce8e7e2a5a4d4f1e3c6f0a5c2d1e9b8a7c6d5e4f3a2b1c0d9e8f:0",
+			Address:        cardanoAddress,
+			Amount:         9821869,
+		},
+	}, tx.Outputs)
+	require.Equal([]*api.CardanoCertificate{
+		{
+			Type:              api.CardanoCertificate_STAKE_KEY_REGISTRATION,
+			Index:             1,
+			StakeAddress:      cardanoStakeAddress,
+			StakingCredential: cardanoCredential,
+			Deposit:           2000000,
+		},
+		{
+			Type:              api.CardanoCertificate_STAKE_DELEGATION,
+			Index:             2,
+			StakeAddress:      cardanoStakeAddress,
+			StakingCredential: cardanoCredential,
+			PoolKeyHash:       "9c35a7b1d0d6e8a3c5f7d4b4f1c2e3a4b5c6d7e8f9a0b1c2d3e4f5a6",
+		},
+	}, tx.Certificates)
+	require.Empty(tx.Withdrawals)
+
+	// Withdraw the rewards and deregister the stake key.
+	tx = transactions[1]
+	require.Equal(uint64(1), tx.Index)
+	require.Equal(1, len(tx.Inputs))
+	require.Equal(2, len(tx.Outputs))
+	require.Equal([]*api.CardanoWithdrawal{
+		{
+			Index:             1,
+			StakeAddress:      "stake1uy6yzwsxxc28lfms0qmpxvyz9a7y770rtcqx9y96m42cgqslv9yxe",
+			StakingCredential: "bf1a1d5e1b4f4b9e1d6f6c7c6f8a9e0d1c2b3a4f5e6d7c8b9a0f1e2d3c4b5a69",
+			Amount:            1234567,
+		},
+	}, tx.Withdrawals)
+	require.Equal(1, len(tx.Certificates))
+	require.Equal(api.CardanoCertificate_STAKE_KEY_DEREGISTRATION, tx.Certificates[0].Type)
+	require.Equal(uint64(2000000), tx.Certificates[0].Refund)
+
+	// The transaction from other_transactions is appended to the block.
+	tx = transactions[2]
+	require.Equal("7f1e9d3c5b7a2e4c6d8f0a1b3c5d7e9f2a4b6c8d0e1f3a5b7c9d2e4f6a8b0c1d", tx.Hash)
+	require.Equal(uint64(2), tx.Index)
+	require.Equal([]*api.CardanoCertificate{
+		{
+			Type:            api.CardanoCertificate_POOL_RETIREMENT,
+			Index:           1,
+			PoolKeyHash:     "0f292fcaa02b8b2f9b3c8f9fd8e0bb21abedb692a6d5058df3ef2735",
+			RetirementEpoch: 465,
+		},
+	}, tx.Certificates)
+}
+
+func (s *cardanoNativeParserTestSuite) TestParseBlock_UnexpectedHash() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/cardano/synthetic_block.json"), fixtures.MustReadFile("parser/cardano/synthetic_other_transaction.json"))
+	block.Metadata.Hash = cardanoParentHash
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected hash in header")
+}
+
+func (s *cardanoNativeParserTestSuite) TestParseBlock_MissingOtherTransactions() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/cardano/synthetic_block.json"))
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected number of transactions")
+}
+
+func (s *cardanoNativeParserTestSuite) TestParseBlock_InvalidAmount() {
+	require := testutil.Require(s.T())
+
+	var response BlockResponse
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("parser/cardano/synthetic_block.json"), &response))
+	response.Block.Transactions[1].Operations[1].Metadata.WithdrawalAmount.Value = "1.5"
+	data, err := json.Marshal(response)
+	require.NoError(err)
+
+	block := s.newBlock(data, fixtures.MustReadFile("parser/cardano/synthetic_other_transaction.json"))
+	_, err = s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "failed to parse operation 1 of type withdrawal")
+}
+
+func (s *cardanoNativeParserTestSuite) newBlock(header []byte, otherTransactions ...[]byte) *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_CARDANO,
+		Network:    common.Network_NETWORK_CARDANO_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          cardanoTag,
+			Hash:         cardanoHash,
+			ParentHash:   cardanoParentHash,
+			Height:       cardanoHeight,
+			ParentHeight: cardanoParentHeight,
+			Timestamp:    testutil.MustTimestamp(cardanoTimestamp),
+		},
+		Blobdata: &api.Block_Rosetta{
+			Rosetta: &api.RosettaBlobdata{
+				Header:            header,
+				OtherTransactions: otherTransactions,
+			},
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package cardano
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/rosetta"
+)
+
+var Module = fx.Options(
+	internal.NewParserBuilder("cardano/staking", NewNativeParser).
+		SetRosettaParserFactory(rosetta.NewRosettaRosettaParser).
+		SetCheckerFactory(rosetta.NewRosettaChecker).
+		Build(),
+)
@@ -0,0 +1,379 @@
+package cosmos
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	nativeParserImpl struct {
+		logger   *zap.Logger
+		validate *validator.Validate
+		config   *config.Config
+	}
+
+	// Quantity is a uint64 encoded as a decimal string, which is how the Tendermint/CometBFT RPC encodes the 64-bit integers.
+	Quantity uint64
+
+	// BlockResponse is the response of /block?height={height}.
+	BlockResponse struct {
+		Result *BlockResult `json:"result" validate:"required"`
+	}
+
+	BlockResult struct {
+		BlockId *BlockId `json:"block_id" validate:"required"`
+		Block   *Block   `json:"block" validate:"required"`
+	}
+
+	BlockId struct {
+		Hash string `json:"hash"`
+	}
+
+	Block struct {
+		Header *Header    `json:"header" validate:"required"`
+		Data   *BlockData `json:"data" validate:"required"`
+	}
+
+	Header struct {
+		ChainId            string   `json:"chain_id" validate:"required"`
+		Height             Quantity `json:"height"`
+		Time               string   `json:"time" validate:"required"`
+		LastBlockId        *BlockId `json:"last_block_id"`
+		LastCommitHash     string   `json:"last_commit_hash"`
+		DataHash           string   `json:"data_hash"`
+		ValidatorsHash     string   `json:"validators_hash"`
+		NextValidatorsHash string   `json:"next_validators_hash"`
+		ConsensusHash      string   `json:"consensus_hash"`
+		AppHash            string   `json:"app_hash"`
+		LastResultsHash    string   `json:"last_results_hash"`
+		EvidenceHash       string   `json:"evidence_hash"`
+		ProposerAddress    string   `json:"proposer_address"`
+	}
+
+	BlockData struct {
+		// Raw transactions encoded in base64.
+		Txs []string `json:"txs"`
+	}
+
+	// BlockResultsResponse is the response of /block_results?height={height}.
+	BlockResultsResponse struct {
+		Result *BlockResults `json:"result" validate:"required"`
+	}
+
+	BlockResults struct {
+		Height     Quantity    `json:"height"`
+		TxsResults []*TxResult `json:"txs_results"`
+		// BeginBlockEvents and EndBlockEvents are replaced by FinalizeBlockEvents since CometBFT v0.38.
+		BeginBlockEvents    []*Event `json:"begin_block_events"`
+		EndBlockEvents      []*Event `json:"end_block_events"`
+		FinalizeBlockEvents []*Event `json:"finalize_block_events"`
+	}
+
+	TxResult struct {
+		Code      uint32   `json:"code"`
+		Codespace string   `json:"codespace"`
+		Log       string   `json:"log"`
+		GasWanted Quantity `json:"gas_wanted"`
+		GasUsed   Quantity `json:"gas_used"`
+		Events    []*Event `json:"events"`
+	}
+
+	Event struct {
+		Type       string            `json:"type"`
+		Attributes []*EventAttribute `json:"attributes"`
+	}
+
+	// EventAttribute is returned as is.
+	// Note that the keys and values are base64-encoded by the Tendermint versions prior to v0.37.
+	EventAttribute struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+		Index bool   `json:"index"`
+	}
+
+	// StatusResponse is the response of /status.
+	StatusResponse struct {
+		Result *Status `json:"result" validate:"required"`
+	}
+
+	Status struct {
+		SyncInfo *SyncInfo `json:"sync_info" validate:"required"`
+	}
+
+	SyncInfo struct {
+		LatestBlockHash   string   `json:"latest_block_hash"`
+		LatestBlockHeight Quantity `json:"latest_block_height"`
+	}
+
+	// BlockchainResponse is the response of /blockchain?minHeight={minHeight}&maxHeight={maxHeight}.
+	BlockchainResponse struct {
+		Result *Blockchain `json:"result" validate:"required"`
+	}
+
+	Blockchain struct {
+		LastHeight Quantity `json:"last_height"`
+		// Block metas are sorted by height in descending order.
+		BlockMetas []*BlockMeta `json:"block_metas"`
+	}
+
+	BlockMeta struct {
+		BlockId *BlockId `json:"block_id" validate:"required"`
+		Header  *Header  `json:"header" validate:"required"`
+		NumTxs  Quantity `json:"num_txs"`
+	}
+)
+
+func NewNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	return &nativeParserImpl{
+		logger:   log.WithPackage(params.Logger),
+		validate: validator.New(),
+		config:   params.Config,
+	}, nil
+}
+
+func (v Quantity) MarshalJSON() ([]byte, error) {
+	s := fmt.Sprintf(`"%d"`, uint64(v))
+	return []byte(s), nil
+}
+
+func (v *Quantity) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return xerrors.Errorf("failed to unmarshal Quantity into string: %w", err)
+	}
+
+	if s == "" {
+		*v = 0
+		return nil
+	}
+
+	i, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return xerrors.Errorf("failed to decode Quantity %v: %w", s, err)
+	}
+
+	*v = Quantity(i)
+	return nil
+}
+
+func (v Quantity) Value() uint64 {
+	return uint64(v)
+}
+
+// GetParentHash returns the hash of the previous block.
+// It is empty for the first block of the chain.
+func (h *Header) GetParentHash() string {
+	if h.LastBlockId == nil {
+		return ""
+	}
+
+	return h.LastBlockId.Hash
+}
+
+// GetTimestamp parses the block time, which is encoded in RFC 3339 with nanoseconds.
+func (h *Header) GetTimestamp() (*timestamppb.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339Nano, h.Time)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse block time %v: %w", h.Time, err)
+	}
+
+	return timestamppb.New(t), nil
+}
+
+func (p *nativeParserImpl) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+	metadata := rawBlock.GetMetadata()
+	if metadata == nil {
+		return nil, xerrors.New("metadata not found")
+	}
+
+	blobdata := rawBlock.GetCosmos()
+	if blobdata == nil {
+		return nil, xerrors.Errorf("blobdata not found (metadata={%+v})", metadata)
+	}
+
+	var block BlockResponse
+	if err := json.Unmarshal(blobdata.Block, &block); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block (metadata={%+v}): %w", metadata, err)
+	}
+
+	if err := p.validate.Struct(block); err != nil {
+		return nil, xerrors.Errorf("failed to validate block (metadata={%+v}): %w", metadata, err)
+	}
+
+	var blockResults BlockResultsResponse
+	if err := json.Unmarshal(blobdata.BlockResults, &blockResults); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block results (metadata={%+v}): %w", metadata, err)
+	}
+
+	if err := p.validate.Struct(blockResults); err != nil {
+		return nil, xerrors.Errorf("failed to validate block results (metadata={%+v}): %w", metadata, err)
+	}
+
+	header, err := p.parseHeader(block.Result, metadata)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse header (metadata={%+v}): %w", metadata, err)
+	}
+
+	transactions, err := p.parseTransactions(block.Result.Block.Data, blockResults.Result, metadata)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse transactions (metadata={%+v}): %w", metadata, err)
+	}
+
+	results := blockResults.Result
+	var blockEvents []*api.CosmosEvent
+	blockEvents = append(blockEvents, p.parseEvents(results.BeginBlockEvents)...)
+	blockEvents = append(blockEvents, p.parseEvents(results.EndBlockEvents)...)
+	blockEvents = append(blockEvents, p.parseEvents(results.FinalizeBlockEvents)...)
+
+	return &api.NativeBlock{
+		Blockchain:      rawBlock.Blockchain,
+		Network:         rawBlock.Network,
+		SideChain:       rawBlock.SideChain,
+		Tag:             metadata.Tag,
+		Hash:            metadata.Hash,
+		ParentHash:      metadata.ParentHash,
+		Height:          metadata.Height,
+		ParentHeight:    metadata.ParentHeight,
+		Timestamp:       metadata.Timestamp,
+		NumTransactions: uint64(len(transactions)),
+		Block: &api.NativeBlock_Cosmos{
+			Cosmos: &api.CosmosBlock{
+				Header:       header,
+				Transactions: transactions,
+				BlockEvents:  blockEvents,
+			},
+		},
+	}, nil
+}
+
+func (p *nativeParserImpl) GetTransaction(ctx context.Context, nativeBlock *api.NativeBlock, transactionHash string) (*api.NativeTransaction, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (p *nativeParserImpl) parseHeader(result *BlockResult, metadata *api.BlockMetadata) (*api.CosmosHeader, error) {
+	header := result.Block.Header
+	height := header.Height.Value()
+	if height != metadata.Height {
+		return nil, xerrors.Errorf("unexpected height in header: expected=%v, actual=%v", metadata.Height, height)
+	}
+
+	hash := result.BlockId.Hash
+	if hash != metadata.Hash {
+		return nil, xerrors.Errorf("unexpected hash in header: expected=%v, actual=%v", metadata.Hash, hash)
+	}
+
+	timestamp, err := header.GetTimestamp()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get timestamp: %w", err)
+	}
+
+	return &api.CosmosHeader{
+		ChainId:            header.ChainId,
+		Height:             height,
+		Time:               timestamp,
+		Hash:               hash,
+		LastBlockHash:      header.GetParentHash(),
+		LastCommitHash:     header.LastCommitHash,
+		DataHash:           header.DataHash,
+		ValidatorsHash:     header.ValidatorsHash,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ConsensusHash:      header.ConsensusHash,
+		AppHash:            header.AppHash,
+		LastResultsHash:    header.LastResultsHash,
+		EvidenceHash:       header.EvidenceHash,
+		ProposerAddress:    header.ProposerAddress,
+	}, nil
+}
+
+func (p *nativeParserImpl) parseTransactions(data *BlockData, results *BlockResults, metadata *api.BlockMetadata) ([]*api.CosmosTransaction, error) {
+	if results.Height.Value() != metadata.Height {
+		return nil, xerrors.Errorf("unexpected height in block results: expected=%v, actual=%v", metadata.Height, results.Height.Value())
+	}
+
+	if len(data.Txs) != len(results.TxsResults) {
+		return nil, xerrors.Errorf("unexpected number of transaction results: expected=%v, actual=%v", len(data.Txs), len(results.TxsResults))
+	}
+
+	transactions := make([]*api.CosmosTransaction, len(data.Txs))
+	for i, encoded := range data.Txs {
+		rawTx, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode transaction %v: %w", i, err)
+		}
+
+		result := results.TxsResults[i]
+		transaction := &api.CosmosTransaction{
+			Hash:      getTransactionHash(rawTx),
+			Index:     uint64(i),
+			Code:      result.Code,
+			Codespace: result.Codespace,
+			Log:       result.Log,
+			GasWanted: result.GasWanted.Value(),
+			GasUsed:   result.GasUsed.Value(),
+			Events:    p.parseEvents(result.Events),
+		}
+
+		if err := decodeTx(rawTx, transaction); err != nil {
+			if result.Code == 0 {
+				return nil, xerrors.Errorf("failed to decode transaction %v (hash=%v): %w", i, transaction.Hash, err)
+			}
+
+			// A transaction which cannot be decoded by the application may still be included in the block,
+			// in which case it is rejected with a non-zero code.
+			p.logger.Warn(
+				"failed to decode failed transaction",
+				zap.Error(err),
+				zap.String("hash", transaction.Hash),
+				zap.Uint32("code", result.Code),
+			)
+		}
+
+		transactions[i] = transaction
+	}
+
+	return transactions, nil
+}
+
+func (p *nativeParserImpl) parseEvents(events []*Event) []*api.CosmosEvent {
+	result := make([]*api.CosmosEvent, len(events))
+	for i, event := range events {
+		attributes := make([]*api.CosmosEventAttribute, len(event.Attributes))
+		for j, attribute := range event.Attributes {
+			attributes[j] = &api.CosmosEventAttribute{
+				Key:   attribute.Key,
+				Value: attribute.Value,
+				Index: attribute.Index,
+			}
+		}
+
+		result[i] = &api.CosmosEvent{
+			Type:       event.Type,
+			Attributes: attributes,
+		}
+	}
+
+	return result
+}
+
+// getTransactionHash returns the hash of the raw transaction as displayed by the Tendermint/CometBFT RPC.
+func getTransactionHash(rawTx []byte) string {
+	hash := sha256.Sum256(rawTx)
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
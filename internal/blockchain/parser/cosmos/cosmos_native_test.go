@@ -0,0 +1,238 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type cosmosNativeParserTestSuite struct {
+	suite.Suite
+
+	app    testapp.TestApp
+	parser internal.NativeParser
+}
+
+// The fixtures are NOT a cosmoshub-4 block: the block and its results were written by hand, and the heights, the hashes
+// and the timestamps below are made up. The transactions are valid encodings of the staking messages, hence they only
+// exercise the decoding. They should be replaced with the `/block` and `/block_results` responses of a real block.
+const (
+	cosmosTag          = uint32(1)
+	cosmosHeight       = uint64(19000001)
+	cosmosParentHeight = uint64(19000000)
+	cosmosHash         = "8E5B2D3C6A0F7A8FD5B0B1E6C0C7B6E2D6E1A0F3C4B5A69788796A5B4C3D2E1F"
+	cosmosParentHash   = "5F4A3B2C1D0E9F8A7B6C5D4E3F2A1B0C9D8E7F6A5B4C3D2E1F0A9B8C7D6E5F4A"
+	cosmosTimestamp    = "2024-01-15T08:30:12.345678901Z"
+
+	cosmosDelegator  = "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	cosmosDelegator2 = "cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5"
+	cosmosValidator  = "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	cosmosValidator2 = "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"
+
+	// A truncated varint, which cannot be decoded.
+	invalidTransaction = "gA=="
+)
+
+func TestCosmosNativeParserTestSuite(t *testing.T) {
+	suite.Run(t, new(cosmosNativeParserTestSuite))
+}
+
+func (s *cosmosNativeParserTestSuite) SetupTest() {
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_COSMOS, common.Network_NETWORK_COSMOS_MAINNET),
+		fx.Provide(NewNativeParser),
+		fx.Populate(&s.parser),
+	)
+	s.NotNil(s.parser)
+}
+
+func (s *cosmosNativeParserTestSuite) TearDownTest() {
+	s.app.Close()
+}
+
+func (s *cosmosNativeParserTestSuite) TestParseBlock() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/cosmos/synthetic_block.json"), fixtures.MustReadFile("parser/cosmos/synthetic_block_results.json"))
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	expectedTimestamp := testutil.MustTimestamp(cosmosTimestamp)
+	require.Equal(common.Blockchain_BLOCKCHAIN_COSMOS, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_COSMOS_MAINNET, nativeBlock.Network)
+	require.Equal(cosmosTag, nativeBlock.Tag)
+	require.Equal(cosmosHeight, nativeBlock.Height)
+	require.Equal(cosmosParentHeight, nativeBlock.ParentHeight)
+	require.Equal(cosmosHash, nativeBlock.Hash)
+	require.Equal(cosmosParentHash, nativeBlock.ParentHash)
+	require.Equal(expectedTimestamp, nativeBlock.Timestamp)
+	require.Equal(uint64(4), nativeBlock.NumTransactions)
+	require.False(nativeBlock.Skipped)
+
+	cosmosBlock := nativeBlock.GetCosmos()
+	require.NotNil(cosmosBlock)
+
+	header := cosmosBlock.Header
+	require.Equal("cosmoshub-4", header.ChainId)
+	require.Equal(cosmosHeight, header.Height)
+	require.Equal(expectedTimestamp, header.Time)
+	require.Equal(cosmosHash, header.Hash)
+	require.Equal(cosmosParentHash, header.LastBlockHash)
+	require.Equal("83F47D7747B0F633A6BA0DF49B7DCF61F90AA1B0", header.ProposerAddress)
+
+	require.Equal(2, len(cosmosBlock.BlockEvents))
+	require.Equal("coin_received", cosmosBlock.BlockEvents[0].Type)
+	require.Equal("mint", cosmosBlock.BlockEvents[1].Type)
+	require.Equal(&api.CosmosEventAttribute{Key: "amount", Value: "3401326", Index: true}, cosmosBlock.BlockEvents[1].Attributes[2])
+
+	transactions := cosmosBlock.Transactions
+	require.Equal(4, len(transactions))
+
+	// Withdraw the rewards and delegate them again.
+	tx := transactions[0]
+	require.Equal("24992F776B56EA56AEC52422A7A273E76A97F996BDD7614ECE26DFC8A4A9F73F", tx.Hash)
+	require.Equal(uint64(0), tx.Index)
+	require.Equal("restake", tx.Memo)
+	require.Equal(uint32(0), tx.Code)
+	require.Equal(uint64(300000), tx.GasWanted)
+	require.Equal(uint64(212345), tx.GasUsed)
+	require.Equal(&api.CosmosFee{
+		Amount:   []*api.CosmosCoin{{Denom: "uatom", Amount: "5000"}},
+		GasLimit: 300000,
+	}, tx.Fee)
+	require.Equal(2, len(tx.Messages))
+	require.Equal(TypeUrlMsgWithdrawDelegatorReward, tx.Messages[0].TypeUrl)
+	require.Equal(&api.CosmosMsgWithdrawDelegatorReward{
+		DelegatorAddress: cosmosDelegator,
+		ValidatorAddress: cosmosValidator,
+	}, tx.Messages[0].GetWithdrawDelegatorReward())
+	require.Equal(TypeUrlMsgDelegate, tx.Messages[1].TypeUrl)
+	require.Equal(&api.CosmosMsgDelegate{
+		DelegatorAddress: cosmosDelegator,
+		ValidatorAddress: cosmosValidator,
+		Amount:           &api.CosmosCoin{Denom: "uatom", Amount: "1500000"},
+	}, tx.Messages[1].GetDelegate())
+	require.Equal(4, len(tx.Events))
+	require.Equal("withdraw_rewards", tx.Events[2].Type)
+	require.Equal(&api.CosmosEventAttribute{Key: "amount", Value: "12345uatom", Index: true}, tx.Events[2].Attributes[0])
+
+	tx = transactions[1]
+	require.Equal(uint64(19000100), tx.TimeoutHeight)
+	require.Equal(1, len(tx.Messages))
+	require.Equal(&api.CosmosMsgBeginRedelegate{
+		DelegatorAddress:    cosmosDelegator2,
+		ValidatorSrcAddress: cosmosValidator,
+		ValidatorDstAddress: cosmosValidator2,
+		Amount:              &api.CosmosCoin{Denom: "uatom", Amount: "25000000"},
+	}, tx.Messages[0].GetBeginRedelegate())
+
+	tx = transactions[2]
+	require.Equal(1, len(tx.Messages))
+	require.Equal(&api.CosmosMsgUndelegate{
+		DelegatorAddress: cosmosDelegator2,
+		ValidatorAddress: cosmosValidator2,
+		Amount:           &api.CosmosCoin{Denom: "uatom", Amount: "1000000"},
+	}, tx.Messages[0].GetUndelegate())
+
+	// Other messages are kept in the encoded form.
+	tx = transactions[3]
+	require.Equal(uint32(5), tx.Code)
+	require.Equal("sdk", tx.Codespace)
+	require.Contains(tx.Log, "insufficient funds")
+	require.Equal(1, len(tx.Messages))
+	require.Equal("/cosmos.bank.v1beta1.MsgSend", tx.Messages[0].TypeUrl)
+	require.NotEmpty(tx.Messages[0].Value)
+	require.Nil(tx.Messages[0].Message)
+}
+
+func (s *cosmosNativeParserTestSuite) TestParseBlock_UnexpectedHash() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/cosmos/synthetic_block.json"), fixtures.MustReadFile("parser/cosmos/synthetic_block_results.json"))
+	block.Metadata.Hash = cosmosParentHash
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected hash in header")
+}
+
+func (s *cosmosNativeParserTestSuite) TestParseBlock_MissingTransactionResults() {
+	require := testutil.Require(s.T())
+
+	var blockResults BlockResultsResponse
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("parser/cosmos/synthetic_block_results.json"), &blockResults))
+	blockResults.Result.TxsResults = blockResults.Result.TxsResults[:3]
+	data, err := json.Marshal(blockResults)
+	require.NoError(err)
+
+	block := s.newBlock(fixtures.MustReadFile("parser/cosmos/synthetic_block.json"), data)
+	_, err = s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected number of transaction results")
+}
+
+func (s *cosmosNativeParserTestSuite) TestParseBlock_InvalidTransaction() {
+	require := testutil.Require(s.T())
+
+	// The transaction is rejected if it was executed successfully.
+	block := s.newBlock(s.replaceTransaction(0, invalidTransaction), fixtures.MustReadFile("parser/cosmos/synthetic_block_results.json"))
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "failed to decode transaction 0")
+}
+
+func (s *cosmosNativeParserTestSuite) TestParseBlock_InvalidFailedTransaction() {
+	require := testutil.Require(s.T())
+
+	// The transaction is kept without the messages if it failed.
+	block := s.newBlock(s.replaceTransaction(3, invalidTransaction), fixtures.MustReadFile("parser/cosmos/synthetic_block_results.json"))
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	tx := nativeBlock.GetCosmos().Transactions[3]
+	require.Equal(uint32(5), tx.Code)
+	require.Empty(tx.Messages)
+	require.NotEmpty(tx.Hash)
+}
+
+func (s *cosmosNativeParserTestSuite) replaceTransaction(index int, tx string) []byte {
+	require := testutil.Require(s.T())
+
+	var block BlockResponse
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("parser/cosmos/synthetic_block.json"), &block))
+	block.Result.Block.Data.Txs[index] = tx
+	data, err := json.Marshal(block)
+	require.NoError(err)
+	return data
+}
+
+func (s *cosmosNativeParserTestSuite) newBlock(block []byte, blockResults []byte) *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_COSMOS,
+		Network:    common.Network_NETWORK_COSMOS_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          cosmosTag,
+			Hash:         cosmosHash,
+			ParentHash:   cosmosParentHash,
+			Height:       cosmosHeight,
+			ParentHeight: cosmosParentHeight,
+			Timestamp:    testutil.MustTimestamp(cosmosTimestamp),
+		},
+		Blobdata: &api.Block_Cosmos{
+			Cosmos: &api.CosmosBlobdata{
+				Block:        block,
+				BlockResults: blockResults,
+			},
+		},
+	}
+}
@@ -0,0 +1,306 @@
+package cosmos
+
+import (
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+// The transactions are decoded from the wire format directly, using the field numbers defined in
+// https://github.com/cosmos/cosmos-sdk/tree/main/proto/cosmos, to avoid depending on the Cosmos SDK.
+
+type (
+	protoField struct {
+		number protowire.Number
+		// bytes is set for the length-delimited fields.
+		bytes []byte
+		// varint is set for the varint fields.
+		varint uint64
+	}
+)
+
+const (
+	TypeUrlMsgDelegate                = "/cosmos.staking.v1beta1.MsgDelegate"
+	TypeUrlMsgUndelegate              = "/cosmos.staking.v1beta1.MsgUndelegate"
+	TypeUrlMsgBeginRedelegate         = "/cosmos.staking.v1beta1.MsgBeginRedelegate"
+	TypeUrlMsgWithdrawDelegatorReward = "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward"
+)
+
+// decodeTx decodes cosmos.tx.v1beta1.TxRaw into the transaction.
+func decodeTx(rawTx []byte, transaction *api.CosmosTransaction) error {
+	fields, err := parseProtoFields(rawTx)
+	if err != nil {
+		return xerrors.Errorf("failed to parse TxRaw: %w", err)
+	}
+
+	for _, field := range fields {
+		switch field.number {
+		case 1: // body_bytes
+			if err := decodeTxBody(field.bytes, transaction); err != nil {
+				return xerrors.Errorf("failed to decode TxBody: %w", err)
+			}
+		case 2: // auth_info_bytes
+			fee, err := decodeAuthInfo(field.bytes)
+			if err != nil {
+				return xerrors.Errorf("failed to decode AuthInfo: %w", err)
+			}
+
+			transaction.Fee = fee
+		}
+	}
+
+	return nil
+}
+
+// decodeTxBody decodes cosmos.tx.v1beta1.TxBody into the transaction.
+func decodeTxBody(data []byte, transaction *api.CosmosTransaction) error {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		switch field.number {
+		case 1: // messages
+			message, err := decodeMessage(field.bytes)
+			if err != nil {
+				return xerrors.Errorf("failed to decode message %v: %w", len(transaction.Messages), err)
+			}
+
+			transaction.Messages = append(transaction.Messages, message)
+		case 2: // memo
+			transaction.Memo = string(field.bytes)
+		case 3: // timeout_height
+			transaction.TimeoutHeight = field.varint
+		}
+	}
+
+	return nil
+}
+
+// decodeMessage decodes google.protobuf.Any into a message.
+// The staking-related messages are decoded as well.
+func decodeMessage(data []byte) (*api.CosmosMessage, error) {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &api.CosmosMessage{}
+	for _, field := range fields {
+		switch field.number {
+		case 1: // type_url
+			message.TypeUrl = string(field.bytes)
+		case 2: // value
+			message.Value = field.bytes
+		}
+	}
+
+	switch message.TypeUrl {
+	case TypeUrlMsgDelegate:
+		delegator, validator, amount, err := decodeDelegation(message.Value)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode %v: %w", message.TypeUrl, err)
+		}
+
+		message.Message = &api.CosmosMessage_Delegate{
+			Delegate: &api.CosmosMsgDelegate{
+				DelegatorAddress: delegator,
+				ValidatorAddress: validator,
+				Amount:           amount,
+			},
+		}
+	case TypeUrlMsgUndelegate:
+		delegator, validator, amount, err := decodeDelegation(message.Value)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode %v: %w", message.TypeUrl, err)
+		}
+
+		message.Message = &api.CosmosMessage_Undelegate{
+			Undelegate: &api.CosmosMsgUndelegate{
+				DelegatorAddress: delegator,
+				ValidatorAddress: validator,
+				Amount:           amount,
+			},
+		}
+	case TypeUrlMsgBeginRedelegate:
+		redelegate, err := decodeBeginRedelegate(message.Value)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode %v: %w", message.TypeUrl, err)
+		}
+
+		message.Message = &api.CosmosMessage_BeginRedelegate{
+			BeginRedelegate: redelegate,
+		}
+	case TypeUrlMsgWithdrawDelegatorReward:
+		delegator, validator, _, err := decodeDelegation(message.Value)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode %v: %w", message.TypeUrl, err)
+		}
+
+		message.Message = &api.CosmosMessage_WithdrawDelegatorReward{
+			WithdrawDelegatorReward: &api.CosmosMsgWithdrawDelegatorReward{
+				DelegatorAddress: delegator,
+				ValidatorAddress: validator,
+			},
+		}
+	}
+
+	return message, nil
+}
+
+// decodeDelegation decodes MsgDelegate, MsgUndelegate and MsgWithdrawDelegatorReward,
+// which share the same field numbers for the delegator address, validator address and amount.
+func decodeDelegation(data []byte) (string, string, *api.CosmosCoin, error) {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	var delegator, validator string
+	var amount *api.CosmosCoin
+	for _, field := range fields {
+		switch field.number {
+		case 1: // delegator_address
+			delegator = string(field.bytes)
+		case 2: // validator_address
+			validator = string(field.bytes)
+		case 3: // amount
+			amount, err = decodeCoin(field.bytes)
+			if err != nil {
+				return "", "", nil, xerrors.Errorf("failed to decode amount: %w", err)
+			}
+		}
+	}
+
+	return delegator, validator, amount, nil
+}
+
+func decodeBeginRedelegate(data []byte) (*api.CosmosMsgBeginRedelegate, error) {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api.CosmosMsgBeginRedelegate{}
+	for _, field := range fields {
+		switch field.number {
+		case 1: // delegator_address
+			result.DelegatorAddress = string(field.bytes)
+		case 2: // validator_src_address
+			result.ValidatorSrcAddress = string(field.bytes)
+		case 3: // validator_dst_address
+			result.ValidatorDstAddress = string(field.bytes)
+		case 4: // amount
+			result.Amount, err = decodeCoin(field.bytes)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to decode amount: %w", err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// decodeAuthInfo decodes the fee out of cosmos.tx.v1beta1.AuthInfo.
+func decodeAuthInfo(data []byte) (*api.CosmosFee, error) {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if field.number == 2 { // fee
+			fee, err := decodeFee(field.bytes)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to decode fee: %w", err)
+			}
+
+			return fee, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func decodeFee(data []byte) (*api.CosmosFee, error) {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := &api.CosmosFee{}
+	for _, field := range fields {
+		switch field.number {
+		case 1: // amount
+			coin, err := decodeCoin(field.bytes)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to decode amount: %w", err)
+			}
+
+			fee.Amount = append(fee.Amount, coin)
+		case 2: // gas_limit
+			fee.GasLimit = field.varint
+		case 3: // payer
+			fee.Payer = string(field.bytes)
+		case 4: // granter
+			fee.Granter = string(field.bytes)
+		}
+	}
+
+	return fee, nil
+}
+
+func decodeCoin(data []byte) (*api.CosmosCoin, error) {
+	fields, err := parseProtoFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	coin := &api.CosmosCoin{}
+	for _, field := range fields {
+		switch field.number {
+		case 1: // denom
+			coin.Denom = string(field.bytes)
+		case 2: // amount
+			coin.Amount = string(field.bytes)
+		}
+	}
+
+	return coin, nil
+}
+
+// parseProtoFields parses the top-level fields of a protobuf-encoded message.
+// Fields of other wire types than varint and length-delimited are skipped.
+func parseProtoFields(data []byte) ([]protoField, error) {
+	var fields []protoField
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, xerrors.Errorf("failed to parse tag: %w", protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		field := protoField{number: number}
+		switch wireType {
+		case protowire.BytesType:
+			field.bytes, n = protowire.ConsumeBytes(data)
+		case protowire.VarintType:
+			field.varint, n = protowire.ConsumeVarint(data)
+		default:
+			n = protowire.ConsumeFieldValue(number, wireType, data)
+		}
+
+		if n < 0 {
+			return nil, xerrors.Errorf("failed to parse field %v: %w", number, protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		if wireType == protowire.BytesType || wireType == protowire.VarintType {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
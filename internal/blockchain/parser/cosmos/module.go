@@ -0,0 +1,12 @@
+package cosmos
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+var Module = fx.Options(
+	internal.NewParserBuilder("cosmos/staking", NewNativeParser).
+		Build(),
+)
@@ -0,0 +1,739 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	"github.com/coinbase/chainstorage/internal/utils/utils"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+const (
+	SecondsPerSlot = 12
+	SlotsPerEpoch  = 32
+
+	// Block versions returned by /eth/v2/beacon/blocks/{block_id}.
+	versionPhase0    = "phase0"
+	versionAltair    = "altair"
+	versionBellatrix = "bellatrix"
+	versionCapella   = "capella"
+	versionDeneb     = "deneb"
+	versionElectra   = "electra"
+	versionFulu      = "fulu"
+)
+
+var (
+	// Unix timestamp of the genesis slot of each beacon chain.
+	// See https://github.com/eth-clients/mainnet and https://github.com/eth-clients/holesky.
+	genesisTimes = map[api.SideChain]int64{
+		api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON: 1606824023,
+		api.SideChain_SIDECHAIN_ETHEREUM_HOLESKY_BEACON: 1695902400,
+	}
+
+	blockVersions = map[string]api.EthereumBeaconBlockData_Version{
+		versionPhase0:    api.EthereumBeaconBlockData_PHASE0,
+		versionAltair:    api.EthereumBeaconBlockData_ALTAIR,
+		versionBellatrix: api.EthereumBeaconBlockData_BELLATRIX,
+		versionCapella:   api.EthereumBeaconBlockData_CAPELLA,
+		versionDeneb:     api.EthereumBeaconBlockData_DENEB,
+		versionElectra:   api.EthereumBeaconBlockData_ELECTRA,
+		versionFulu:      api.EthereumBeaconBlockData_FULU,
+	}
+)
+
+type (
+	// Quantity is a uint64 encoded as a base-10 string, e.g. "slot": "8000000".
+	Quantity uint64
+
+	nativeParserImpl struct {
+		logger   *zap.Logger
+		validate *validator.Validate
+		config   *config.Config
+	}
+
+	// BlockHeaderResponse is the response of /eth/v1/beacon/headers/{block_id}.
+	BlockHeaderResponse struct {
+		Data *BlockHeaderData `json:"data" validate:"required"`
+	}
+
+	BlockHeaderData struct {
+		Root      string             `json:"root" validate:"required"`
+		Canonical bool               `json:"canonical"`
+		Header    *SignedBlockHeader `json:"header" validate:"required"`
+	}
+
+	SignedBlockHeader struct {
+		Message   *BlockHeaderMessage `json:"message" validate:"required"`
+		Signature string              `json:"signature" validate:"required"`
+	}
+
+	BlockHeaderMessage struct {
+		Slot          Quantity `json:"slot"`
+		ProposerIndex Quantity `json:"proposer_index"`
+		ParentRoot    string   `json:"parent_root" validate:"required"`
+		StateRoot     string   `json:"state_root" validate:"required"`
+		BodyRoot      string   `json:"body_root" validate:"required"`
+	}
+
+	// BlockResponse is the response of /eth/v2/beacon/blocks/{block_id}.
+	BlockResponse struct {
+		Version string       `json:"version" validate:"required"`
+		Data    *SignedBlock `json:"data" validate:"required"`
+	}
+
+	SignedBlock struct {
+		Message   *BlockMessage `json:"message" validate:"required"`
+		Signature string        `json:"signature" validate:"required"`
+	}
+
+	BlockMessage struct {
+		Slot          Quantity   `json:"slot"`
+		ProposerIndex Quantity   `json:"proposer_index"`
+		ParentRoot    string     `json:"parent_root" validate:"required"`
+		StateRoot     string     `json:"state_root" validate:"required"`
+		Body          *BlockBody `json:"body" validate:"required"`
+	}
+
+	BlockBody struct {
+		RandaoReveal          string                        `json:"randao_reveal"`
+		Eth1Data              *Eth1Data                     `json:"eth1_data"`
+		Graffiti              string                        `json:"graffiti"`
+		ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings"`
+		AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings"`
+		Attestations          []*Attestation                `json:"attestations"`
+		Deposits              []*Deposit                    `json:"deposits"`
+		VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits"`
+		SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+		ExecutionPayload      *ExecutionPayload             `json:"execution_payload"`
+		BLSToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+		BlobKzgCommitments    []string                      `json:"blob_kzg_commitments"`
+		ExecutionRequests     *ExecutionRequests            `json:"execution_requests"`
+	}
+
+	Eth1Data struct {
+		DepositRoot  string   `json:"deposit_root"`
+		DepositCount Quantity `json:"deposit_count"`
+		BlockHash    string   `json:"block_hash"`
+	}
+
+	ProposerSlashing struct {
+		SignedHeader1 *SignedBlockHeader `json:"signed_header_1"`
+		SignedHeader2 *SignedBlockHeader `json:"signed_header_2"`
+	}
+
+	Checkpoint struct {
+		Epoch Quantity `json:"epoch"`
+		Root  string   `json:"root"`
+	}
+
+	AttestationData struct {
+		Slot            Quantity    `json:"slot"`
+		Index           Quantity    `json:"index"`
+		BeaconBlockRoot string      `json:"beacon_block_root"`
+		Source          *Checkpoint `json:"source"`
+		Target          *Checkpoint `json:"target"`
+	}
+
+	IndexedAttestation struct {
+		AttestingIndices []Quantity       `json:"attesting_indices"`
+		Data             *AttestationData `json:"data"`
+		Signature        string           `json:"signature"`
+	}
+
+	AttesterSlashing struct {
+		Attestation1 *IndexedAttestation `json:"attestation_1"`
+		Attestation2 *IndexedAttestation `json:"attestation_2"`
+	}
+
+	Attestation struct {
+		AggregationBits string           `json:"aggregation_bits"`
+		Data            *AttestationData `json:"data"`
+		Signature       string           `json:"signature"`
+		CommitteeBits   string           `json:"committee_bits"`
+	}
+
+	Deposit struct {
+		Proof []string     `json:"proof"`
+		Data  *DepositData `json:"data"`
+	}
+
+	DepositData struct {
+		Pubkey                string   `json:"pubkey"`
+		WithdrawalCredentials string   `json:"withdrawal_credentials"`
+		Amount                Quantity `json:"amount"`
+		Signature             string   `json:"signature"`
+	}
+
+	SignedVoluntaryExit struct {
+		Message   *VoluntaryExit `json:"message"`
+		Signature string         `json:"signature"`
+	}
+
+	VoluntaryExit struct {
+		Epoch          Quantity `json:"epoch"`
+		ValidatorIndex Quantity `json:"validator_index"`
+	}
+
+	SyncAggregate struct {
+		SyncCommitteeBits      string `json:"sync_committee_bits"`
+		SyncCommitteeSignature string `json:"sync_committee_signature"`
+	}
+
+	ExecutionPayload struct {
+		ParentHash    string        `json:"parent_hash"`
+		FeeRecipient  string        `json:"fee_recipient"`
+		StateRoot     string        `json:"state_root"`
+		ReceiptsRoot  string        `json:"receipts_root"`
+		LogsBloom     string        `json:"logs_bloom"`
+		PrevRandao    string        `json:"prev_randao"`
+		BlockNumber   Quantity      `json:"block_number"`
+		GasLimit      Quantity      `json:"gas_limit"`
+		GasUsed       Quantity      `json:"gas_used"`
+		Timestamp     Quantity      `json:"timestamp"`
+		ExtraData     string        `json:"extra_data"`
+		BaseFeePerGas string        `json:"base_fee_per_gas"`
+		BlockHash     string        `json:"block_hash"`
+		Transactions  []string      `json:"transactions"`
+		Withdrawals   []*Withdrawal `json:"withdrawals"`
+		BlobGasUsed   Quantity      `json:"blob_gas_used"`
+		ExcessBlobGas Quantity      `json:"excess_blob_gas"`
+	}
+
+	Withdrawal struct {
+		Index          Quantity `json:"index"`
+		ValidatorIndex Quantity `json:"validator_index"`
+		Address        string   `json:"address"`
+		Amount         Quantity `json:"amount"`
+	}
+
+	SignedBLSToExecutionChange struct {
+		Message   *BLSToExecutionChange `json:"message"`
+		Signature string                `json:"signature"`
+	}
+
+	BLSToExecutionChange struct {
+		ValidatorIndex     Quantity `json:"validator_index"`
+		FromBLSPubkey      string   `json:"from_bls_pubkey"`
+		ToExecutionAddress string   `json:"to_execution_address"`
+	}
+
+	// ExecutionRequests is available since the Electra fork.
+	ExecutionRequests struct {
+		Deposits       []*DepositRequest       `json:"deposits"`
+		Withdrawals    []*WithdrawalRequest    `json:"withdrawals"`
+		Consolidations []*ConsolidationRequest `json:"consolidations"`
+	}
+
+	DepositRequest struct {
+		Pubkey                string   `json:"pubkey"`
+		WithdrawalCredentials string   `json:"withdrawal_credentials"`
+		Amount                Quantity `json:"amount"`
+		Signature             string   `json:"signature"`
+		Index                 Quantity `json:"index"`
+	}
+
+	WithdrawalRequest struct {
+		SourceAddress   string   `json:"source_address"`
+		ValidatorPubkey string   `json:"validator_pubkey"`
+		Amount          Quantity `json:"amount"`
+	}
+
+	ConsolidationRequest struct {
+		SourceAddress string `json:"source_address"`
+		SourcePubkey  string `json:"source_pubkey"`
+		TargetPubkey  string `json:"target_pubkey"`
+	}
+
+	// BlobsResponse is the response of /eth/v1/beacon/blob_sidecars/{block_id}.
+	BlobsResponse struct {
+		Data []*BlobSidecar `json:"data"`
+	}
+
+	BlobSidecar struct {
+		Index                       Quantity           `json:"index"`
+		Blob                        string             `json:"blob" validate:"required"`
+		KzgCommitment               string             `json:"kzg_commitment" validate:"required"`
+		KzgProof                    string             `json:"kzg_proof" validate:"required"`
+		SignedBlockHeader           *SignedBlockHeader `json:"signed_block_header" validate:"required"`
+		KzgCommitmentInclusionProof []string           `json:"kzg_commitment_inclusion_proof"`
+	}
+)
+
+func NewNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	return &nativeParserImpl{
+		logger:   log.WithPackage(params.Logger),
+		validate: validator.New(),
+		config:   params.Config,
+	}, nil
+}
+
+func (v Quantity) MarshalJSON() ([]byte, error) {
+	s := fmt.Sprintf(`"%d"`, uint64(v))
+	return []byte(s), nil
+}
+
+func (v *Quantity) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return xerrors.Errorf("failed to unmarshal Quantity into string: %w", err)
+	}
+
+	if s == "" {
+		*v = 0
+		return nil
+	}
+
+	i, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return xerrors.Errorf("failed to decode Quantity %v: %w", s, err)
+	}
+
+	*v = Quantity(i)
+	return nil
+}
+
+func (v Quantity) Value() uint64 {
+	return uint64(v)
+}
+
+// GetSlotTimestamp returns the start time of the slot on the given beacon chain.
+func GetSlotTimestamp(sidechain api.SideChain, slot uint64) (*timestamppb.Timestamp, error) {
+	genesisTime, ok := genesisTimes[sidechain]
+	if !ok {
+		return nil, xerrors.Errorf("genesis time is not available for sidechain %v", sidechain)
+	}
+
+	return utils.ToTimestamp(genesisTime + int64(slot)*SecondsPerSlot), nil
+}
+
+// HasBlobs returns true if blob sidecars may be attached to the block.
+func (b *BlockResponse) HasBlobs() bool {
+	return b.Data.Message.Body != nil && len(b.Data.Message.Body.BlobKzgCommitments) > 0
+}
+
+func (p *nativeParserImpl) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+	metadata := rawBlock.GetMetadata()
+	if metadata == nil {
+		return nil, xerrors.New("metadata not found")
+	}
+
+	if metadata.Skipped {
+		return &api.NativeBlock{
+			Blockchain: rawBlock.Blockchain,
+			Network:    rawBlock.Network,
+			SideChain:  rawBlock.SideChain,
+			Tag:        metadata.Tag,
+			Height:     metadata.Height,
+			Skipped:    true,
+		}, nil
+	}
+
+	blobdata := rawBlock.GetEthereumBeacon()
+	if blobdata == nil {
+		return nil, xerrors.Errorf("blobdata not found (metadata={%+v})", metadata)
+	}
+
+	header, err := p.parseHeader(blobdata.Header, metadata)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse header (metadata={%+v}): %w", metadata, err)
+	}
+
+	block, err := p.parseBlockData(blobdata.Block)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse block (metadata={%+v}): %w", metadata, err)
+	}
+
+	blobs, err := p.parseBlobs(blobdata.Blobs)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse blobs (metadata={%+v}): %w", metadata, err)
+	}
+
+	if err := p.validateBlock(header, block, blobs); err != nil {
+		return nil, xerrors.Errorf("inconsistent block (metadata={%+v}): %w", metadata, err)
+	}
+
+	return &api.NativeBlock{
+		Blockchain:   rawBlock.Blockchain,
+		Network:      rawBlock.Network,
+		SideChain:    rawBlock.SideChain,
+		Tag:          metadata.Tag,
+		Hash:         metadata.Hash,
+		ParentHash:   metadata.ParentHash,
+		Height:       metadata.Height,
+		ParentHeight: metadata.ParentHeight,
+		Timestamp:    metadata.Timestamp,
+		Block: &api.NativeBlock_EthereumBeacon{
+			EthereumBeacon: &api.EthereumBeaconBlock{
+				Header: header,
+				Block:  block,
+				Blobs:  blobs,
+			},
+		},
+	}, nil
+}
+
+func (p *nativeParserImpl) GetTransaction(ctx context.Context, nativeBlock *api.NativeBlock, transactionHash string) (*api.NativeTransaction, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (p *nativeParserImpl) parseHeader(data []byte, metadata *api.BlockMetadata) (*api.EthereumBeaconBlockHeader, error) {
+	var response BlockHeaderResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal header: %w", err)
+	}
+
+	if err := p.validate.Struct(response); err != nil {
+		return nil, xerrors.Errorf("failed to validate header: %w", err)
+	}
+
+	message := response.Data.Header.Message
+	slot := message.Slot.Value()
+	if slot != metadata.Height {
+		return nil, xerrors.Errorf("unexpected slot in header: expected=%v, actual=%v", metadata.Height, slot)
+	}
+
+	if response.Data.Root != metadata.Hash {
+		return nil, xerrors.Errorf("unexpected root in header: expected=%v, actual=%v", metadata.Hash, response.Data.Root)
+	}
+
+	return &api.EthereumBeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: message.ProposerIndex.Value(),
+		ParentRoot:    message.ParentRoot,
+		StateRoot:     message.StateRoot,
+		BodyRoot:      message.BodyRoot,
+		Signature:     response.Data.Header.Signature,
+		Root:          response.Data.Root,
+		Epoch:         slot / SlotsPerEpoch,
+		Timestamp:     metadata.Timestamp,
+	}, nil
+}
+
+func (p *nativeParserImpl) parseBlockData(data []byte) (*api.EthereumBeaconBlockData, error) {
+	var response BlockResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	if err := p.validate.Struct(response); err != nil {
+		return nil, xerrors.Errorf("failed to validate block: %w", err)
+	}
+
+	version, ok := blockVersions[response.Version]
+	if !ok {
+		return nil, xerrors.Errorf("unknown block version: %v", response.Version)
+	}
+
+	message := response.Data.Message
+	return &api.EthereumBeaconBlockData{
+		Version:       version,
+		Slot:          message.Slot.Value(),
+		ProposerIndex: message.ProposerIndex.Value(),
+		ParentRoot:    message.ParentRoot,
+		StateRoot:     message.StateRoot,
+		Signature:     response.Data.Signature,
+		Body:          p.parseBlockBody(message.Body),
+	}, nil
+}
+
+func (p *nativeParserImpl) parseBlockBody(body *BlockBody) *api.EthereumBeaconBlockBody {
+	result := &api.EthereumBeaconBlockBody{
+		RandaoReveal:       body.RandaoReveal,
+		Graffiti:           body.Graffiti,
+		BlobKzgCommitments: body.BlobKzgCommitments,
+	}
+
+	if body.Eth1Data != nil {
+		result.Eth1Data = &api.EthereumBeaconEth1Data{
+			DepositRoot:  body.Eth1Data.DepositRoot,
+			DepositCount: body.Eth1Data.DepositCount.Value(),
+			BlockHash:    body.Eth1Data.BlockHash,
+		}
+	}
+
+	result.ProposerSlashings = make([]*api.EthereumBeaconProposerSlashing, len(body.ProposerSlashings))
+	for i, slashing := range body.ProposerSlashings {
+		result.ProposerSlashings[i] = &api.EthereumBeaconProposerSlashing{
+			SignedHeader_1: p.parseSignedBlockHeader(slashing.SignedHeader1),
+			SignedHeader_2: p.parseSignedBlockHeader(slashing.SignedHeader2),
+		}
+	}
+
+	result.AttesterSlashings = make([]*api.EthereumBeaconAttesterSlashing, len(body.AttesterSlashings))
+	for i, slashing := range body.AttesterSlashings {
+		result.AttesterSlashings[i] = &api.EthereumBeaconAttesterSlashing{
+			Attestation_1: p.parseIndexedAttestation(slashing.Attestation1),
+			Attestation_2: p.parseIndexedAttestation(slashing.Attestation2),
+		}
+	}
+
+	result.Attestations = make([]*api.EthereumBeaconAttestation, len(body.Attestations))
+	for i, attestation := range body.Attestations {
+		result.Attestations[i] = &api.EthereumBeaconAttestation{
+			AggregationBits: attestation.AggregationBits,
+			Data:            p.parseAttestationData(attestation.Data),
+			Signature:       attestation.Signature,
+			CommitteeBits:   attestation.CommitteeBits,
+		}
+	}
+
+	result.Deposits = make([]*api.EthereumBeaconDeposit, len(body.Deposits))
+	for i, deposit := range body.Deposits {
+		result.Deposits[i] = &api.EthereumBeaconDeposit{
+			Proof: deposit.Proof,
+		}
+		if deposit.Data != nil {
+			result.Deposits[i].Pubkey = deposit.Data.Pubkey
+			result.Deposits[i].WithdrawalCredentials = deposit.Data.WithdrawalCredentials
+			result.Deposits[i].Amount = deposit.Data.Amount.Value()
+			result.Deposits[i].Signature = deposit.Data.Signature
+		}
+	}
+
+	result.VoluntaryExits = make([]*api.EthereumBeaconSignedVoluntaryExit, len(body.VoluntaryExits))
+	for i, exit := range body.VoluntaryExits {
+		result.VoluntaryExits[i] = &api.EthereumBeaconSignedVoluntaryExit{
+			Signature: exit.Signature,
+		}
+		if exit.Message != nil {
+			result.VoluntaryExits[i].Epoch = exit.Message.Epoch.Value()
+			result.VoluntaryExits[i].ValidatorIndex = exit.Message.ValidatorIndex.Value()
+		}
+	}
+
+	if body.SyncAggregate != nil {
+		result.SyncAggregate = &api.EthereumBeaconSyncAggregate{
+			SyncCommitteeBits:      body.SyncAggregate.SyncCommitteeBits,
+			SyncCommitteeSignature: body.SyncAggregate.SyncCommitteeSignature,
+		}
+	}
+
+	if body.ExecutionPayload != nil {
+		result.ExecutionPayload = p.parseExecutionPayload(body.ExecutionPayload)
+	}
+
+	result.BlsToExecutionChanges = make([]*api.EthereumBeaconSignedBLSToExecutionChange, len(body.BLSToExecutionChanges))
+	for i, change := range body.BLSToExecutionChanges {
+		result.BlsToExecutionChanges[i] = &api.EthereumBeaconSignedBLSToExecutionChange{
+			Signature: change.Signature,
+		}
+		if change.Message != nil {
+			result.BlsToExecutionChanges[i].ValidatorIndex = change.Message.ValidatorIndex.Value()
+			result.BlsToExecutionChanges[i].FromBlsPubkey = change.Message.FromBLSPubkey
+			result.BlsToExecutionChanges[i].ToExecutionAddress = change.Message.ToExecutionAddress
+		}
+	}
+
+	if body.ExecutionRequests != nil {
+		result.ExecutionRequests = p.parseExecutionRequests(body.ExecutionRequests)
+	}
+
+	return result
+}
+
+func (p *nativeParserImpl) parseExecutionRequests(requests *ExecutionRequests) *api.EthereumBeaconExecutionRequests {
+	deposits := make([]*api.EthereumBeaconDepositRequest, len(requests.Deposits))
+	for i, deposit := range requests.Deposits {
+		deposits[i] = &api.EthereumBeaconDepositRequest{
+			Pubkey:                deposit.Pubkey,
+			WithdrawalCredentials: deposit.WithdrawalCredentials,
+			Amount:                deposit.Amount.Value(),
+			Signature:             deposit.Signature,
+			Index:                 deposit.Index.Value(),
+		}
+	}
+
+	withdrawals := make([]*api.EthereumBeaconWithdrawalRequest, len(requests.Withdrawals))
+	for i, withdrawal := range requests.Withdrawals {
+		withdrawals[i] = &api.EthereumBeaconWithdrawalRequest{
+			SourceAddress:   withdrawal.SourceAddress,
+			ValidatorPubkey: withdrawal.ValidatorPubkey,
+			Amount:          withdrawal.Amount.Value(),
+		}
+	}
+
+	consolidations := make([]*api.EthereumBeaconConsolidationRequest, len(requests.Consolidations))
+	for i, consolidation := range requests.Consolidations {
+		consolidations[i] = &api.EthereumBeaconConsolidationRequest{
+			SourceAddress: consolidation.SourceAddress,
+			SourcePubkey:  consolidation.SourcePubkey,
+			TargetPubkey:  consolidation.TargetPubkey,
+		}
+	}
+
+	return &api.EthereumBeaconExecutionRequests{
+		Deposits:       deposits,
+		Withdrawals:    withdrawals,
+		Consolidations: consolidations,
+	}
+}
+
+func (p *nativeParserImpl) parseSignedBlockHeader(header *SignedBlockHeader) *api.EthereumBeaconSignedBlockHeader {
+	if header == nil || header.Message == nil {
+		return nil
+	}
+
+	return &api.EthereumBeaconSignedBlockHeader{
+		Slot:          header.Message.Slot.Value(),
+		ProposerIndex: header.Message.ProposerIndex.Value(),
+		ParentRoot:    header.Message.ParentRoot,
+		StateRoot:     header.Message.StateRoot,
+		BodyRoot:      header.Message.BodyRoot,
+		Signature:     header.Signature,
+	}
+}
+
+func (p *nativeParserImpl) parseIndexedAttestation(attestation *IndexedAttestation) *api.EthereumBeaconIndexedAttestation {
+	if attestation == nil {
+		return nil
+	}
+
+	indices := make([]uint64, len(attestation.AttestingIndices))
+	for i, index := range attestation.AttestingIndices {
+		indices[i] = index.Value()
+	}
+
+	return &api.EthereumBeaconIndexedAttestation{
+		AttestingIndices: indices,
+		Data:             p.parseAttestationData(attestation.Data),
+		Signature:        attestation.Signature,
+	}
+}
+
+func (p *nativeParserImpl) parseAttestationData(data *AttestationData) *api.EthereumBeaconAttestationData {
+	if data == nil {
+		return nil
+	}
+
+	return &api.EthereumBeaconAttestationData{
+		Slot:            data.Slot.Value(),
+		Index:           data.Index.Value(),
+		BeaconBlockRoot: data.BeaconBlockRoot,
+		Source:          p.parseCheckpoint(data.Source),
+		Target:          p.parseCheckpoint(data.Target),
+	}
+}
+
+func (p *nativeParserImpl) parseCheckpoint(checkpoint *Checkpoint) *api.EthereumBeaconCheckpoint {
+	if checkpoint == nil {
+		return nil
+	}
+
+	return &api.EthereumBeaconCheckpoint{
+		Epoch: checkpoint.Epoch.Value(),
+		Root:  checkpoint.Root,
+	}
+}
+
+func (p *nativeParserImpl) parseExecutionPayload(payload *ExecutionPayload) *api.EthereumBeaconExecutionPayload {
+	withdrawals := make([]*api.EthereumWithdrawal, len(payload.Withdrawals))
+	for i, withdrawal := range payload.Withdrawals {
+		withdrawals[i] = &api.EthereumWithdrawal{
+			Index:          withdrawal.Index.Value(),
+			ValidatorIndex: withdrawal.ValidatorIndex.Value(),
+			Address:        withdrawal.Address,
+			Amount:         withdrawal.Amount.Value(),
+		}
+	}
+
+	return &api.EthereumBeaconExecutionPayload{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber.Value(),
+		GasLimit:      payload.GasLimit.Value(),
+		GasUsed:       payload.GasUsed.Value(),
+		Timestamp:     utils.ToTimestamp(int64(payload.Timestamp.Value())),
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: payload.BaseFeePerGas,
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		Withdrawals:   withdrawals,
+		BlobGasUsed:   payload.BlobGasUsed.Value(),
+		ExcessBlobGas: payload.ExcessBlobGas.Value(),
+	}
+}
+
+func (p *nativeParserImpl) parseBlobs(data []byte) ([]*api.EthereumBeaconBlob, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var response BlobsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal blobs: %w", err)
+	}
+
+	blobs := make([]*api.EthereumBeaconBlob, len(response.Data))
+	for i, sidecar := range response.Data {
+		if err := p.validate.Struct(sidecar); err != nil {
+			return nil, xerrors.Errorf("failed to validate blob sidecar: %w", err)
+		}
+
+		var slot uint64
+		if sidecar.SignedBlockHeader.Message != nil {
+			slot = sidecar.SignedBlockHeader.Message.Slot.Value()
+		}
+
+		blobs[i] = &api.EthereumBeaconBlob{
+			Slot:                        slot,
+			Index:                       sidecar.Index.Value(),
+			Blob:                        sidecar.Blob,
+			KzgCommitment:               sidecar.KzgCommitment,
+			KzgProof:                    sidecar.KzgProof,
+			KzgCommitmentInclusionProof: sidecar.KzgCommitmentInclusionProof,
+		}
+	}
+
+	return blobs, nil
+}
+
+// validateBlock checks that the header, the block and the blob sidecars refer to the same slot.
+func (p *nativeParserImpl) validateBlock(header *api.EthereumBeaconBlockHeader, block *api.EthereumBeaconBlockData, blobs []*api.EthereumBeaconBlob) error {
+	if block.Slot != header.Slot {
+		return xerrors.Errorf("unexpected slot in block: expected=%v, actual=%v", header.Slot, block.Slot)
+	}
+
+	if block.ParentRoot != header.ParentRoot {
+		return xerrors.Errorf("unexpected parent root in block: expected=%v, actual=%v", header.ParentRoot, block.ParentRoot)
+	}
+
+	if block.StateRoot != header.StateRoot {
+		return xerrors.Errorf("unexpected state root in block: expected=%v, actual=%v", header.StateRoot, block.StateRoot)
+	}
+
+	if len(blobs) == 0 {
+		// Blob sidecars are pruned by the beacon nodes after about 18 days.
+		return nil
+	}
+
+	commitments := block.Body.BlobKzgCommitments
+	if len(blobs) != len(commitments) {
+		return xerrors.Errorf("unexpected number of blobs: expected=%v, actual=%v", len(commitments), len(blobs))
+	}
+
+	for _, blob := range blobs {
+		if blob.Slot != header.Slot {
+			return xerrors.Errorf("unexpected slot in blob %v: expected=%v, actual=%v", blob.Index, header.Slot, blob.Slot)
+		}
+
+		if blob.Index >= uint64(len(commitments)) || commitments[blob.Index] != blob.KzgCommitment {
+			return xerrors.Errorf("unexpected kzg commitment in blob %v: %v", blob.Index, blob.KzgCommitment)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,348 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type beaconNativeParserTestSuite struct {
+	suite.Suite
+
+	app    testapp.TestApp
+	parser internal.NativeParser
+}
+
+const (
+	beaconTag          = uint32(1)
+	beaconHeight       = uint64(8000001)
+	beaconParentHeight = uint64(7999999)
+	beaconHash         = "0x212f8151226fce768980fd6789e6c5e856204de64456c3678ae86d91146cfa3f"
+	beaconParentHash   = "0xa1081b778ca39d1253d6d0222cec9ab7f50edcd61c351a8318e2f4fdd3f7e77d"
+	beaconTimestamp    = "2023-12-17T14:40:35Z"
+
+	electraPubkey    = "0x8f2b7e0f9c1d6a3b5e4f2a1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c"
+	electraSignature = "0xa5c1b2d3e4f5061728394a5b6c7d8e9f00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff0011"
+)
+
+func TestBeaconNativeParserTestSuite(t *testing.T) {
+	suite.Run(t, new(beaconNativeParserTestSuite))
+}
+
+func (s *beaconNativeParserTestSuite) SetupTest() {
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetworkSidechain(common.Blockchain_BLOCKCHAIN_ETHEREUM, common.Network_NETWORK_ETHEREUM_MAINNET, api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON),
+		fx.Provide(NewNativeParser),
+		fx.Populate(&s.parser),
+	)
+	s.NotNil(s.parser)
+}
+
+func (s *beaconNativeParserTestSuite) TearDownTest() {
+	s.app.Close()
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/ethereum/beacon/blob_sidecars.json"))
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	expectedTimestamp := testutil.MustTimestamp(beaconTimestamp)
+	require.Equal(common.Blockchain_BLOCKCHAIN_ETHEREUM, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_ETHEREUM_MAINNET, nativeBlock.Network)
+	require.Equal(api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON, nativeBlock.SideChain)
+	require.Equal(beaconTag, nativeBlock.Tag)
+	require.Equal(beaconHeight, nativeBlock.Height)
+	require.Equal(beaconParentHeight, nativeBlock.ParentHeight)
+	require.Equal(beaconHash, nativeBlock.Hash)
+	require.Equal(beaconParentHash, nativeBlock.ParentHash)
+	require.Equal(expectedTimestamp, nativeBlock.Timestamp)
+	require.False(nativeBlock.Skipped)
+
+	beaconBlock := nativeBlock.GetEthereumBeacon()
+	require.NotNil(beaconBlock)
+
+	header := beaconBlock.Header
+	require.Equal(beaconHeight, header.Slot)
+	require.Equal(uint64(1029412), header.ProposerIndex)
+	require.Equal(beaconParentHash, header.ParentRoot)
+	require.Equal(beaconHash, header.Root)
+	require.Equal(uint64(250000), header.Epoch)
+	require.Equal(expectedTimestamp, header.Timestamp)
+
+	data := beaconBlock.Block
+	require.Equal(api.EthereumBeaconBlockData_DENEB, data.Version)
+	require.Equal(beaconHeight, data.Slot)
+	require.Equal(header.ProposerIndex, data.ProposerIndex)
+	require.Equal(header.ParentRoot, data.ParentRoot)
+	require.Equal(header.StateRoot, data.StateRoot)
+
+	body := data.Body
+	require.Equal(uint64(1445273), body.Eth1Data.DepositCount)
+	require.Empty(body.ProposerSlashings)
+	require.Empty(body.AttesterSlashings)
+	require.Equal(1, len(body.Attestations))
+	require.Equal(uint64(8000000), body.Attestations[0].Data.Slot)
+	require.Equal(uint64(249999), body.Attestations[0].Data.Target.Epoch)
+	require.Equal(1, len(body.Deposits))
+	require.Equal(uint64(32000000000), body.Deposits[0].Amount)
+	require.Equal(3, len(body.Deposits[0].Proof))
+	require.Equal(1, len(body.VoluntaryExits))
+	require.Equal(uint64(123456), body.VoluntaryExits[0].ValidatorIndex)
+	require.NotEmpty(body.SyncAggregate.SyncCommitteeBits)
+	require.Equal(1, len(body.BlsToExecutionChanges))
+	require.Equal(uint64(9876), body.BlsToExecutionChanges[0].ValidatorIndex)
+	require.Equal(2, len(body.BlobKzgCommitments))
+
+	payload := body.ExecutionPayload
+	require.Equal(uint64(19076890), payload.BlockNumber)
+	require.Equal(uint64(30000000), payload.GasLimit)
+	require.Equal(uint64(12345678), payload.GasUsed)
+	require.Equal(expectedTimestamp, payload.Timestamp)
+	require.Equal("16203474185", payload.BaseFeePerGas)
+	require.Equal(2, len(payload.Transactions))
+	require.Equal(uint64(262144), payload.BlobGasUsed)
+	require.Equal(uint64(0), payload.ExcessBlobGas)
+	require.Equal(2, len(payload.Withdrawals))
+	require.Equal(uint64(31337001), payload.Withdrawals[0].Index)
+	require.Equal(uint64(445566), payload.Withdrawals[0].ValidatorIndex)
+	require.Equal(uint64(17654321), payload.Withdrawals[0].Amount)
+
+	require.Equal(2, len(beaconBlock.Blobs))
+	for i, blob := range beaconBlock.Blobs {
+		require.Equal(uint64(i), blob.Index)
+		require.Equal(beaconHeight, blob.Slot)
+		require.Equal(body.BlobKzgCommitments[i], blob.KzgCommitment)
+		require.NotEmpty(blob.Blob)
+		require.NotEmpty(blob.KzgProof)
+		require.Equal(17, len(blob.KzgCommitmentInclusionProof))
+	}
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_WithoutBlobs() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(nil)
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(2, len(nativeBlock.GetEthereumBeacon().Block.Body.BlobKzgCommitments))
+	require.Empty(nativeBlock.GetEthereumBeacon().Blobs)
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_Skipped() {
+	require := testutil.Require(s.T())
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		SideChain:  api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON,
+		Metadata: &api.BlockMetadata{
+			Tag:     beaconTag,
+			Height:  beaconHeight - 1,
+			Skipped: true,
+		},
+	}
+
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(&api.NativeBlock{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		SideChain:  api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON,
+		Tag:        beaconTag,
+		Height:     beaconHeight - 1,
+		Skipped:    true,
+	}, nativeBlock)
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_InconsistentHash() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(nil)
+	block.Metadata.Hash = beaconParentHash
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected root in header")
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_InconsistentBlobs() {
+	require := testutil.Require(s.T())
+
+	var blobs BlobsResponse
+	err := json.Unmarshal(fixtures.MustReadFile("parser/ethereum/beacon/blob_sidecars.json"), &blobs)
+	require.NoError(err)
+	blobs.Data[0].KzgCommitment = blobs.Data[1].KzgCommitment
+	data, err := json.Marshal(&blobs)
+	require.NoError(err)
+
+	block := s.newBlock(data)
+	_, err = s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected kzg commitment in blob 0")
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_Electra() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(nil)
+	block.GetEthereumBeacon().Block = s.newElectraBlock(versionElectra)
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	data := nativeBlock.GetEthereumBeacon().Block
+	require.Equal(api.EthereumBeaconBlockData_ELECTRA, data.Version)
+
+	attestation := data.Body.Attestations[0]
+	require.Equal("0x8100000000000000", attestation.CommitteeBits)
+	require.Equal(uint64(0), attestation.Data.Index)
+
+	requests := data.Body.ExecutionRequests
+	require.NotNil(requests)
+	require.Equal([]*api.EthereumBeaconDepositRequest{
+		{
+			Pubkey:                electraPubkey,
+			WithdrawalCredentials: "0x020000000000000000000000a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+			Amount:                32000000000,
+			Signature:             electraSignature,
+			Index:                 1953427,
+		},
+	}, requests.Deposits)
+	require.Equal([]*api.EthereumBeaconWithdrawalRequest{
+		{
+			SourceAddress:   "0xa1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+			ValidatorPubkey: electraPubkey,
+			Amount:          0,
+		},
+	}, requests.Withdrawals)
+	require.Empty(requests.Consolidations)
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_Fulu() {
+	require := testutil.Require(s.T())
+
+	// The block body is unchanged by the Fulu fork.
+	block := s.newBlock(nil)
+	block.GetEthereumBeacon().Block = s.newElectraBlock(versionFulu)
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	data := nativeBlock.GetEthereumBeacon().Block
+	require.Equal(api.EthereumBeaconBlockData_FULU, data.Version)
+	require.NotNil(data.Body.ExecutionRequests)
+}
+
+func (s *beaconNativeParserTestSuite) TestParseBlock_UnknownVersion() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(nil)
+	block.GetEthereumBeacon().Block = s.newElectraBlock("gloas")
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unknown block version: gloas")
+}
+
+func (s *beaconNativeParserTestSuite) TestUnmarshalQuantity() {
+	require := testutil.Require(s.T())
+
+	var slot Quantity
+	require.NoError(json.Unmarshal([]byte(`"8000001"`), &slot))
+	require.Equal(beaconHeight, slot.Value())
+
+	require.NoError(json.Unmarshal([]byte(`""`), &slot))
+	require.Equal(uint64(0), slot.Value())
+
+	err := json.Unmarshal([]byte(`"0x1234"`), &slot)
+	require.Error(err)
+	require.Contains(err.Error(), "failed to decode Quantity")
+}
+
+func (s *beaconNativeParserTestSuite) TestGetSlotTimestamp() {
+	require := testutil.Require(s.T())
+
+	timestamp, err := GetSlotTimestamp(api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON, beaconHeight)
+	require.NoError(err)
+	require.Equal(testutil.MustTimestamp(beaconTimestamp), timestamp)
+
+	timestamp, err = GetSlotTimestamp(api.SideChain_SIDECHAIN_ETHEREUM_HOLESKY_BEACON, 1)
+	require.NoError(err)
+	require.Equal(testutil.MustTimestamp("2023-09-28T12:00:12Z"), timestamp)
+
+	_, err = GetSlotTimestamp(api.SideChain_SIDECHAIN_NONE, 1)
+	require.Error(err)
+}
+
+func (s *beaconNativeParserTestSuite) newBlock(blobs []byte) *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		SideChain:  api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON,
+		Metadata: &api.BlockMetadata{
+			Tag:          beaconTag,
+			Hash:         beaconHash,
+			ParentHash:   beaconParentHash,
+			Height:       beaconHeight,
+			ParentHeight: beaconParentHeight,
+			Timestamp:    testutil.MustTimestamp(beaconTimestamp),
+		},
+		Blobdata: &api.Block_EthereumBeacon{
+			EthereumBeacon: &api.EthereumBeaconBlobdata{
+				Header: fixtures.MustReadFile("parser/ethereum/beacon/block_header.json"),
+				Block:  fixtures.MustReadFile("parser/ethereum/beacon/block.json"),
+				Blobs:  blobs,
+			},
+		},
+	}
+}
+
+// newElectraBlock converts the block fixture into the post-Electra shape, where the attestations
+// carry the committee bits and the body carries the execution layer requests.
+// The result is NOT a mainnet block: the roots and the signature still belong to the Deneb fixture,
+// and a real post-Electra block from /eth/v2/beacon/blocks should replace it once captured.
+func (s *beaconNativeParserTestSuite) newElectraBlock(version string) []byte {
+	require := testutil.Require(s.T())
+
+	var block map[string]any
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("parser/ethereum/beacon/block.json"), &block))
+	block["version"] = version
+
+	body := block["data"].(map[string]any)["message"].(map[string]any)["body"].(map[string]any)
+	attestation := body["attestations"].([]any)[0].(map[string]any)
+	attestation["committee_bits"] = "0x8100000000000000"
+	attestation["data"].(map[string]any)["index"] = "0"
+	body["execution_requests"] = map[string]any{
+		"deposits": []any{
+			map[string]any{
+				"pubkey":                 electraPubkey,
+				"withdrawal_credentials": "0x020000000000000000000000a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+				"amount":                 "32000000000",
+				"signature":              electraSignature,
+				"index":                  "1953427",
+			},
+		},
+		"withdrawals": []any{
+			map[string]any{
+				"source_address":   "0xa1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+				"validator_pubkey": electraPubkey,
+				"amount":           "0",
+			},
+		},
+		"consolidations": []any{},
+	}
+
+	data, err := json.Marshal(block)
+	require.NoError(err)
+	return data
+}
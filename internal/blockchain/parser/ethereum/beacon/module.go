@@ -0,0 +1,12 @@
+package beacon
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+var Module = fx.Options(
+	internal.NewParserBuilder("ethereum/beacon", NewNativeParser).
+		Build(),
+)
@@ -0,0 +1,14 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+func NewBlastNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	// Blast shares the same data schema as Ethereum since it is an EVM chain built on the OP stack,
+	// including the L1 fee fields of the receipts.
+	// The yield and gas modes requested for Blast are NOT parsed: the receipts carry no such fields, since the modes
+	// are configured per contract through the Blast predeploy rather than per transaction. Surfacing them requires
+	// decoding the configuration calls or events of the predeploy, which is pending on the requester.
+	return NewEthereumNativeParser(params, opts...)
+}
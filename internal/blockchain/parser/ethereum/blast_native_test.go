@@ -0,0 +1,88 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type blastParserTestSuite struct {
+	suite.Suite
+
+	controller *gomock.Controller
+	testapp    testapp.TestApp
+	parser     internal.Parser
+}
+
+func TestBlastParserTestSuite(t *testing.T) {
+	suite.Run(t, new(blastParserTestSuite))
+}
+
+func (s *blastParserTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+
+	var parser internal.Parser
+	s.testapp = testapp.New(
+		s.T(),
+		Module,
+		internal.Module,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_BLAST, common.Network_NETWORK_BLAST_MAINNET),
+		fx.Populate(&parser),
+	)
+
+	s.parser = parser
+	s.NotNil(s.parser)
+}
+
+func (s *blastParserTestSuite) TearDownTest() {
+	s.testapp.Close()
+	s.controller.Finish()
+}
+
+func (s *blastParserTestSuite) TestParseBlastBlock() {
+	require := testutil.Require(s.T())
+
+	// The receipt is NOT from Blast mainnet: it is the Ethereum receipt fixture with the OP stack L1 fee fields added by
+	// hand, so it only covers the parsing of those fields and should be replaced with a real eth_getTransactionReceipt
+	// response.
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_BLAST,
+		Network:    common.Network_NETWORK_BLAST_MAINNET,
+		Metadata:   ethereumMetadata,
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeader,
+				TransactionReceipts: [][]byte{fixtures.MustReadFile("parser/blast/synthetic_block_receipt.json")},
+				TransactionTraces:   [][]byte{fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")},
+			},
+		},
+	}
+
+	nativeBlock, err := s.parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_BLAST, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_BLAST_MAINNET, nativeBlock.Network)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+	require.Equal(1, len(actual.Transactions))
+
+	receipt := actual.Transactions[0].Receipt
+	require.Equal("0xe67071db25331ea3a92a4e28b516c95f2d5b62b68329b70386c19e00807f51d8", receipt.TransactionHash)
+	require.Equal(&api.EthereumTransactionReceipt_L1FeeInfo{
+		L1GasUsed:   0x8c4,
+		L1GasPrice:  0x3b9aca00,
+		L1Fee:       0x1f0a3e5c7b,
+		L1FeeScalar: "0.684",
+	}, receipt.GetL1FeeInfo())
+}
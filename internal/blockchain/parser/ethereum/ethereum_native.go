@@ -61,6 +61,17 @@ type (
 		// Note that the unit of withdrawal `amount` is in Gwei (1e9 wei).
 		Withdrawals     []*EthereumWithdrawal `json:"withdrawals"`
 		WithdrawalsRoot EthereumHexString     `json:"withdrawalsRoot"`
+
+		// EIP-4844 and EIP-4788 introduce new fields in the block header
+		// https://eips.ethereum.org/EIPS/eip-4844
+		// https://eips.ethereum.org/EIPS/eip-4788
+		BlobGasUsed           *EthereumQuantity `json:"blobGasUsed"`
+		ExcessBlobGas         *EthereumQuantity `json:"excessBlobGas"`
+		ParentBeaconBlockRoot EthereumHexString `json:"parentBeaconBlockRoot"`
+
+		// EIP-7685 introduces the requests hash in the block header
+		// https://eips.ethereum.org/EIPS/eip-7685
+		RequestsHash EthereumHexString `json:"requestsHash"`
 	}
 
 	PolygonHeader struct {
@@ -86,6 +97,15 @@ type (
 		Amount         EthereumQuantity  `json:"amount"`
 	}
 
+	EthereumAuthorization struct {
+		ChainId EthereumQuantity  `json:"chainId"`
+		Address EthereumHexString `json:"address"`
+		Nonce   EthereumQuantity  `json:"nonce"`
+		YParity EthereumQuantity  `json:"yParity"`
+		R       EthereumHexString `json:"r"`
+		S       EthereumHexString `json:"s"`
+	}
+
 	EthereumTransactionAccess struct {
 		Address     EthereumHexString   `json:"address"`
 		StorageKeys []EthereumHexString `json:"storageKeys"`
@@ -116,6 +136,11 @@ type (
 		MaxPriorityFeePerGas *EthereumQuantity             `json:"maxPriorityFeePerGas"`
 		AccessList           *[]*EthereumTransactionAccess `json:"accessList"`
 		Mint                 *EthereumBigQuantity          `json:"mint"`
+		// The EIP-4844 related fields
+		MaxFeePerBlobGas    *EthereumQuantity   `json:"maxFeePerBlobGas"`
+		BlobVersionedHashes []EthereumHexString `json:"blobVersionedHashes"`
+		// The EIP-7702 related fields
+		AuthorizationList []*EthereumAuthorization `json:"authorizationList"`
 
 		// Deposit transaction fields for Optimism and Base.
 		SourceHash EthereumHexString `json:"sourceHash"`
@@ -162,10 +187,36 @@ type (
 		// Base/Optimism specific fields.
 		DepositNonce          *EthereumQuantity `json:"depositNonce"`
 		DepositReceiptVersion *EthereumQuantity `json:"depositReceiptVersion"`
+
+		// The EIP-4844 related fields.
+		BlobGasPrice *EthereumQuantity `json:"blobGasPrice"`
+		BlobGasUsed  *EthereumQuantity `json:"blobGasUsed"`
+
+		// zkSync specific fields.
+		// Ref: https://docs.zksync.io/build/api-reference/ethereum-rpc#eth_gettransactionreceipt
+		L1BatchNumber  *EthereumQuantity    `json:"l1BatchNumber"`
+		L1BatchTxIndex *EthereumQuantity    `json:"l1BatchTxIndex"`
+		L2ToL1Logs     []*EthereumL2ToL1Log `json:"l2ToL1Logs"`
+	}
+
+	EthereumL2ToL1Log struct {
+		BlockHash        EthereumHexString `json:"blockHash"`
+		BlockNumber      EthereumQuantity  `json:"blockNumber"`
+		L1BatchNumber    EthereumQuantity  `json:"l1BatchNumber"`
+		TransactionHash  EthereumHexString `json:"transactionHash"`
+		TransactionIndex EthereumQuantity  `json:"transactionIndex"`
+		TxIndexInL1Batch EthereumQuantity  `json:"txIndexInL1Batch"`
+		LogIndex         EthereumQuantity  `json:"logIndex"`
+		ShardId          EthereumQuantity  `json:"shardId"`
+		IsService        bool              `json:"isService"`
+		Sender           EthereumHexString `json:"sender"`
+		Key              EthereumHexString `json:"key"`
+		Value            EthereumHexString `json:"value"`
 	}
 
 	EthereumTransactionReceiptLit struct {
-		BlockHash EthereumHexString `json:"blockHash"`
+		BlockHash       EthereumHexString `json:"blockHash"`
+		TransactionHash EthereumHexString `json:"transactionHash"`
 	}
 
 	EthereumEventLog struct {
@@ -265,6 +316,17 @@ const (
 	// ERC-721: Transfer(address indexed _from, address indexed _to, uint256 indexed _tokenId)
 	TransferEventTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
 
+	// TransferSingleEventTopic is the hash of the ERC-1155 TransferSingle event:
+	// TransferSingle(address indexed _operator, address indexed _from, address indexed _to, uint256 _id, uint256 _value)
+	TransferSingleEventTopic = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+
+	// TransferBatchEventTopic is the hash of the ERC-1155 TransferBatch event:
+	// TransferBatch(address indexed _operator, address indexed _from, address indexed _to, uint256[] _ids, uint256[] _values)
+	TransferBatchEventTopic = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+
+	// abiWordSize is the size of a word in the ABI encoding.
+	abiWordSize = 32
+
 	ethNullAddress = "0x0000000000000000000000000000000000000000"
 
 	parserMetricsReasonKey    = "reason"
@@ -694,6 +756,18 @@ func (p *ethereumNativeParserImpl) parseHeader(data []byte) (*api.EthereumHeader
 			}
 		}
 
+		if transaction.MaxFeePerBlobGas != nil {
+			transactions[i].OptionalMaxFeePerBlobGas = &api.EthereumTransaction_MaxFeePerBlobGas{
+				MaxFeePerBlobGas: transaction.MaxFeePerBlobGas.Value(),
+			}
+		}
+		if len(transaction.BlobVersionedHashes) > 0 {
+			transactions[i].BlobVersionedHashes = p.copyEthereumHexStrings(transaction.BlobVersionedHashes)
+		}
+		if len(transaction.AuthorizationList) > 0 {
+			transactions[i].AuthorizationList = p.parseAuthorizationList(transaction.AuthorizationList)
+		}
+
 		if transaction.Mint != nil && transaction.Mint.Value() != "0" {
 			transactions[i].OptionalMint = &api.EthereumTransaction_Mint{
 				Mint: transaction.Mint.Value(),
@@ -738,34 +812,46 @@ func (p *ethereumNativeParserImpl) parseHeader(data []byte) (*api.EthereumHeader
 	uncles := p.copyEthereumHexStrings(block.Uncles)
 	withdrawals := p.parseWithdrawals(block.Withdrawals)
 	header := &api.EthereumHeader{
-		Hash:             block.Hash.Value(),
-		ParentHash:       block.ParentHash.Value(),
-		Number:           block.Number.Value(),
-		Timestamp:        &timestamp.Timestamp{Seconds: int64(block.Timestamp.Value())},
-		Transactions:     transactionHashes,
-		Nonce:            block.Nonce.Value(),
-		Sha3Uncles:       block.Sha3Uncles.Value(),
-		LogsBloom:        block.LogsBloom.Value(),
-		TransactionsRoot: block.TransactionsRoot.Value(),
-		StateRoot:        block.StateRoot.Value(),
-		ReceiptsRoot:     block.ReceiptsRoot.Value(),
-		Miner:            block.Miner.Value(),
-		Difficulty:       block.Difficulty.Value(),
-		TotalDifficulty:  block.TotalDifficulty.Value(),
-		ExtraData:        block.ExtraData.Value(),
-		Size:             block.Size.Value(),
-		GasLimit:         block.GasLimit.Value(),
-		GasUsed:          block.GasUsed.Value(),
-		Uncles:           uncles,
-		MixHash:          block.MixHash.Value(),
-		Withdrawals:      withdrawals,
-		WithdrawalsRoot:  block.WithdrawalsRoot.Value(),
+		Hash:                  block.Hash.Value(),
+		ParentHash:            block.ParentHash.Value(),
+		Number:                block.Number.Value(),
+		Timestamp:             &timestamp.Timestamp{Seconds: int64(block.Timestamp.Value())},
+		Transactions:          transactionHashes,
+		Nonce:                 block.Nonce.Value(),
+		Sha3Uncles:            block.Sha3Uncles.Value(),
+		LogsBloom:             block.LogsBloom.Value(),
+		TransactionsRoot:      block.TransactionsRoot.Value(),
+		StateRoot:             block.StateRoot.Value(),
+		ReceiptsRoot:          block.ReceiptsRoot.Value(),
+		Miner:                 block.Miner.Value(),
+		Difficulty:            block.Difficulty.Value(),
+		TotalDifficulty:       block.TotalDifficulty.Value(),
+		ExtraData:             block.ExtraData.Value(),
+		Size:                  block.Size.Value(),
+		GasLimit:              block.GasLimit.Value(),
+		GasUsed:               block.GasUsed.Value(),
+		Uncles:                uncles,
+		MixHash:               block.MixHash.Value(),
+		Withdrawals:           withdrawals,
+		WithdrawalsRoot:       block.WithdrawalsRoot.Value(),
+		ParentBeaconBlockRoot: block.ParentBeaconBlockRoot.Value(),
+		RequestsHash:          block.RequestsHash.Value(),
 	}
 	if block.BaseFeePerGas != nil {
 		header.OptionalBaseFeePerGas = &api.EthereumHeader_BaseFeePerGas{
 			BaseFeePerGas: block.BaseFeePerGas.Value(),
 		}
 	}
+	if block.BlobGasUsed != nil {
+		header.OptionalBlobGasUsed = &api.EthereumHeader_BlobGasUsed{
+			BlobGasUsed: block.BlobGasUsed.Value(),
+		}
+	}
+	if block.ExcessBlobGas != nil {
+		header.OptionalExcessBlobGas = &api.EthereumHeader_ExcessBlobGas{
+			ExcessBlobGas: block.ExcessBlobGas.Value(),
+		}
+	}
 
 	return header, transactions, nil
 }
@@ -844,6 +930,18 @@ func (p *ethereumNativeParserImpl) parseTransactionReceipts(blobdata *api.Ethere
 				optionalL1FeeInfo.L1FeeInfo.L1Fee = l1Fee
 			}
 
+		} else if receipt.L1Fee != nil {
+			// Scroll only reports the L1 fee.
+			l1Fee, err := receipt.L1Fee.Uint64()
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse receipt L1Fee to uint64 %v", receipt.L1Fee.Value())
+			}
+
+			receipts[i].OptionalL1FeeInfo = &api.EthereumTransactionReceipt_L1FeeInfo_{
+				L1FeeInfo: &api.EthereumTransactionReceipt_L1FeeInfo{
+					L1Fee: l1Fee,
+				},
+			}
 		}
 
 		if receipt.GasUsedForL1 != nil {
@@ -877,6 +975,35 @@ func (p *ethereumNativeParserImpl) parseTransactionReceipts(blobdata *api.Ethere
 				DepositReceiptVersion: receipt.DepositReceiptVersion.Value(),
 			}
 		}
+
+		if receipt.BlobGasPrice != nil {
+			receipts[i].OptionalBlobGasPrice = &api.EthereumTransactionReceipt_BlobGasPrice{
+				BlobGasPrice: receipt.BlobGasPrice.Value(),
+			}
+		}
+
+		if receipt.BlobGasUsed != nil {
+			receipts[i].OptionalBlobGasUsed = &api.EthereumTransactionReceipt_BlobGasUsed{
+				BlobGasUsed: receipt.BlobGasUsed.Value(),
+			}
+		}
+
+		// The L1 batch fields are null until the block is sealed into a batch.
+		if receipt.L1BatchNumber != nil {
+			receipts[i].OptionalL1BatchNumber = &api.EthereumTransactionReceipt_L1BatchNumber{
+				L1BatchNumber: receipt.L1BatchNumber.Value(),
+			}
+		}
+
+		if receipt.L1BatchTxIndex != nil {
+			receipts[i].OptionalL1BatchTxIndex = &api.EthereumTransactionReceipt_L1BatchTxIndex{
+				L1BatchTxIndex: receipt.L1BatchTxIndex.Value(),
+			}
+		}
+
+		if len(receipt.L2ToL1Logs) > 0 {
+			receipts[i].L2ToL1Logs = p.parseL2ToL1Logs(&receipt)
+		}
 	}
 
 	return receipts, nil
@@ -894,6 +1021,22 @@ func (p *ethereumNativeParserImpl) parseTransactionAccessList(transaction *Ether
 	return accessList
 }
 
+func (p *ethereumNativeParserImpl) parseAuthorizationList(authorizationList []*EthereumAuthorization) []*api.EthereumAuthorization {
+	result := make([]*api.EthereumAuthorization, len(authorizationList))
+	for i, authorization := range authorizationList {
+		result[i] = &api.EthereumAuthorization{
+			ChainId: authorization.ChainId.Value(),
+			Address: authorization.Address.Value(),
+			Nonce:   authorization.Nonce.Value(),
+			YParity: authorization.YParity.Value(),
+			R:       authorization.R.Value(),
+			S:       authorization.S.Value(),
+		}
+	}
+
+	return result
+}
+
 func (p *ethereumNativeParserImpl) parseWithdrawals(withdrawals []*EthereumWithdrawal) []*api.EthereumWithdrawal {
 	result := make([]*api.EthereumWithdrawal, len(withdrawals))
 	for i, withdrawal := range withdrawals {
@@ -926,6 +1069,28 @@ func (p *ethereumNativeParserImpl) parseEventLogs(receipt *EthereumTransactionRe
 	return logs
 }
 
+func (p *ethereumNativeParserImpl) parseL2ToL1Logs(receipt *EthereumTransactionReceipt) []*api.EthereumL2ToL1Log {
+	logs := make([]*api.EthereumL2ToL1Log, len(receipt.L2ToL1Logs))
+	for i, log := range receipt.L2ToL1Logs {
+		logs[i] = &api.EthereumL2ToL1Log{
+			BlockHash:        log.BlockHash.Value(),
+			BlockNumber:      log.BlockNumber.Value(),
+			L1BatchNumber:    log.L1BatchNumber.Value(),
+			TransactionHash:  log.TransactionHash.Value(),
+			TransactionIndex: log.TransactionIndex.Value(),
+			TxIndexInL1Batch: log.TxIndexInL1Batch.Value(),
+			LogIndex:         log.LogIndex.Value(),
+			ShardId:          log.ShardId.Value(),
+			IsService:        log.IsService,
+			Sender:           log.Sender.Value(),
+			Key:              log.Key.Value(),
+			Value:            log.Value.Value(),
+		}
+	}
+
+	return logs
+}
+
 func (p *ethereumNativeParserImpl) parseTransactionTraces(blobdata *api.EthereumBlobdata, transactions []*api.EthereumTransaction) ([]*api.EthereumTransactionTrace, error) {
 	numTransactions := len(transactions)
 	if len(blobdata.TransactionTraces) > numTransactions {
@@ -1208,6 +1373,14 @@ func (p *ethereumNativeParserImpl) parseTokenTransfers(transactionReceipts []*ap
 				if tokenTransfer != nil {
 					tokenTransfers = append(tokenTransfers, tokenTransfer)
 				}
+			} else if len(eventLog.Topics) == 4 && (eventLog.Topics[0] == TransferSingleEventTopic || eventLog.Topics[0] == TransferBatchEventTopic) {
+				// Parse ERC-1155 token
+				// https://eips.ethereum.org/EIPS/eip-1155
+				erc1155TokenTransfers, err := p.parseERC1155TokenTransfers(eventLog)
+				if err != nil {
+					return nil, xerrors.Errorf("failed to parse erc1155 token transfer: %w", err)
+				}
+				tokenTransfers = append(tokenTransfers, erc1155TokenTransfers...)
 			}
 		}
 
@@ -1335,6 +1508,127 @@ func (p *ethereumNativeParserImpl) parseERC721TokenTransfer(eventLog *api.Ethere
 	}, nil
 }
 
+// parseERC1155TokenTransfers returns one token transfer for each id in the event,
+// i.e. one for TransferSingle and len(_ids) for TransferBatch.
+func (p *ethereumNativeParserImpl) parseERC1155TokenTransfers(eventLog *api.EthereumEventLog) ([]*api.EthereumTokenTransfer, error) {
+	// Topic Indices
+	// -------------
+	// Index 0 - Event Signature
+	// Index 1 - operator
+	// Index 2 - from
+	// Index 3 - to
+
+	if len(eventLog.Topics) != 4 || (eventLog.Topics[0] != TransferSingleEventTopic && eventLog.Topics[0] != TransferBatchEventTopic) {
+		return nil, xerrors.Errorf("invalid erc1155 token transfer")
+	}
+
+	tokenAddress, err := internal.CleanAddress(eventLog.Address)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode token address for erc1155 %v: %w", eventLog.Address, err)
+	}
+
+	operatorAddress, err := internal.CleanAddress(eventLog.Topics[1])
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode operator address for erc1155 %v: %w", eventLog.Topics[1], err)
+	}
+
+	fromAddress, err := internal.CleanAddress(eventLog.Topics[2])
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode from address for erc1155 %v: %w", eventLog.Topics[2], err)
+	}
+
+	toAddress, err := internal.CleanAddress(eventLog.Topics[3])
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode to address for erc1155 %v: %w", eventLog.Topics[3], err)
+	}
+
+	data, err := hexutil.Decode(eventLog.Data)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode data for erc1155 %v: %w", eventLog.Data, err)
+	}
+
+	var ids, values []*big.Int
+	if eventLog.Topics[0] == TransferSingleEventTopic {
+		// Data: _id (uint256) || _value (uint256)
+		if len(data) == 2*abiWordSize {
+			ids = []*big.Int{new(big.Int).SetBytes(data[:abiWordSize])}
+			values = []*big.Int{new(big.Int).SetBytes(data[abiWordSize:])}
+		}
+	} else {
+		// Data: offset of _ids || offset of _values || length of _ids || _ids || length of _values || _values
+		ids = decodeABIUint256Array(data, 0)
+		values = decodeABIUint256Array(data, abiWordSize)
+	}
+
+	if len(ids) == 0 || len(ids) != len(values) {
+		// Ignore such event if the data is malformed.
+		p.Logger.Warn("invalid erc1155 event log data",
+			zap.Uint64("height", eventLog.BlockNumber),
+			zap.String("transaction_hash", eventLog.TransactionHash),
+			zap.Uint64("log_index", eventLog.LogIndex),
+		)
+		return nil, nil
+	}
+
+	tokenTransfers := make([]*api.EthereumTokenTransfer, len(ids))
+	for i := range ids {
+		tokenTransfers[i] = &api.EthereumTokenTransfer{
+			TokenAddress:     tokenAddress,
+			FromAddress:      fromAddress,
+			ToAddress:        toAddress,
+			Value:            values[i].String(),
+			TransactionHash:  eventLog.TransactionHash,
+			TransactionIndex: eventLog.TransactionIndex,
+			LogIndex:         eventLog.LogIndex,
+			BlockHash:        eventLog.BlockHash,
+			BlockNumber:      eventLog.BlockNumber,
+			TokenTransfer: &api.EthereumTokenTransfer_Erc1155{
+				Erc1155: &api.ERC1155TokenTransfer{
+					OperatorAddress: operatorAddress,
+					FromAddress:     fromAddress,
+					ToAddress:       toAddress,
+					TokenId:         ids[i].String(),
+					Value:           values[i].String(),
+				},
+			},
+		}
+	}
+
+	return tokenTransfers, nil
+}
+
+// decodeABIUint256Array decodes a uint256[] whose offset is stored at the given position of the ABI encoded data.
+// It returns nil if the data is malformed.
+func decodeABIUint256Array(data []byte, position int) []*big.Int {
+	readWord := func(pos uint64) (*big.Int, bool) {
+		if pos > uint64(len(data)) || uint64(len(data))-pos < abiWordSize {
+			return nil, false
+		}
+		return new(big.Int).SetBytes(data[pos : pos+abiWordSize]), true
+	}
+
+	offset, ok := readWord(uint64(position))
+	if !ok || !offset.IsUint64() {
+		return nil
+	}
+
+	length, ok := readWord(offset.Uint64())
+	if !ok || !length.IsUint64() || length.Uint64() > uint64(len(data))/abiWordSize {
+		return nil
+	}
+
+	result := make([]*big.Int, length.Uint64())
+	start := offset.Uint64() + abiWordSize
+	for i := range result {
+		result[i], ok = readWord(start + uint64(i)*abiWordSize)
+		if !ok {
+			return nil
+		}
+	}
+
+	return result
+}
+
 func (p *ethereumNativeParserImpl) parseUncles(blobdata *api.EthereumBlobdata) ([]*api.EthereumHeader, error) {
 	uncles := make([]*api.EthereumHeader, len(blobdata.Uncles))
 	for i, rawUncle := range blobdata.Uncles {
@@ -10,6 +10,8 @@ import (
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"go.uber.org/fx"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
 	"github.com/coinbase/chainstorage/internal/utils/fixtures"
@@ -1921,6 +1923,242 @@ func TestParseEthereumBlock_PostShanghai(t *testing.T) {
 	require.Equal(&expectedHeader, actual.Header)
 }
 
+func TestParseEthereumBlock_PostCancun(t *testing.T) {
+	require := testutil.Require(t)
+
+	// The fixture is NOT a mainnet block: it is built on the pre-Cancun block above with the EIP-4844 and EIP-4788
+	// fields added, and its transaction hash, trie roots and block hash are recomputed, so that it passes the validator.
+	// It should be replaced with a real post-Cancun mainnet block once captured.
+	const (
+		postCancunHash   = "0x495476a9cb98f7c65fba3c758396341802e30c6410cff58edc732fce81d6a4b7"
+		postCancunTxHash = "0x99bcb5b5d5dd523a7e399b013f7ba73aed5bbfb5ae3a7157b236be3ec915f069"
+	)
+
+	fixtureHeaderPostCancun := fixtures.MustReadFile("parser/ethereum/synthetic_block_header_post_cancun.json")
+	fixtureReceipt := fixtures.MustReadFile("parser/ethereum/synthetic_block_receipt_post_cancun.json")
+	fixtureTraces := fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          ethereumTag,
+			Hash:         postCancunHash,
+			ParentHash:   ethereumParentHash,
+			Height:       ethereumHeight,
+			ParentHeight: ethereumParentHeight,
+		},
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeaderPostCancun,
+				TransactionReceipts: [][]byte{fixtureReceipt},
+				TransactionTraces:   [][]byte{fixtureTraces},
+			},
+		},
+	}
+
+	var parser internal.Parser
+	app := testapp.New(
+		t,
+		Module,
+		internal.Module,
+		fx.Populate(&parser),
+	)
+	defer app.Close()
+	require.NotNil(parser)
+
+	nativeBlock, err := parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(postCancunHash, nativeBlock.Hash)
+	require.Equal(uint64(1), nativeBlock.NumTransactions)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+
+	header := actual.Header
+	require.NotNil(header.GetOptionalBlobGasUsed())
+	require.Equal(uint64(0x40000), header.GetBlobGasUsed())
+	require.NotNil(header.GetOptionalExcessBlobGas())
+	require.Equal(uint64(0x80000), header.GetExcessBlobGas())
+	require.Equal("0x7a6a0b8a3c0d3d0e4a4b2a1b7ac4d1c3b11a0ef6f5e7a0d8a55a8ba5ba3c0f1e", header.ParentBeaconBlockRoot)
+
+	require.Equal(1, len(actual.Transactions))
+	transaction := actual.Transactions[0]
+	require.Equal(postCancunTxHash, transaction.Hash)
+	require.Equal(uint64(3), transaction.Type)
+	require.NotNil(transaction.GetOptionalMaxFeePerBlobGas())
+	require.Equal(uint64(1_000_000_000), transaction.GetMaxFeePerBlobGas())
+	require.Equal([]string{
+		"0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8",
+		"0x01b2b5fc6ab4e8ba5bd8b4a4d4c5f4dc4d9d3d8d8b1b0a4d2c9a7c6b5b4a3f2e",
+	}, transaction.BlobVersionedHashes)
+
+	receipt := transaction.Receipt
+	require.NotNil(receipt)
+	require.Equal(uint64(3), receipt.Type)
+	require.NotNil(receipt.GetOptionalBlobGasPrice())
+	require.Equal(uint64(1), receipt.GetBlobGasPrice())
+	require.NotNil(receipt.GetOptionalBlobGasUsed())
+	require.Equal(uint64(0x40000), receipt.GetBlobGasUsed())
+	require.Equal(postCancunTxHash, receipt.TransactionHash)
+
+	// The header hash covers the blob gas fields and the parent beacon block root.
+	require.NoError(parser.ValidateBlock(context.Background(), nativeBlock))
+}
+
+func TestParseEthereumBlock_PostPectra(t *testing.T) {
+	require := testutil.Require(t)
+
+	// Similar to the post-Cancun fixture, this is NOT a mainnet block: the EIP-7702 transaction and the EIP-7685
+	// requests hash are added, and the transaction hash, trie roots and block hash are recomputed.
+	// It should be replaced with a real post-Prague mainnet block once captured.
+	const (
+		postPectraHash   = "0x3188dbaa12f9b3d81be7e89d4e8470206e919c229446984da17e6fbf0b7e7091"
+		postPectraTxHash = "0xf3875c3a6166c466b94701a59ca2d3d06275fc00b90f1093280244eea0d069f1"
+	)
+
+	fixtureHeaderPostPectra := fixtures.MustReadFile("parser/ethereum/synthetic_block_header_post_pectra.json")
+	fixtureReceipt := fixtures.MustReadFile("parser/ethereum/synthetic_block_receipt_post_pectra.json")
+	fixtureTraces := fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          ethereumTag,
+			Hash:         postPectraHash,
+			ParentHash:   ethereumParentHash,
+			Height:       ethereumHeight,
+			ParentHeight: ethereumParentHeight,
+		},
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeaderPostPectra,
+				TransactionReceipts: [][]byte{fixtureReceipt},
+				TransactionTraces:   [][]byte{fixtureTraces},
+			},
+		},
+	}
+
+	var parser internal.Parser
+	app := testapp.New(
+		t,
+		Module,
+		internal.Module,
+		fx.Populate(&parser),
+	)
+	defer app.Close()
+	require.NotNil(parser)
+
+	nativeBlock, err := parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+
+	require.Equal(postPectraHash, nativeBlock.Hash)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+	// The requests hash of a block without any request is sha256 of the empty string.
+	require.Equal("0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", actual.Header.RequestsHash)
+	require.Equal(1, len(actual.Transactions))
+
+	transaction := actual.Transactions[0]
+	require.Equal(postPectraTxHash, transaction.Hash)
+	require.Equal(uint64(4), transaction.Type)
+	require.Equal(uint64(4), transaction.Receipt.Type)
+	require.Equal([]*api.EthereumAuthorization{
+		{
+			ChainId: 1,
+			Address: "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+			Nonce:   7,
+			YParity: 1,
+			R:       "0x80af9d3eb54902575ca9583643b4620e63fe5a5f708baa7d721d5e08eb33a28",
+			S:       "0xe3f0397993db20d6254a0d3aa069c6a7291f885dc00fb675097b6a399038513",
+		},
+	}, transaction.AuthorizationList)
+
+	// The header hash covers the requests hash.
+	require.NoError(parser.ValidateBlock(context.Background(), nativeBlock))
+
+	corruptBlock := proto.Clone(nativeBlock).(*api.NativeBlock)
+	corruptBlock.GetEthereum().Header.RequestsHash = "0x0000000000000000000000000000000000000000000000000000000000000000"
+	err = parser.ValidateBlock(context.Background(), corruptBlock)
+	require.True(xerrors.Is(err, ErrInvalidBlockHash))
+}
+
+func TestParseEthereumBlock_ERC1155TokenTransfers(t *testing.T) {
+	require := testutil.Require(t)
+
+	fixtureReceipt := fixtures.MustReadFile("parser/ethereum/raw_block_receipt_erc1155.json")
+	fixtureTraces := fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		Metadata:   ethereumMetadata,
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeader,
+				TransactionReceipts: [][]byte{fixtureReceipt},
+				TransactionTraces:   [][]byte{fixtureTraces},
+			},
+		},
+	}
+
+	var parser internal.Parser
+	app := testapp.New(
+		t,
+		Module,
+		internal.Module,
+		fx.Populate(&parser),
+	)
+	defer app.Close()
+	require.NotNil(parser)
+
+	nativeBlock, err := parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+	require.Equal(1, len(actual.Transactions))
+
+	const (
+		tokenAddress    = "0x76be3b62873462d2142405439777e971754e8e77"
+		operatorAddress = "0x1e0049783f008a0085193e00003d00cd54003c71"
+		fromAddress     = "0x98265d92b016df8758f361fb8d2f9a813c82494a"
+		toAddress       = "0x92330d8818e8a3b50f027c819fa46031ffba2c8c"
+	)
+	newTokenTransfer := func(logIndex uint64, tokenID string, value string) *api.EthereumTokenTransfer {
+		return &api.EthereumTokenTransfer{
+			TokenAddress:     tokenAddress,
+			FromAddress:      fromAddress,
+			ToAddress:        toAddress,
+			Value:            value,
+			TransactionIndex: 0,
+			TransactionHash:  "0xe67071db25331ea3a92a4e28b516c95f2d5b62b68329b70386c19e00807f51d8",
+			LogIndex:         logIndex,
+			BlockHash:        "0xbaa42c87b7c764c548fa37e61e9764415fd4a79d7e073d4f92a456698002016b",
+			BlockNumber:      0xacc290,
+			TokenTransfer: &api.EthereumTokenTransfer_Erc1155{
+				Erc1155: &api.ERC1155TokenTransfer{
+					OperatorAddress: operatorAddress,
+					FromAddress:     fromAddress,
+					ToAddress:       toAddress,
+					TokenId:         tokenID,
+					Value:           value,
+				},
+			},
+		}
+	}
+
+	// TransferSingle yields one token transfer, TransferBatch yields one token transfer per id,
+	// and the malformed TransferBatch with mismatched ids and values is ignored.
+	require.Equal([]*api.EthereumTokenTransfer{
+		newTokenTransfer(0, "10", "1"),
+		newTokenTransfer(1, "11", "5"),
+		newTokenTransfer(1, "12", "6"),
+	}, actual.Transactions[0].TokenTransfers)
+}
+
 func TestParseEthereumBlock_LargeEventLogData(t *testing.T) {
 	require := testutil.Require(t)
 
@@ -468,6 +468,87 @@ func (s *ethereumRosettaParserTestSuite) TestTraceOps_FailureNonZeroValue() {
 	require.Equal("0x7a250d5630b4cf539739df2c5dacb4c659f2488d", failedTxn.Operations[3].Account.Address)
 }
 
+func (s *ethereumRosettaParserTestSuite) TestFeeDetails_BlobTransaction() {
+	require := testutil.Require(s.T())
+
+	block := &api.EthereumBlock{
+		Header: &api.EthereumHeader{
+			OptionalBaseFeePerGas: &api.EthereumHeader_BaseFeePerGas{
+				BaseFeePerGas: 100,
+			},
+		},
+	}
+	transaction := &api.EthereumTransaction{
+		Type: 3,
+		OptionalMaxFeePerGas: &api.EthereumTransaction_MaxFeePerGas{
+			MaxFeePerGas: 200,
+		},
+		OptionalMaxPriorityFeePerGas: &api.EthereumTransaction_MaxPriorityFeePerGas{
+			MaxPriorityFeePerGas: 10,
+		},
+		OptionalPriorityFeePerGas: &api.EthereumTransaction_PriorityFeePerGas{
+			PriorityFeePerGas: 10,
+		},
+		Receipt: &api.EthereumTransactionReceipt{
+			GasUsed:           21000,
+			EffectiveGasPrice: 110,
+			OptionalBlobGasUsed: &api.EthereumTransactionReceipt_BlobGasUsed{
+				BlobGasUsed: 131072,
+			},
+			OptionalBlobGasPrice: &api.EthereumTransactionReceipt_BlobGasPrice{
+				BlobGasPrice: 3,
+			},
+		},
+	}
+
+	feeDetails, err := getFeeDetails(transaction, block)
+	require.NoError(err)
+	// The execution fee (21000 * 110) plus the blob fee (131072 * 3).
+	require.Equal("2703216", feeDetails.feeAmount.String())
+	// The burned base fee (21000 * 100) plus the blob fee (131072 * 3).
+	require.Equal("2493216", feeDetails.feeBurned.String())
+	require.Equal("110", feeDetails.effectiveFeePerGas.String())
+}
+
+func (s *ethereumRosettaParserTestSuite) TestAuthorizationListMetadata() {
+	require := testutil.Require(s.T())
+
+	authorizations := []*api.EthereumAuthorization{
+		{
+			ChainId: 1,
+			Address: "0x63C0c19a282a1B52b07dD5a65b58948A07DAE32B",
+			Nonce:   7,
+			YParity: 1,
+			R:       "0x80af9d3eb54902575ca9583643b4620e63fe5a5f708baa7d721d5e08eb33a28",
+			S:       "0xe3f0397993db20d6254a0d3aa069c6a7291f885dc00fb675097b6a399038513",
+		},
+		{
+			// The signature is invalid, so that the authority cannot be recovered.
+			ChainId: 1,
+			Address: "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+			Nonce:   8,
+			YParity: 2,
+			R:       "0x80af9d3eb54902575ca9583643b4620e63fe5a5f708baa7d721d5e08eb33a28",
+			S:       "0xe3f0397993db20d6254a0d3aa069c6a7291f885dc00fb675097b6a399038513",
+		},
+	}
+
+	metadata := getAuthorizationListMetadata(authorizations)
+	require.Equal([]any{
+		map[string]any{
+			"chain_id":  "0x1",
+			"address":   "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+			"nonce":     "0x7",
+			"authority": "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23",
+		},
+		map[string]any{
+			"chain_id": "0x1",
+			"address":  "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+			"nonce":    "0x8",
+		},
+	}, metadata)
+}
+
 func (s *ethereumRosettaParserTestSuite) normalizeTransactions(expected *rosetta.Transaction, actual *rosetta.Transaction) error {
 	// lowercase all rosetta_ethereum addresses to match ChainStorage
 	for i := range expected.Operations {
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
 	"go.uber.org/zap"
 
 	"golang.org/x/xerrors"
@@ -47,6 +48,75 @@ type (
 		Value *hexutil.Big `json:"value"`
 		Proof []string     `json:"proof"`
 	}
+
+	// derivableList overrides the encoding of some items in a types.DerivableList.
+	// It is used for the transaction types which are not supported by geth yet, e.g. EIP-7702.
+	derivableList struct {
+		types.DerivableList
+		overrides map[int][]byte
+	}
+
+	// setCodeTx is the payload of an EIP-7702 transaction, with the fields in the consensus encoding order.
+	// https://eips.ethereum.org/EIPS/eip-7702
+	setCodeTx struct {
+		ChainID    *uint256.Int
+		Nonce      uint64
+		GasTipCap  *uint256.Int
+		GasFeeCap  *uint256.Int
+		Gas        uint64
+		To         geth.Address
+		Value      *uint256.Int
+		Data       []byte
+		AccessList types.AccessList
+		AuthList   []setCodeAuthorization
+		V          *uint256.Int
+		R          *uint256.Int
+		S          *uint256.Int
+	}
+
+	setCodeAuthorization struct {
+		ChainID *uint256.Int
+		Address geth.Address
+		Nonce   uint64
+		V       uint8
+		R       *uint256.Int
+		S       *uint256.Int
+	}
+
+	// pragueHeader is the consensus encoding of a block header since the Prague fork,
+	// which appends the requests hash introduced by EIP-7685 to the Cancun header.
+	// https://eips.ethereum.org/EIPS/eip-7685
+	pragueHeader struct {
+		ParentHash       geth.Hash
+		UncleHash        geth.Hash
+		Coinbase         geth.Address
+		Root             geth.Hash
+		TxHash           geth.Hash
+		ReceiptHash      geth.Hash
+		Bloom            types.Bloom
+		Difficulty       *big.Int
+		Number           *big.Int
+		GasLimit         uint64
+		GasUsed          uint64
+		Time             uint64
+		Extra            []byte
+		MixDigest        geth.Hash
+		Nonce            types.BlockNonce
+		BaseFee          *big.Int
+		WithdrawalsHash  geth.Hash
+		BlobGasUsed      uint64
+		ExcessBlobGas    uint64
+		ParentBeaconRoot geth.Hash
+		RequestsHash     geth.Hash
+	}
+
+	// setCodeReceipt is the consensus encoding of an EIP-7702 receipt.
+	setCodeReceipt struct {
+		PostStateOrStatus []byte
+		CumulativeGasUsed uint64
+		Bloom             types.Bloom
+		Logs              []*types.Log
+	}
 )
 
 var (
@@ -160,9 +230,33 @@ func (v *ethereumValidator) validateBlockHeader(ctx context.Context, header *api
 		protocolHeader.WithdrawalsHash = &hash
 	}
 
+	// EIP-4844: include the blob gas fields in the block header.
+	if header.GetOptionalBlobGasUsed() != nil {
+		protocolHeader.BlobGasUsed = pointer.Ref(header.GetBlobGasUsed())
+	}
+	if header.GetOptionalExcessBlobGas() != nil {
+		protocolHeader.ExcessBlobGas = pointer.Ref(header.GetExcessBlobGas())
+	}
+
+	// EIP-4788: include the parent beacon block root in the block header.
+	if header.ParentBeaconBlockRoot != "" {
+		hash := geth.HexToHash(header.ParentBeaconBlockRoot)
+		protocolHeader.ParentBeaconRoot = &hash
+	}
+
 	// Note that Hash returns the block hash of the header, which is simply the keccak256 hash of its RLP encoding.
 	// We expect that the block hash recomputed following the protocol should match the one from the payload itself.
 	expectedHash := protocolHeader.Hash()
+
+	// EIP-7685: include the requests hash in the block header, which is not supported by geth yet.
+	if header.RequestsHash != "" {
+		var err error
+		expectedHash, err = hashPragueHeader(&protocolHeader, geth.HexToHash(header.RequestsHash))
+		if err != nil {
+			return xerrors.Errorf("failed to hash prague header: %w", err)
+		}
+	}
+
 	actualHash := geth.HexToHash(header.Hash)
 	if expectedHash != actualHash {
 		return xerrors.Errorf("unexpected block hash (expected=%v, actual=%v): %w", expectedHash, actualHash, ErrInvalidBlockHash)
@@ -171,6 +265,43 @@ func (v *ethereumValidator) validateBlockHeader(ctx context.Context, header *api
 	return nil
 }
 
+// Compute the block hash of a Prague header, i.e. keccak256(rlp(header)), where the requests hash is appended to the Cancun fields.
+func hashPragueHeader(header *types.Header, requestsHash geth.Hash) (geth.Hash, error) {
+	if header.BaseFee == nil || header.WithdrawalsHash == nil || header.BlobGasUsed == nil ||
+		header.ExcessBlobGas == nil || header.ParentBeaconRoot == nil {
+		return geth.Hash{}, xerrors.New("prague header is missing the fields of the previous forks")
+	}
+
+	encoded, err := rlp.EncodeToBytes(&pragueHeader{
+		ParentHash:       header.ParentHash,
+		UncleHash:        header.UncleHash,
+		Coinbase:         header.Coinbase,
+		Root:             header.Root,
+		TxHash:           header.TxHash,
+		ReceiptHash:      header.ReceiptHash,
+		Bloom:            header.Bloom,
+		Difficulty:       header.Difficulty,
+		Number:           header.Number,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Time:             header.Time,
+		Extra:            header.Extra,
+		MixDigest:        header.MixDigest,
+		Nonce:            header.Nonce,
+		BaseFee:          header.BaseFee,
+		WithdrawalsHash:  *header.WithdrawalsHash,
+		BlobGasUsed:      *header.BlobGasUsed,
+		ExcessBlobGas:    *header.ExcessBlobGas,
+		ParentBeaconRoot: *header.ParentBeaconRoot,
+		RequestsHash:     requestsHash,
+	})
+	if err != nil {
+		return geth.Hash{}, xerrors.Errorf("failed to encode prague header: %w", err)
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
 // Verify the withdrawals in the block with the withdrawals trie root hash.
 func (v *ethereumValidator) validateWithdrawals(ctx context.Context, withdrawals []*api.EthereumWithdrawal, withdrawalsRoot string) error {
 	if withdrawalsRoot != "" {
@@ -216,9 +347,19 @@ func (v *ethereumValidator) validateTransactions(ctx context.Context, transactio
 	}
 
 	// Convert the native transactions to geth transactions.
+	// The transactions not supported by geth are encoded separately.
 	gethTxs := make(types.Transactions, numTxs)
+	overrides := make(map[int][]byte)
 	var err error
 	for i := 0; i < numTxs; i++ {
+		if transactions[i].GetType() == eip7702TxType {
+			overrides[i], err = v.encodeSetCodeTransaction(transactions[i])
+			if err != nil {
+				return xerrors.Errorf("failed to encode set code transaction: %w", err)
+			}
+			continue
+		}
+
 		gethTxs[i], err = v.toGethTransaction(transactions[i])
 		if err != nil {
 			return xerrors.Errorf("failed to convert to geth transaction: %w)", err)
@@ -228,7 +369,8 @@ func (v *ethereumValidator) validateTransactions(ctx context.Context, transactio
 	expectedHash := geth.HexToHash(transactionsRoot)
 
 	// This is how geth calculates the transaction trie hash. We just leverage this function of geth to recompute it.
-	if actualHash := types.DeriveSha(gethTxs, trie.NewStackTrie(nil)); actualHash != expectedHash {
+	list := &derivableList{DerivableList: gethTxs, overrides: overrides}
+	if actualHash := types.DeriveSha(list, trie.NewStackTrie(nil)); actualHash != expectedHash {
 		return xerrors.Errorf("transaction root hash mismatch (expected=%x, actual=%x): %w", expectedHash, actualHash, ErrInvalidTransactionsHash)
 	}
 
@@ -290,11 +432,59 @@ func (v *ethereumValidator) toGethTransaction(transaction *api.EthereumTransacti
 		mint = m
 	}
 
-	// There are three tranaction types in geth. Convert the native transaction to one of those.
+	// There are four tranaction types in geth. Convert the native transaction to one of those.
 	// The logic here is the same to geth.
 	// https://github.com/ethereum/go-ethereum/blob/8013a494fe3f0812aa1661aba43898d22a6fc061/internal/ethapi/transaction_args.go#L284
 	var data types.TxData
 	switch {
+	case transaction.GetType() == types.BlobTxType:
+		v.logger.Debug(
+			"toGethTransaction: BlobTx",
+			zap.String("hash", transaction.GetHash()),
+		)
+		if to == nil {
+			return nil, xerrors.Errorf("blob transaction %s must have a recipient", transaction.GetHash())
+		}
+
+		// BlobTx uses uint256 instead of big.Int.
+		blobChainId, err := toUint256("chainId", chainId)
+		if err != nil {
+			return nil, err
+		}
+		blobValue, err := toUint256("value", value)
+		if err != nil {
+			return nil, err
+		}
+		blobV, err := toUint256("V", sv)
+		if err != nil {
+			return nil, err
+		}
+		blobR, err := toUint256("R", sr)
+		if err != nil {
+			return nil, err
+		}
+		blobS, err := toUint256("S", ss)
+		if err != nil {
+			return nil, err
+		}
+
+		data = &types.BlobTx{
+			ChainID:    blobChainId,
+			Nonce:      transaction.GetNonce(),
+			GasTipCap:  uint256.NewInt(transaction.GetMaxPriorityFeePerGas()),
+			GasFeeCap:  uint256.NewInt(transaction.GetMaxFeePerGas()),
+			Gas:        transaction.GetGas(),
+			To:         *to,
+			Value:      blobValue,
+			Data:       input,
+			AccessList: toGethAccessList(transaction.GetTransactionAccessList()),
+			BlobFeeCap: uint256.NewInt(transaction.GetMaxFeePerBlobGas()),
+			BlobHashes: hexToHash(transaction.GetBlobVersionedHashes()),
+			V:          blobV,
+			R:          blobR,
+			S:          blobS,
+		}
+
 	case transaction.GetOptionalMaxFeePerGas() != nil:
 		v.logger.Debug(
 			"toGethTransaction: DynamicFeeTx",
@@ -380,6 +570,105 @@ func (v *ethereumValidator) toGethTransaction(transaction *api.EthereumTransacti
 	return types.NewTx(data), nil
 }
 
+// Encode one EIP-7702 transaction following its consensus encoding, i.e. 0x04 || rlp(payload).
+func (v *ethereumValidator) encodeSetCodeTransaction(transaction *api.EthereumTransaction) ([]byte, error) {
+	if transaction == nil {
+		return nil, xerrors.New("input transaction is nil")
+	}
+
+	v.logger.Debug(
+		"encodeSetCodeTransaction",
+		zap.String("hash", transaction.GetHash()),
+	)
+
+	// A set code transaction cannot create a contract.
+	to := convertTo(transaction.GetTo())
+	if to == nil {
+		return nil, xerrors.Errorf("set code transaction %s must have a recipient", transaction.GetHash())
+	}
+
+	value, ok := new(big.Int).SetString(transaction.GetValue(), 10)
+	if !ok {
+		return nil, xerrors.Errorf("failed to convert value %s to big.Int", transaction.GetValue())
+	}
+
+	input, err := hexutil.Decode(transaction.GetInput())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to convert input %s to []byte, %w", transaction.GetInput(), err)
+	}
+
+	tx := &setCodeTx{
+		ChainID:    uint256.NewInt(transaction.GetChainId()),
+		Nonce:      transaction.GetNonce(),
+		GasTipCap:  uint256.NewInt(transaction.GetMaxPriorityFeePerGas()),
+		GasFeeCap:  uint256.NewInt(transaction.GetMaxFeePerGas()),
+		Gas:        transaction.GetGas(),
+		To:         *to,
+		Data:       input,
+		AccessList: toGethAccessList(transaction.GetTransactionAccessList()),
+		AuthList:   make([]setCodeAuthorization, len(transaction.GetAuthorizationList())),
+	}
+
+	if tx.Value, err = toUint256("value", value); err != nil {
+		return nil, err
+	}
+	if tx.V, err = hexToUint256("V", transaction.GetV()); err != nil {
+		return nil, err
+	}
+	if tx.R, err = hexToUint256("R", transaction.GetR()); err != nil {
+		return nil, err
+	}
+	if tx.S, err = hexToUint256("S", transaction.GetS()); err != nil {
+		return nil, err
+	}
+
+	for i, authorization := range transaction.GetAuthorizationList() {
+		tx.AuthList[i] = setCodeAuthorization{
+			ChainID: uint256.NewInt(authorization.GetChainId()),
+			Address: geth.HexToAddress(authorization.GetAddress()),
+			Nonce:   authorization.GetNonce(),
+			V:       uint8(authorization.GetYParity()),
+		}
+		if tx.AuthList[i].R, err = hexToUint256("authorization R", authorization.GetR()); err != nil {
+			return nil, err
+		}
+		if tx.AuthList[i].S, err = hexToUint256("authorization S", authorization.GetS()); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to encode set code transaction %s: %w", transaction.GetHash(), err)
+	}
+
+	return append([]byte{byte(eip7702TxType)}, payload...), nil
+}
+
+// Convert a hex string to a uint256.Int.
+func hexToUint256(name string, value string) (*uint256.Int, error) {
+	result, ok := new(big.Int).SetString(value, 0)
+	if !ok {
+		return nil, xerrors.Errorf("failed to convert %s %s to big.Int", name, value)
+	}
+
+	return toUint256(name, result)
+}
+
+// Convert a big.Int to a uint256.Int, which is used by the blob transactions.
+func toUint256(name string, value *big.Int) (*uint256.Int, error) {
+	if value == nil {
+		return nil, xerrors.Errorf("%s is missing", name)
+	}
+
+	result, overflow := uint256.FromBig(value)
+	if overflow {
+		return nil, xerrors.Errorf("failed to convert %s %v to uint256", name, value)
+	}
+
+	return result, nil
+}
+
 // Convert a hex string address to a *geth.Address. Need to return nil for "" input.
 func convertTo(to string) *geth.Address {
 	if to == "" {
@@ -421,19 +710,29 @@ func (v *ethereumValidator) validateReceipts(ctx context.Context, transactions [
 	}
 
 	// Convert the native receipts to geth receipts.
+	// The receipts not supported by geth are encoded separately.
 	gethReceipts := make(types.Receipts, numTxs)
+	overrides := make(map[int][]byte)
 	var err error
 	for i := 0; i < numTxs; i++ {
 		gethReceipts[i], err = toGethReceipt(transactions[i].GetReceipt())
 		if err != nil {
 			return xerrors.Errorf("failed to convert receipt: %w", err)
 		}
+
+		if uint64(gethReceipts[i].Type) == eip7702TxType {
+			overrides[i], err = encodeSetCodeReceipt(gethReceipts[i])
+			if err != nil {
+				return xerrors.Errorf("failed to encode set code receipt: %w", err)
+			}
+		}
 	}
 
 	expectedHash := geth.HexToHash(receiptsRoot)
 
 	// This is how geth calculates the receipt trie hash. We just leverage this function of geth to recompute it.
-	if actualHash := types.DeriveSha(gethReceipts, trie.NewStackTrie(nil)); actualHash != expectedHash {
+	list := &derivableList{DerivableList: gethReceipts, overrides: overrides}
+	if actualHash := types.DeriveSha(list, trie.NewStackTrie(nil)); actualHash != expectedHash {
 		return xerrors.Errorf("receipt root hash mismatch (expected=%x, actual=%x): %w", expectedHash, actualHash, ErrInvalidReceiptsHash)
 	}
 
@@ -488,9 +787,47 @@ func toGethReceipt(receipt *api.EthereumTransactionReceipt) (*types.Receipt, err
 		result.DepositReceiptVersion = pointer.Ref(receipt.GetDepositReceiptVersion())
 	}
 
+	// The blob gas fields are not part of the consensus encoding, but are kept for completeness.
+	if receipt.GetOptionalBlobGasUsed() != nil {
+		result.BlobGasUsed = receipt.GetBlobGasUsed()
+	}
+
+	if receipt.GetOptionalBlobGasPrice() != nil {
+		result.BlobGasPrice = new(big.Int).SetUint64(receipt.GetBlobGasPrice())
+	}
+
 	return result, nil
 }
 
+// Encode one EIP-7702 receipt following its consensus encoding, i.e. 0x04 || rlp(receipt).
+func encodeSetCodeReceipt(receipt *types.Receipt) ([]byte, error) {
+	status := []byte{}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		status = []byte{0x01}
+	}
+
+	payload, err := rlp.EncodeToBytes(&setCodeReceipt{
+		PostStateOrStatus: status,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		Bloom:             receipt.Bloom,
+		Logs:              receipt.Logs,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to encode set code receipt %s: %w", receipt.TxHash, err)
+	}
+
+	return append([]byte{byte(eip7702TxType)}, payload...), nil
+}
+
+func (l *derivableList) EncodeIndex(i int, w *bytes.Buffer) {
+	if encoded, ok := l.overrides[i]; ok {
+		w.Write(encoded)
+		return
+	}
+
+	l.DerivableList.EncodeIndex(i, w)
+}
+
 func toGethLogs(receiptLogs []*api.EthereumEventLog) ([]*types.Log, error) {
 	gethLogs := make([]*types.Log, len(receiptLogs))
 	for i, l := range receiptLogs {
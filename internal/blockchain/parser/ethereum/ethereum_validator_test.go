@@ -8,12 +8,17 @@ import (
 	"testing"
 
 	geth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"go.uber.org/fx"
 	"golang.org/x/xerrors"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
 	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/pointer"
 	"github.com/coinbase/chainstorage/internal/utils/testapp"
 	"github.com/coinbase/chainstorage/internal/utils/testutil"
 	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
@@ -167,6 +172,269 @@ func TestEthereumValidator_Failures(t *testing.T) {
 	corrupt_block.GetEthereum().GetHeader().Withdrawals[2].Address = "0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293fabc"
 	err = parser.ValidateBlock(ctx, corrupt_block)
 	require.True(xerrors.Is(err, ErrInvalidWithdrawalsHash))
+
+	// 5. Add the EIP-4844 and EIP-4788 fields to a pre-Cancun header and fail the block header verification.
+	// Add a zero blobGasUsed, which is still part of the header hash.
+	corrupt_block = proto.Clone(&block).(*api.NativeBlock)
+	corrupt_block.GetEthereum().GetHeader().OptionalBlobGasUsed = &api.EthereumHeader_BlobGasUsed{
+		BlobGasUsed: 0,
+	}
+	err = parser.ValidateBlock(ctx, corrupt_block)
+	require.True(xerrors.Is(err, ErrInvalidBlockHash))
+
+	// Add an excessBlobGas.
+	corrupt_block = proto.Clone(&block).(*api.NativeBlock)
+	corrupt_block.GetEthereum().GetHeader().OptionalExcessBlobGas = &api.EthereumHeader_ExcessBlobGas{
+		ExcessBlobGas: 131072,
+	}
+	err = parser.ValidateBlock(ctx, corrupt_block)
+	require.True(xerrors.Is(err, ErrInvalidBlockHash))
+
+	// Add a parentBeaconBlockRoot.
+	corrupt_block = proto.Clone(&block).(*api.NativeBlock)
+	corrupt_block.GetEthereum().GetHeader().ParentBeaconBlockRoot = "0x7a6a0b8a3c0d3d0e4a4b2a1b7ac4d1c3b11a0ef6f5e7a0d8a55a8ba5ba3c0f1e"
+	err = parser.ValidateBlock(ctx, corrupt_block)
+	require.True(xerrors.Is(err, ErrInvalidBlockHash))
+}
+
+func TestEthereumValidator_BlobTransaction(t *testing.T) {
+	require := testutil.Require(t)
+
+	app := testapp.New(t)
+	defer app.Close()
+
+	v := &ethereumValidator{
+		config: app.Config(),
+		logger: app.Logger(),
+	}
+
+	transaction := &api.EthereumTransaction{
+		Hash:  "0x99bcb5b5d5dd523a7e399b013f7ba73aed5bbfb5ae3a7157b236be3ec915f069",
+		Type:  3,
+		Nonce: 496,
+		Gas:   90000,
+		To:    "0xdac17f958d2ee523a2206206994597c13d831ec7",
+		Value: "10",
+		Input: "0xa9059cbb00000000000000000000000022852cdfdda5eb9b0e25d6581bdb82a156ac4c400000000000000000000000000000000000000000000000000000000162598040",
+		V:     "0x0",
+		R:     "0x5f2ba54bcb85a3a3de43dd80ab4905247c8e898ec892b83e133fc9edbb3a9586",
+		S:     "0x6de1025cf859b6bc635fae85e6c460e4ccb6f05a74fa42164f14a409fb28a957",
+		OptionalChainId: &api.EthereumTransaction_ChainId{
+			ChainId: 1,
+		},
+		OptionalMaxFeePerGas: &api.EthereumTransaction_MaxFeePerGas{
+			MaxFeePerGas: 2_000_000_000,
+		},
+		OptionalMaxPriorityFeePerGas: &api.EthereumTransaction_MaxPriorityFeePerGas{
+			MaxPriorityFeePerGas: 1,
+		},
+		OptionalMaxFeePerBlobGas: &api.EthereumTransaction_MaxFeePerBlobGas{
+			MaxFeePerBlobGas: 1_000_000_000,
+		},
+		BlobVersionedHashes: []string{
+			"0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8",
+			"0x01b2b5fc6ab4e8ba5bd8b4a4d4c5f4dc4d9d3d8d8b1b0a4d2c9a7c6b5b4a3f2e",
+		},
+	}
+
+	tx, err := v.toGethTransaction(transaction)
+	require.NoError(err)
+	require.Equal(transaction.Hash, tx.Hash().Hex())
+	require.Equal(uint8(types.BlobTxType), tx.Type())
+	require.Equal(uint64(1), tx.ChainId().Uint64())
+	require.Equal(uint64(496), tx.Nonce())
+	require.Equal(uint64(90000), tx.Gas())
+	require.Equal(uint64(2_000_000_000), tx.GasFeeCap().Uint64())
+	require.Equal(uint64(1), tx.GasTipCap().Uint64())
+	require.Equal(uint64(1_000_000_000), tx.BlobGasFeeCap().Uint64())
+	require.Equal(uint64(10), tx.Value().Uint64())
+	require.Equal(geth.HexToAddress(transaction.To), *tx.To())
+	require.Equal([]geth.Hash{
+		geth.HexToHash("0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8"),
+		geth.HexToHash("0x01b2b5fc6ab4e8ba5bd8b4a4d4c5f4dc4d9d3d8d8b1b0a4d2c9a7c6b5b4a3f2e"),
+	}, tx.BlobHashes())
+
+	// A blob transaction cannot create a contract.
+	transaction.To = ""
+	_, err = v.toGethTransaction(transaction)
+	require.Error(err)
+	require.Contains(err.Error(), "must have a recipient")
+}
+
+func TestEthereumValidator_SetCodeTransaction(t *testing.T) {
+	require := testutil.Require(t)
+
+	app := testapp.New(t)
+	defer app.Close()
+
+	v := &ethereumValidator{
+		config: app.Config(),
+		logger: app.Logger(),
+	}
+
+	transaction := &api.EthereumTransaction{
+		Hash:  "0x9d6e5f1b4a3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e",
+		Type:  4,
+		Nonce: 5,
+		Gas:   100000,
+		To:    "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23",
+		Value: "0",
+		Input: "0xabcd",
+		V:     "0x0",
+		R:     "0x5f2ba54bcb85a3a3de43dd80ab4905247c8e898ec892b83e133fc9edbb3a9586",
+		S:     "0x6de1025cf859b6bc635fae85e6c460e4ccb6f05a74fa42164f14a409fb28a957",
+		OptionalChainId: &api.EthereumTransaction_ChainId{
+			ChainId: 1,
+		},
+		OptionalMaxFeePerGas: &api.EthereumTransaction_MaxFeePerGas{
+			MaxFeePerGas: 3_000_000_000,
+		},
+		OptionalMaxPriorityFeePerGas: &api.EthereumTransaction_MaxPriorityFeePerGas{
+			MaxPriorityFeePerGas: 2,
+		},
+		OptionalTransactionAccessList: &api.EthereumTransaction_TransactionAccessList{
+			TransactionAccessList: &api.EthereumTransactionAccessList{
+				AccessList: []*api.EthereumTransactionAccess{
+					{
+						Address:     "0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae",
+						StorageKeys: []string{"0x0000000000000000000000000000000000000000000000000000000000000003"},
+					},
+				},
+			},
+		},
+		AuthorizationList: []*api.EthereumAuthorization{
+			{
+				ChainId: 1,
+				Address: "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+				Nonce:   7,
+				YParity: 1,
+				R:       "0x080af9d3eb54902575ca9583643b4620e63fe5a5f708baa7d721d5e08eb33a28",
+				S:       "0x0e3f0397993db20d6254a0d3aa069c6a7291f885dc00fb675097b6a399038513",
+			},
+		},
+	}
+
+	// The expected encoding is 0x04 || rlp([chain_id, nonce, max_priority_fee_per_gas, max_fee_per_gas, gas_limit,
+	// destination, value, data, access_list, authorization_list, signature_y_parity, signature_r, signature_s]),
+	// i.e. the payload of an EIP-1559 transaction encoded by geth, with the authorization list inserted before the signature.
+	encoded, err := v.encodeSetCodeTransaction(transaction)
+	require.NoError(err)
+	require.Equal(byte(eip7702TxType), encoded[0])
+	var items []rlp.RawValue
+	require.NoError(rlp.DecodeBytes(encoded[1:], &items))
+	require.Equal(13, len(items))
+
+	dynamicFeeTransaction := proto.Clone(transaction).(*api.EthereumTransaction)
+	dynamicFeeTransaction.Type = types.DynamicFeeTxType
+	dynamicFeeTransaction.AuthorizationList = nil
+	dynamicFeeTx, err := v.toGethTransaction(dynamicFeeTransaction)
+	require.NoError(err)
+	dynamicFeeEncoded, err := dynamicFeeTx.MarshalBinary()
+	require.NoError(err)
+	require.Equal(byte(types.DynamicFeeTxType), dynamicFeeEncoded[0])
+	var dynamicFeeItems []rlp.RawValue
+	require.NoError(rlp.DecodeBytes(dynamicFeeEncoded[1:], &dynamicFeeItems))
+	require.Equal(12, len(dynamicFeeItems))
+	require.Equal(dynamicFeeItems[:9], items[:9])
+	require.Equal(dynamicFeeItems[9:], items[10:])
+
+	// Each authorization is encoded as [chain_id, address, nonce, y_parity, r, s].
+	var authorizations []struct {
+		ChainID uint64
+		Address geth.Address
+		Nonce   uint64
+		YParity uint8
+		R       *big.Int
+		S       *big.Int
+	}
+	require.NoError(rlp.DecodeBytes(items[9], &authorizations))
+	require.Equal(1, len(authorizations))
+	require.Equal(uint64(1), authorizations[0].ChainID)
+	require.Equal(geth.HexToAddress("0x63c0c19a282a1b52b07dd5a65b58948a07dae32b"), authorizations[0].Address)
+	require.Equal(uint64(7), authorizations[0].Nonce)
+	require.Equal(uint8(1), authorizations[0].YParity)
+	require.Equal("0x80af9d3eb54902575ca9583643b4620e63fe5a5f708baa7d721d5e08eb33a28", hexutil.EncodeBig(authorizations[0].R))
+	require.Equal("0xe3f0397993db20d6254a0d3aa069c6a7291f885dc00fb675097b6a399038513", hexutil.EncodeBig(authorizations[0].S))
+
+	// A set code transaction cannot create a contract.
+	transaction.To = ""
+	_, err = v.encodeSetCodeTransaction(transaction)
+	require.Error(err)
+	require.Contains(err.Error(), "must have a recipient")
+
+	// The expected encoding is 0x04 || rlp([status, cumulative_gas_used, logs_bloom, logs]),
+	// which only differs from the encoding of an EIP-1559 receipt by geth in the type.
+	receipt := &types.Receipt{
+		Type:              types.DynamicFeeTxType,
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs: []*types.Log{
+			{
+				Address: geth.HexToAddress("0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae"),
+				Topics:  []geth.Hash{geth.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+				Data:    []byte{0x01},
+			},
+		},
+	}
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	for _, status := range []uint64{types.ReceiptStatusSuccessful, types.ReceiptStatusFailed} {
+		receipt.Status = status
+		dynamicFeeEncoded, err := receipt.MarshalBinary()
+		require.NoError(err)
+
+		encoded, err := encodeSetCodeReceipt(receipt)
+		require.NoError(err)
+		require.Equal(byte(eip7702TxType), encoded[0])
+		require.Equal(dynamicFeeEncoded[1:], encoded[1:])
+	}
+}
+
+func TestEthereumValidator_PragueHeader(t *testing.T) {
+	require := testutil.Require(t)
+
+	header := &types.Header{
+		ParentHash:       geth.HexToHash("0xb91edf64c8c47f199398050a1d18efc3b00725d866b875e340198f563a000575"),
+		UncleHash:        types.EmptyUncleHash,
+		Coinbase:         geth.HexToAddress("0xd224ca0c819e8e97ba0136b3b95ceff503b79f53"),
+		Root:             geth.HexToHash("0xf7135b656a6513846894dad825c7a2403ee2f93ea9e3fe0e8cd846ba0df2fd7d"),
+		TxHash:           types.EmptyTxsHash,
+		ReceiptHash:      types.EmptyReceiptsHash,
+		Difficulty:       big.NewInt(0),
+		Number:           big.NewInt(11322000),
+		GasLimit:         36_000_000,
+		Time:             1606233657,
+		Extra:            []byte("chainstorage"),
+		BaseFee:          big.NewInt(1_000_000_000),
+		WithdrawalsHash:  &types.EmptyWithdrawalsHash,
+		BlobGasUsed:      pointer.Ref(uint64(0)),
+		ExcessBlobGas:    pointer.Ref(uint64(0x80000)),
+		ParentBeaconRoot: &geth.Hash{0x7a},
+	}
+
+	// The requests hash of a block without any request is sha256 of the empty string.
+	requestsHash := geth.HexToHash("0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+	actual, err := hashPragueHeader(header, requestsHash)
+	require.NoError(err)
+
+	// The Prague header is the Cancun header encoded by geth with the requests hash appended.
+	// The header above is not a mainnet header, so this only checks the encoding against geth rather than a known hash;
+	// a real Prague header with its on-chain hash should be added once captured.
+	cancunEncoded, err := rlp.EncodeToBytes(header)
+	require.NoError(err)
+	var items []rlp.RawValue
+	require.NoError(rlp.DecodeBytes(cancunEncoded, &items))
+	require.Equal(20, len(items))
+	encodedRequestsHash, err := rlp.EncodeToBytes(requestsHash)
+	require.NoError(err)
+	pragueEncoded, err := rlp.EncodeToBytes(append(items, encodedRequestsHash))
+	require.NoError(err)
+	require.Equal(crypto.Keccak256Hash(pragueEncoded), actual)
+	require.NotEqual(header.Hash(), actual)
+
+	// The fields of the previous forks are mandatory.
+	header.ParentBeaconRoot = nil
+	_, err = hashPragueHeader(header, requestsHash)
+	require.Error(err)
 }
 
 func TestValidateAccountState_Success(t *testing.T) {
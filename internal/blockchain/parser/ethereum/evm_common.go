@@ -6,8 +6,11 @@ import (
 	"math/big"
 	"strings"
 
+	geth "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"golang.org/x/xerrors"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -21,6 +24,8 @@ const (
 	// EIP-2718 transaction types.
 	legacyTxType  = uint64(0)
 	eip1559TxType = uint64(2)
+	eip4844TxType = uint64(3)
+	eip7702TxType = uint64(4)
 
 	// ethByzantiumHardForkHeight is the Byzantium hard fork height which changed the tx receipt status format
 	ethByzantiumHardForkHeight  = 4_370_000
@@ -72,7 +77,7 @@ func getFeeDetails(transaction *api.EthereumTransaction, block *api.EthereumBloc
 	gasUsed := big.NewInt(int64(transaction.Receipt.GasUsed))
 	txType := transaction.GetType()
 
-	if txType == eip1559TxType {
+	if txType == eip1559TxType || txType == eip4844TxType || txType == eip7702TxType {
 		if transaction.GetOptionalMaxPriorityFeePerGas() == nil {
 			return nil, xerrors.Errorf("Miss maxPriorityFeePerGas for transaction %v", transaction.Hash)
 		}
@@ -102,6 +107,20 @@ func getFeeDetails(transaction *api.EthereumTransaction, block *api.EthereumBloc
 		feeBurn = new(big.Int).Mul(gasUsed, baseFeePerGas)
 	}
 
+	// EIP-4844 blob transactions additionally pay for the blob gas, which is entirely burned.
+	receipt := transaction.GetReceipt()
+	if receipt.GetOptionalBlobGasUsed() != nil && receipt.GetOptionalBlobGasPrice() != nil {
+		blobFee := new(big.Int).Mul(
+			new(big.Int).SetUint64(receipt.GetBlobGasUsed()),
+			new(big.Int).SetUint64(receipt.GetBlobGasPrice()),
+		)
+		feeAmount.Add(feeAmount, blobFee)
+		if feeBurn == nil {
+			feeBurn = new(big.Int)
+		}
+		feeBurn.Add(feeBurn, blobFee)
+	}
+
 	return &feeDetails{
 		maxPriorityFeePerGas: maxPriorityFeePerGas,
 		maxFeePerGas:         maxFeePerGas,
@@ -329,12 +348,17 @@ func getRosettaTransactionsFromCSBlock(
 			}
 		}
 
-		metadata, err := rosetta.FromSDKMetadata(map[string]any{
+		txMetadata := map[string]any{
 			"gas_limit": hexutil.EncodeUint64(ethTxn.GetGas()),
 			"gas_price": hexutil.EncodeUint64(ethTxn.GetGasPrice()),
 			"receipt":   receiptMap,
 			"trace":     traceMap,
-		})
+		}
+		if len(ethTxn.GetAuthorizationList()) > 0 {
+			txMetadata["authorization_list"] = getAuthorizationListMetadata(ethTxn.GetAuthorizationList())
+		}
+
+		metadata, err := rosetta.FromSDKMetadata(txMetadata)
 		if err != nil {
 			return nil, xerrors.Errorf("failed to convert transaction metadata to rosetta proto: %w", err)
 		}
@@ -415,3 +439,65 @@ func shouldIncludeType(traceType string) bool {
 	}
 	return true
 }
+
+// getAuthorizationListMetadata converts the EIP-7702 authorizations of a transaction to rosetta metadata.
+// The authority, i.e. the account delegating its code to the address, is recovered from the signature.
+func getAuthorizationListMetadata(authorizations []*api.EthereumAuthorization) []any {
+	result := make([]any, len(authorizations))
+	for i, authorization := range authorizations {
+		metadata := map[string]any{
+			"chain_id": hexutil.EncodeUint64(authorization.GetChainId()),
+			"address":  strings.ToLower(authorization.GetAddress()),
+			"nonce":    hexutil.EncodeUint64(authorization.GetNonce()),
+		}
+
+		// An invalid authorization is skipped by the protocol, without failing the transaction.
+		if authority, err := recoverAuthority(authorization); err == nil {
+			metadata["authority"] = strings.ToLower(authority.Hex())
+		}
+
+		result[i] = metadata
+	}
+
+	return result
+}
+
+// recoverAuthority recovers the signer of an EIP-7702 authorization,
+// which signs keccak256(0x05 || rlp([chain_id, address, nonce])).
+func recoverAuthority(authorization *api.EthereumAuthorization) (geth.Address, error) {
+	r, ok := new(big.Int).SetString(authorization.GetR(), 0)
+	if !ok {
+		return geth.Address{}, xerrors.Errorf("failed to convert R %s to big.Int", authorization.GetR())
+	}
+
+	s, ok := new(big.Int).SetString(authorization.GetS(), 0)
+	if !ok {
+		return geth.Address{}, xerrors.Errorf("failed to convert S %s to big.Int", authorization.GetS())
+	}
+
+	if authorization.GetYParity() > 1 || !crypto.ValidateSignatureValues(byte(authorization.GetYParity()), r, s, true) {
+		return geth.Address{}, xerrors.New("invalid authorization signature")
+	}
+
+	payload, err := rlp.EncodeToBytes([]any{
+		new(big.Int).SetUint64(authorization.GetChainId()),
+		geth.HexToAddress(authorization.GetAddress()),
+		authorization.GetNonce(),
+	})
+	if err != nil {
+		return geth.Address{}, xerrors.Errorf("failed to encode authorization: %w", err)
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:64])
+	signature[crypto.RecoveryIDOffset] = byte(authorization.GetYParity())
+
+	hash := crypto.Keccak256(append([]byte{0x05}, payload...))
+	publicKey, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return geth.Address{}, xerrors.Errorf("failed to recover authority: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
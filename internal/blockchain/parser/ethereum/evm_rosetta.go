@@ -0,0 +1,294 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+	rosetta "github.com/coinbase/chainstorage/protos/coinbase/crypto/rosetta/types"
+)
+
+type (
+	// evmRosettaParserImpl is a rosetta parser shared by the EVM chains
+	// which do not need any chain specific operations besides the fees.
+	evmRosettaParserImpl struct {
+		logger       *zap.Logger
+		config       *config.Config
+		nativeParser internal.NativeParser
+		options      *evmRosettaOptions
+	}
+
+	evmRosettaOptions struct {
+		// currency is the native currency of the chain.
+		currency *rosetta.Currency
+		// l1FeeMode determines how the fee of posting the transaction to L1 is charged.
+		l1FeeMode l1FeeMode
+		// l1FeeRecipient receives the L1 portion of the transaction fee.
+		l1FeeRecipient string
+		// baseFeeRecipient receives the base fee. If not set, the base fee is burned.
+		baseFeeRecipient string
+	}
+
+	l1FeeMode int
+)
+
+const (
+	// l1FeeModeNone is used by the L1 chains.
+	l1FeeModeNone l1FeeMode = iota
+	// l1FeeModeOptimism is used by the OP stack chains,
+	// where the L1 data fee is charged on top of the L2 execution fee.
+	// See https://docs.optimism.io/stack/transactions/fees
+	l1FeeModeOptimism
+	// l1FeeModeArbitrum is used by the Arbitrum chains,
+	// where the gas paid for L1 is included in the gas used by the transaction.
+	// See https://docs.arbitrum.io/how-arbitrum-works/gas-fees
+	l1FeeModeArbitrum
+)
+
+const (
+	// ArbitrumL1PricerFundsPool receives the L1 portion of the transaction fees on Arbitrum.
+	// Ref: https://github.com/OffchainLabs/nitro/blob/master/arbos/l1pricing/l1pricing.go
+	ArbitrumL1PricerFundsPool = "0xa4b05fffffffffffffffffffffffffffffffffff"
+
+	// ArbitrumNetworkFeeAccount receives the L2 base fee on Arbitrum One, which is not burned.
+	// The account is stored in the ArbOS state and returned by ArbOwnerPublic.getNetworkFeeAccount.
+	// When an infra fee account is configured, ArbOS credits the part of the base fee up to the minimum base fee
+	// to that account instead; the split is not modeled since the minimum base fee is not available in the block.
+	// Ref: https://github.com/OffchainLabs/nitro/blob/master/arbos/tx_processor.go
+	ArbitrumNetworkFeeAccount = "0xbf5041fc07e1c866d15c749156657b8eed0fb649"
+)
+
+var (
+	bscRosettaCurrency = rosetta.Currency{
+		Symbol:   "BNB",
+		Decimals: 18,
+	}
+
+	avacchainRosettaCurrency = rosetta.Currency{
+		Symbol:   "AVAX",
+		Decimals: 18,
+	}
+
+	fantomRosettaCurrency = rosetta.Currency{
+		Symbol:   "FTM",
+		Decimals: 18,
+	}
+)
+
+func NewBscRosettaParser(params internal.ParserParams, nativeParser internal.NativeParser, opts ...internal.ParserFactoryOption) (internal.RosettaParser, error) {
+	return newEVMRosettaParser(params, nativeParser, &evmRosettaOptions{
+		currency: &bscRosettaCurrency,
+	})
+}
+
+func NewAvacchainRosettaParser(params internal.ParserParams, nativeParser internal.NativeParser, opts ...internal.ParserFactoryOption) (internal.RosettaParser, error) {
+	return newEVMRosettaParser(params, nativeParser, &evmRosettaOptions{
+		currency: &avacchainRosettaCurrency,
+	})
+}
+
+func NewFantomRosettaParser(params internal.ParserParams, nativeParser internal.NativeParser, opts ...internal.ParserFactoryOption) (internal.RosettaParser, error) {
+	return newEVMRosettaParser(params, nativeParser, &evmRosettaOptions{
+		currency: &fantomRosettaCurrency,
+	})
+}
+
+func NewArbitrumRosettaParser(params internal.ParserParams, nativeParser internal.NativeParser, opts ...internal.ParserFactoryOption) (internal.RosettaParser, error) {
+	return newEVMRosettaParser(params, nativeParser, &evmRosettaOptions{
+		currency:         &ethereumRosettaCurrency,
+		l1FeeMode:        l1FeeModeArbitrum,
+		l1FeeRecipient:   ArbitrumL1PricerFundsPool,
+		baseFeeRecipient: ArbitrumNetworkFeeAccount,
+	})
+}
+
+func NewOptimismRosettaParser(params internal.ParserParams, nativeParser internal.NativeParser, opts ...internal.ParserFactoryOption) (internal.RosettaParser, error) {
+	return newEVMRosettaParser(params, nativeParser, &evmRosettaOptions{
+		currency:         &ethereumRosettaCurrency,
+		l1FeeMode:        l1FeeModeOptimism,
+		l1FeeRecipient:   L1FeeVault,
+		baseFeeRecipient: BaseFeeVault,
+	})
+}
+
+func newEVMRosettaParser(params internal.ParserParams, nativeParser internal.NativeParser, options *evmRosettaOptions) (internal.RosettaParser, error) {
+	return &evmRosettaParserImpl{
+		logger:       log.WithPackage(params.Logger),
+		config:       params.Config,
+		nativeParser: nativeParser,
+		options:      options,
+	}, nil
+}
+
+func (p *evmRosettaParserImpl) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.RosettaBlock, error) {
+	nativeBlock, err := p.nativeParser.ParseBlock(ctx, rawBlock)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse block into native format: %w", err)
+	}
+
+	block := nativeBlock.GetEthereum()
+	if block == nil {
+		return nil, xerrors.New("failed to find ethereum block")
+	}
+
+	blockIdentifier := &rosetta.BlockIdentifier{
+		Index: int64(rawBlock.GetMetadata().GetHeight()),
+		Hash:  rawBlock.GetMetadata().GetHash(),
+	}
+
+	parentBlockIdentifier := &rosetta.BlockIdentifier{
+		Index: int64(rawBlock.GetMetadata().GetParentHeight()),
+		Hash:  rawBlock.GetMetadata().GetParentHash(),
+	}
+
+	transactions, err := getRosettaTransactionsFromCSBlock(
+		block,
+		rawBlock.GetEthereum(),
+		block.GetHeader().GetMiner(),
+		p.options.currency,
+		p.feeOps,
+		p.mintOps,
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse block transactions: %w", err)
+	}
+
+	return &api.RosettaBlock{
+		Block: &rosetta.Block{
+			BlockIdentifier:       blockIdentifier,
+			ParentBlockIdentifier: parentBlockIdentifier,
+			Timestamp:             block.GetHeader().Timestamp,
+			Transactions:          transactions,
+			Metadata:              nil,
+		},
+	}, nil
+}
+
+// feeOps generates the fee operations for a transaction.
+// In summary:
+// * the from address pays the L2 execution fee, plus the L1 data fee on the OP stack chains
+// * the priority fee is paid to the miner
+// * the base fee is either paid to the base fee recipient or burned
+// * the L1 portion of the fee is paid to the L1 fee recipient
+func (p *evmRosettaParserImpl) feeOps(transaction *api.EthereumTransaction, miner string, block *api.EthereumBlock) ([]*rosetta.Operation, error) {
+	if p.options.l1FeeMode == l1FeeModeOptimism && IsDepositTx(transaction) {
+		return nil, nil
+	}
+
+	feeDetails, err := getFeeDetails(transaction, block)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to calculate fee details: %w", err)
+	}
+
+	feeAmount := feeDetails.feeAmount
+	feeBurned := feeDetails.feeBurned
+	var l1FeeAmount *big.Int
+	switch p.options.l1FeeMode {
+	case l1FeeModeOptimism:
+		l1FeeAmount = ExtractL1Fee(transaction)
+	case l1FeeModeArbitrum:
+		// ArbOS refunds the gas price above the base fee, even for the legacy transactions,
+		// and the gas used for L1 is included in the gas used by the transaction.
+		effectiveGasPrice := new(big.Int).SetUint64(transaction.GetReceipt().GetEffectiveGasPrice())
+		feeAmount = new(big.Int).Mul(feeDetails.gasUsed, effectiveGasPrice)
+		if l1GasUsed := transaction.GetReceipt().GetL1FeeInfo().GetL1GasUsed(); l1GasUsed > 0 {
+			l1FeeAmount = new(big.Int).Mul(new(big.Int).SetUint64(l1GasUsed), effectiveGasPrice)
+			feeAmount = new(big.Int).Sub(feeAmount, l1FeeAmount)
+			if feeBurned != nil {
+				l2GasUsed := new(big.Int).Sub(feeDetails.gasUsed, new(big.Int).SetUint64(l1GasUsed))
+				feeBurned = new(big.Int).Mul(l2GasUsed, new(big.Int).SetUint64(block.GetHeader().GetBaseFeePerGas()))
+			}
+		}
+	}
+
+	totalFeeAmount := feeAmount
+	if l1FeeAmount != nil {
+		totalFeeAmount = new(big.Int).Add(feeAmount, l1FeeAmount)
+	}
+
+	if totalFeeAmount.Sign() == 0 {
+		// This can happen for the system transactions.
+		return nil, nil
+	}
+
+	minerFeeAmount := feeAmount
+	fromFeeAmount := totalFeeAmount
+	if feeBurned != nil {
+		minerFeeAmount = new(big.Int).Sub(feeAmount, feeBurned)
+		if p.options.baseFeeRecipient == "" {
+			fromFeeAmount = new(big.Int).Sub(totalFeeAmount, feeBurned)
+		}
+	}
+
+	relatedOps := []*rosetta.OperationIdentifier{
+		{
+			Index: 0,
+		},
+	}
+
+	currency := p.options.currency
+	ops := []*rosetta.Operation{
+		generateOp(0, nil, opTypeFee, transaction.From, new(big.Int).Neg(fromFeeAmount), currency),
+		generateOp(1, relatedOps, opTypeFee, miner, minerFeeAmount, currency),
+	}
+
+	if feeBurned != nil {
+		if p.options.baseFeeRecipient != "" {
+			ops = append(ops, generateOp(int64(len(ops)), relatedOps, opTypeFee, p.options.baseFeeRecipient, feeBurned, currency))
+		} else {
+			ops = append(ops, generateOp(int64(len(ops)), nil, opTypeFee, transaction.From, new(big.Int).Neg(feeBurned), currency))
+		}
+	}
+
+	if l1FeeAmount != nil {
+		ops = append(ops, generateOp(int64(len(ops)), relatedOps, opTypeFee, p.options.l1FeeRecipient, l1FeeAmount, currency))
+	}
+
+	return ops, nil
+}
+
+// https://github.com/ethereum-optimism/optimism/blob/develop/specs/deposits.md
+// Ref: https://github.com/Inphi/optimism-rosetta/pull/77/files#diff-97c9722cf92f6dbff3f3280715701b484d2b69d0b67ba9b497c08e3a6d120bdcR34
+// The following code is inspired from the optimism rosetta pr with some modifications. The deposits.md explains the L1 deposit operations.
+// mintOps constructs a list of [RosettaTypes.Operation]s for a Deposit (tx_type=126) or "mint" transaction.
+func (p *evmRosettaParserImpl) mintOps(tx *api.EthereumTransaction, startIndex int) ([]*rosetta.Operation, error) {
+	if p.options.l1FeeMode != l1FeeModeOptimism {
+		return nil, nil
+	}
+
+	if !IsDepositTx(tx) || tx.GetMint() == "0" || tx.GetMint() == "" {
+		return nil, nil
+	}
+
+	mintAmount, err := internal.BigInt(tx.GetMint())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*rosetta.Operation{generateOp(int64(startIndex), nil, MintOpType, tx.From, mintAmount, p.options.currency)}, nil
+}
+
+func generateOp(opIndex int64, relatedOps []*rosetta.OperationIdentifier, opType string, address string, value *big.Int, currency *rosetta.Currency) *rosetta.Operation {
+	return &rosetta.Operation{
+		OperationIdentifier: &rosetta.OperationIdentifier{
+			Index: opIndex,
+		},
+		RelatedOperations: relatedOps,
+		Type:              opType,
+		Status:            opStatusSuccess,
+		Account: &rosetta.AccountIdentifier{
+			Address: address,
+		},
+		Amount: &rosetta.Amount{
+			Value:    value.String(),
+			Currency: currency,
+		},
+		Metadata: nil,
+	}
+}
@@ -0,0 +1,203 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+	rosetta "github.com/coinbase/chainstorage/protos/coinbase/crypto/rosetta/types"
+)
+
+// evmNativeParserStub returns the native block loaded from a fixture,
+// since the raw blocks are not available for these chains.
+type evmNativeParserStub struct {
+	block *api.NativeBlock
+}
+
+func (p *evmNativeParserStub) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+	return p.block, nil
+}
+
+func (p *evmNativeParserStub) GetTransaction(ctx context.Context, nativeBlock *api.NativeBlock, transactionHash string) (*api.NativeTransaction, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func TestEVMRosettaParser_Avacchain(t *testing.T) {
+	require := testutil.Require(t)
+
+	rosettaBlock := parseEVMRosettaBlock(
+		t,
+		common.Blockchain_BLOCKCHAIN_AVACCHAIN,
+		common.Network_NETWORK_AVACCHAIN_MAINNET,
+		NewAvacchainRosettaParser,
+		"parser/avacchain/native_block_31878202.json",
+	)
+	require.Equal(&rosetta.BlockIdentifier{
+		Index: 31878202,
+		Hash:  "0xbab92e1a11d73448b5d038a8199ffebd05cd912dcb0daca1294fd2ea1b5e274c",
+	}, rosettaBlock.Block.BlockIdentifier)
+	require.Equal(&rosetta.BlockIdentifier{
+		Index: 31878201,
+		Hash:  "0x7e664d0e0fc3a3e4521a0993112e33fd7c0986b531427dbc3f74f8ad54f847c4",
+	}, rosettaBlock.Block.ParentBlockIdentifier)
+
+	transactions := rosettaBlock.Block.Transactions
+	require.Equal(1, len(transactions))
+	require.Equal("0x5f4f9d16c5c48fcca31675d74339b83e320fa0a390b87590ff73da3feb21276a", transactions[0].TransactionIdentifier.Hash)
+
+	// The base fee is burned and the priority fee is paid to the coinbase.
+	from := "0xe93685f3bba03016f02bd1828badd6195988d950"
+	coinbase := "0x0100000000000000000000000000000000000000"
+	relatedOps := []*rosetta.OperationIdentifier{{Index: 0}}
+	require.Equal([]*rosetta.Operation{
+		generateOp(0, nil, opTypeFee, from, mustBigInt("-778055234058608"), &avacchainRosettaCurrency),
+		generateOp(1, relatedOps, opTypeFee, coinbase, mustBigInt("778055234058608"), &avacchainRosettaCurrency),
+		generateOp(2, nil, opTypeFee, from, mustBigInt("-5233884765941392"), &avacchainRosettaCurrency),
+	}, transactions[0].Operations)
+}
+
+func TestEVMRosettaParser_Arbitrum(t *testing.T) {
+	require := testutil.Require(t)
+
+	rosettaBlock := parseEVMRosettaBlock(
+		t,
+		common.Blockchain_BLOCKCHAIN_ARBITRUM,
+		common.Network_NETWORK_ARBITRUM_MAINNET,
+		NewArbitrumRosettaParser,
+		"parser/arbitrum/native_block_150_000_000.json",
+	)
+
+	transactions := rosettaBlock.Block.Transactions
+	require.Equal(5, len(transactions))
+
+	// The internal transaction does not pay any fee.
+	require.Equal("0x23f3f2a9c24b841171116d8032af7a2190389c1567e12a4174cf9bb1ec310765", transactions[0].TransactionIdentifier.Hash)
+	require.Empty(transactions[0].Operations)
+
+	// The legacy transaction is charged with the effective gas price instead of the gas price.
+	// The L2 base fee is paid to the network fee account, and the gas used for L1 is paid to the L1 pricer funds pool.
+	sequencer := "0xa4b000000000000000000073657175656e636572"
+	relatedOps := []*rosetta.OperationIdentifier{{Index: 0}}
+	from := "0x769d6a494aef318b175b76ec19dcbef3ac6f4a05"
+	require.Equal("0x02df6da97872f8a3e9760212bd548fc74663bd7f844f73969c81082ce7d3d632", transactions[1].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		generateOp(0, nil, opTypeFee, from, mustBigInt("-207885600000000"), &ethereumRosettaCurrency),
+		generateOp(1, relatedOps, opTypeFee, sequencer, mustBigInt("0"), &ethereumRosettaCurrency),
+		generateOp(2, relatedOps, opTypeFee, ArbitrumNetworkFeeAccount, mustBigInt("26274000000000"), &ethereumRosettaCurrency),
+		generateOp(3, relatedOps, opTypeFee, ArbitrumL1PricerFundsPool, mustBigInt("181611600000000"), &ethereumRosettaCurrency),
+	}, transactions[1].Operations)
+	require.Equal(int64(0), sumValues(transactions[1].Operations).Int64())
+
+	from = "0x6ae82f3b7de8f676a3dc391551af1c8d9c24616a"
+	require.Equal("0x733db5b954a397681603a3cc8e8344840174bff09e4f92210728448f075b1ef0", transactions[2].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		generateOp(0, nil, opTypeFee, from, mustBigInt("-44228200000000"), &ethereumRosettaCurrency),
+		generateOp(1, relatedOps, opTypeFee, sequencer, mustBigInt("0"), &ethereumRosettaCurrency),
+		generateOp(2, relatedOps, opTypeFee, ArbitrumNetworkFeeAccount, mustBigInt("2100000000000"), &ethereumRosettaCurrency),
+		generateOp(3, relatedOps, opTypeFee, ArbitrumL1PricerFundsPool, mustBigInt("42128200000000"), &ethereumRosettaCurrency),
+	}, transactions[2].Operations[:4])
+
+	// The value transfer follows the fee operations.
+	ops := transactions[2].Operations[4:]
+	require.Equal(2, len(ops))
+	require.Equal(int64(4), ops[0].OperationIdentifier.Index)
+	require.Equal(from, ops[0].Account.Address)
+	require.Equal("-523392010000000", ops[0].Amount.Value)
+	require.Equal(&ethereumRosettaCurrency, ops[0].Amount.Currency)
+	require.Equal(int64(5), ops[1].OperationIdentifier.Index)
+	require.Equal("0x3773a139b65fc2a6d58e22be7c94a8bf5b51bb3a", ops[1].Account.Address)
+	require.Equal("523392010000000", ops[1].Amount.Value)
+}
+
+func TestEVMRosettaParser_Optimism(t *testing.T) {
+	require := testutil.Require(t)
+
+	rosettaBlock := parseEVMRosettaBlock(
+		t,
+		common.Blockchain_BLOCKCHAIN_OPTIMISM,
+		common.Network_NETWORK_OPTIMISM_MAINNET,
+		NewOptimismRosettaParser,
+		"parser/optimism/mainnet/native_block_105237730.json",
+	)
+
+	transactions := rosettaBlock.Block.Transactions
+	require.Equal(2, len(transactions))
+
+	// The deposit transaction pays no fees.
+	require.Equal("0xe865bbfcd8b1d1c0b2dcc477114d893742b03b6f6e314237f32cc5b8e563f9ea", transactions[0].TransactionIdentifier.Hash)
+	require.Empty(transactions[0].Operations)
+
+	// The L1 data fee is charged on top of the L2 execution fee.
+	from := "0x9bbfb9919062c29a5ee15acd93c9d7c3b14d31aa"
+	sequencerFeeVault := "0x4200000000000000000000000000000000000011"
+	relatedOps := []*rosetta.OperationIdentifier{{Index: 0}}
+	require.Equal("0x69697f61c68da6c65b464ef68db8f7283114e2ce14caace4fa70a0efb4015ac0", transactions[1].TransactionIdentifier.Hash)
+	require.Equal([]*rosetta.Operation{
+		generateOp(0, nil, opTypeFee, from, mustBigInt("-67532696903365"), &ethereumRosettaCurrency),
+		generateOp(1, relatedOps, opTypeFee, sequencerFeeVault, mustBigInt("21338000000000"), &ethereumRosettaCurrency),
+		generateOp(2, relatedOps, opTypeFee, BaseFeeVault, mustBigInt("1066900"), &ethereumRosettaCurrency),
+		generateOp(3, relatedOps, opTypeFee, L1FeeVault, mustBigInt("46194695836465"), &ethereumRosettaCurrency),
+	}, transactions[1].Operations)
+	require.Equal(int64(0), sumValues(transactions[1].Operations).Int64())
+}
+
+func parseEVMRosettaBlock(
+	t *testing.T,
+	blockchain common.Blockchain,
+	network common.Network,
+	factory internal.RosettaParserFactory,
+	fixture string,
+) *api.RosettaBlock {
+	require := testutil.Require(t)
+
+	var nativeBlock api.NativeBlock
+	err := fixtures.UnmarshalPB(fixture, &nativeBlock)
+	require.NoError(err)
+
+	var parser internal.RosettaParser
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(blockchain, network),
+		fx.Provide(func() internal.NativeParser {
+			return &evmNativeParserStub{block: &nativeBlock}
+		}),
+		fx.Provide(factory),
+		fx.Populate(&parser),
+	)
+	defer app.Close()
+	require.NotNil(parser)
+
+	rosettaBlock, err := parser.ParseBlock(context.Background(), &api.Block{
+		Blockchain: blockchain,
+		Network:    network,
+		Metadata: &api.BlockMetadata{
+			Tag:          nativeBlock.Tag,
+			Hash:         nativeBlock.Hash,
+			ParentHash:   nativeBlock.ParentHash,
+			Height:       nativeBlock.Height,
+			ParentHeight: nativeBlock.ParentHeight,
+		},
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{},
+		},
+	})
+	require.NoError(err)
+	return rosettaBlock
+}
+
+func mustBigInt(value string) *big.Int {
+	v, err := internal.BigInt(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
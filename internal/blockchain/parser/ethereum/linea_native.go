@@ -0,0 +1,10 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+func NewLineaNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	// Linea shares the same data schema as Ethereum since its an EVM chain.
+	return NewEthereumNativeParser(params, opts...)
+}
@@ -0,0 +1,84 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type lineaParserTestSuite struct {
+	suite.Suite
+
+	controller *gomock.Controller
+	testapp    testapp.TestApp
+	parser     internal.Parser
+}
+
+func TestLineaParserTestSuite(t *testing.T) {
+	suite.Run(t, new(lineaParserTestSuite))
+}
+
+func (s *lineaParserTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+
+	var parser internal.Parser
+	s.testapp = testapp.New(
+		s.T(),
+		Module,
+		internal.Module,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_LINEA, common.Network_NETWORK_LINEA_MAINNET),
+		fx.Populate(&parser),
+	)
+
+	s.parser = parser
+	s.NotNil(s.parser)
+}
+
+func (s *lineaParserTestSuite) TearDownTest() {
+	s.testapp.Close()
+	s.controller.Finish()
+}
+
+func (s *lineaParserTestSuite) TestParseLineaBlock() {
+	require := testutil.Require(s.T())
+
+	// Linea does not add any field to the receipts, hence the Ethereum mainnet fixtures are reused rather than a Linea block.
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_LINEA,
+		Network:    common.Network_NETWORK_LINEA_MAINNET,
+		Metadata:   ethereumMetadata,
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeader,
+				TransactionReceipts: [][]byte{fixtures.MustReadFile("parser/ethereum/raw_block_receipt.json")},
+				TransactionTraces:   [][]byte{fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")},
+			},
+		},
+	}
+
+	nativeBlock, err := s.parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_LINEA, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_LINEA_MAINNET, nativeBlock.Network)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+	require.Equal(1, len(actual.Transactions))
+
+	receipt := actual.Transactions[0].Receipt
+	require.Equal("0xe67071db25331ea3a92a4e28b516c95f2d5b62b68329b70386c19e00807f51d8", receipt.TransactionHash)
+	require.Equal(uint64(0x1b889), receipt.GasUsed)
+	require.Nil(receipt.GetOptionalL1FeeInfo())
+	require.Nil(receipt.GetOptionalL1BatchNumber())
+	require.Empty(receipt.L2ToL1Logs)
+}
@@ -8,6 +8,7 @@ import (
 
 var Module = fx.Options(
 	internal.NewParserBuilder("bsc", NewBscNativeParser).
+		SetRosettaParserFactory(NewBscRosettaParser).
 		Build(),
 	internal.NewParserBuilder("ethereum", NewEthereumNativeParser).
 		SetRosettaParserFactory(NewEthereumRosettaParser).
@@ -20,12 +21,15 @@ var Module = fx.Options(
 		SetValidatorFactory(NewPolygonValidator).
 		Build(),
 	internal.NewParserBuilder("avacchain", NewAvacchainNativeParser).
+		SetRosettaParserFactory(NewAvacchainRosettaParser).
 		SetCheckerFactory(NewAvacchainChecker).
 		Build(),
 	internal.NewParserBuilder("arbitrum", NewArbitrumNativeParser).
+		SetRosettaParserFactory(NewArbitrumRosettaParser).
 		SetCheckerFactory(NewArbitrumChecker).
 		Build(),
 	internal.NewParserBuilder("optimism", NewOptimismNativeParser).
+		SetRosettaParserFactory(NewOptimismRosettaParser).
 		SetValidatorFactory(NewOptimismValidator).
 		Build(),
 	internal.NewParserBuilder("base", NewBaseNativeParser).
@@ -34,5 +38,14 @@ var Module = fx.Options(
 		SetValidatorFactory(NewBaseValidator).
 		Build(),
 	internal.NewParserBuilder("fantom", NewFantomNativeParser).
+		SetRosettaParserFactory(NewFantomRosettaParser).
+		Build(),
+	internal.NewParserBuilder("zksync", NewZksyncNativeParser).
+		Build(),
+	internal.NewParserBuilder("linea", NewLineaNativeParser).
+		Build(),
+	internal.NewParserBuilder("scroll", NewScrollNativeParser).
+		Build(),
+	internal.NewParserBuilder("blast", NewBlastNativeParser).
 		Build(),
 )
@@ -0,0 +1,10 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+func NewScrollNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	// Scroll shares the same data schema as Ethereum since its an EVM chain, except for the l1Fee in the receipts.
+	return NewEthereumNativeParser(params, opts...)
+}
@@ -0,0 +1,86 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type scrollParserTestSuite struct {
+	suite.Suite
+
+	controller *gomock.Controller
+	testapp    testapp.TestApp
+	parser     internal.Parser
+}
+
+func TestScrollParserTestSuite(t *testing.T) {
+	suite.Run(t, new(scrollParserTestSuite))
+}
+
+func (s *scrollParserTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+
+	var parser internal.Parser
+	s.testapp = testapp.New(
+		s.T(),
+		Module,
+		internal.Module,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_SCROLL, common.Network_NETWORK_SCROLL_MAINNET),
+		fx.Populate(&parser),
+	)
+
+	s.parser = parser
+	s.NotNil(s.parser)
+}
+
+func (s *scrollParserTestSuite) TearDownTest() {
+	s.testapp.Close()
+	s.controller.Finish()
+}
+
+func (s *scrollParserTestSuite) TestParseScrollBlock() {
+	require := testutil.Require(s.T())
+
+	// The receipt is NOT from Scroll mainnet: it is the Ethereum receipt fixture with the Scroll specific fields added by hand,
+	// so it only covers the parsing of those fields and should be replaced with a real eth_getTransactionReceipt response.
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_SCROLL,
+		Network:    common.Network_NETWORK_SCROLL_MAINNET,
+		Metadata:   ethereumMetadata,
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeader,
+				TransactionReceipts: [][]byte{fixtures.MustReadFile("parser/scroll/synthetic_block_receipt.json")},
+				TransactionTraces:   [][]byte{fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")},
+			},
+		},
+	}
+
+	nativeBlock, err := s.parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_SCROLL, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_SCROLL_MAINNET, nativeBlock.Network)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+	require.Equal(1, len(actual.Transactions))
+
+	receipt := actual.Transactions[0].Receipt
+	require.Equal("0xe67071db25331ea3a92a4e28b516c95f2d5b62b68329b70386c19e00807f51d8", receipt.TransactionHash)
+	// Scroll only reports the L1 fee.
+	require.Equal(&api.EthereumTransactionReceipt_L1FeeInfo{
+		L1Fee: 0x1c8f5b2a9e4d,
+	}, receipt.GetL1FeeInfo())
+	require.Nil(receipt.GetOptionalL1BatchNumber())
+}
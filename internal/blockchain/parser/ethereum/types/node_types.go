@@ -12,12 +12,24 @@ const (
 	EthereumNodeType_ARCHIVAL
 	EthereumNodeType_FULL
 	EthereumNodeType_ALCHEMY_POLYGON
+	// EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS is an archival node which supports eth_getBlockReceipts.
+	// A chain opts in only once every node provider in its endpoint groups is known to serve the method,
+	// since each fallback to eth_getTransactionReceipt on method-not-found costs a failed call.
+	// Only the Base nodes have been verified so far; the other chains, including Polygon, stay on
+	// EthereumNodeType_ARCHIVAL until their providers are.
+	EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS
 )
 
 func (t EthereumNodeType) TracesEnabled() bool {
-	return t == EthereumNodeType_ARCHIVAL || t == EthereumNodeType_ALCHEMY_POLYGON
+	return t == EthereumNodeType_ARCHIVAL || t == EthereumNodeType_ALCHEMY_POLYGON || t == EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS
 }
 
 func (t EthereumNodeType) ReceiptsEnabled() bool {
-	return t == EthereumNodeType_ARCHIVAL || t == EthereumNodeType_ALCHEMY_POLYGON
+	return t == EthereumNodeType_ARCHIVAL || t == EthereumNodeType_ALCHEMY_POLYGON || t == EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS
+}
+
+// BlockReceiptsEnabled returns true if the receipts of a block can be fetched in a single eth_getBlockReceipts call,
+// instead of one eth_getTransactionReceipt call per transaction.
+func (t EthereumNodeType) BlockReceiptsEnabled() bool {
+	return t == EthereumNodeType_ARCHIVAL_BLOCK_RECEIPTS
 }
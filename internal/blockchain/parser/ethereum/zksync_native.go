@@ -0,0 +1,10 @@
+package ethereum
+
+import (
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+func NewZksyncNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	// zkSync Era shares the same data schema as Ethereum since its an EVM-compatible chain, except for the L1 batch fields and l2ToL1Logs in the receipts.
+	return NewEthereumNativeParser(params, opts...)
+}
@@ -0,0 +1,100 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type zksyncParserTestSuite struct {
+	suite.Suite
+
+	controller *gomock.Controller
+	testapp    testapp.TestApp
+	parser     internal.Parser
+}
+
+func TestZksyncParserTestSuite(t *testing.T) {
+	suite.Run(t, new(zksyncParserTestSuite))
+}
+
+func (s *zksyncParserTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+
+	var parser internal.Parser
+	s.testapp = testapp.New(
+		s.T(),
+		Module,
+		internal.Module,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_ZKSYNC, common.Network_NETWORK_ZKSYNC_MAINNET),
+		fx.Populate(&parser),
+	)
+
+	s.parser = parser
+	s.NotNil(s.parser)
+}
+
+func (s *zksyncParserTestSuite) TearDownTest() {
+	s.testapp.Close()
+	s.controller.Finish()
+}
+
+func (s *zksyncParserTestSuite) TestParseZksyncBlock() {
+	require := testutil.Require(s.T())
+
+	// The receipt is NOT from zkSync mainnet: it is the Ethereum receipt fixture with the zkSync specific fields added by hand,
+	// so it only covers the parsing of those fields and should be replaced with a real eth_getTransactionReceipt response.
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ZKSYNC,
+		Network:    common.Network_NETWORK_ZKSYNC_MAINNET,
+		Metadata:   ethereumMetadata,
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header:              fixtureHeader,
+				TransactionReceipts: [][]byte{fixtures.MustReadFile("parser/zksync/synthetic_block_receipt.json")},
+				TransactionTraces:   [][]byte{fixtures.MustReadFile("parser/ethereum/raw_block_traces.json")},
+			},
+		},
+	}
+
+	nativeBlock, err := s.parser.ParseNativeBlock(context.Background(), block)
+	require.NoError(err)
+	require.Equal(common.Blockchain_BLOCKCHAIN_ZKSYNC, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_ZKSYNC_MAINNET, nativeBlock.Network)
+
+	actual := nativeBlock.GetEthereum()
+	require.NotNil(actual)
+	require.Equal(1, len(actual.Transactions))
+
+	receipt := actual.Transactions[0].Receipt
+	require.Equal("0xe67071db25331ea3a92a4e28b516c95f2d5b62b68329b70386c19e00807f51d8", receipt.TransactionHash)
+	require.Equal(uint64(0x6f3b1), receipt.GetL1BatchNumber())
+	require.Equal(uint64(0x2a), receipt.GetL1BatchTxIndex())
+	require.Equal([]*api.EthereumL2ToL1Log{
+		{
+			BlockHash:        ethereumHash,
+			BlockNumber:      ethereumHeight,
+			L1BatchNumber:    0x6f3b1,
+			TransactionHash:  "0xe67071db25331ea3a92a4e28b516c95f2d5b62b68329b70386c19e00807f51d8",
+			TransactionIndex: 0,
+			TxIndexInL1Batch: 0x2a,
+			LogIndex:         0,
+			ShardId:          0,
+			IsService:        true,
+			Sender:           "0x0000000000000000000000000000000000008008",
+			Key:              "0x000000000000000000000000000000000000000000000000000000000000800a",
+			Value:            "0x45c3e1cc5e4c7e5d3a2ad87d8b6f5d2ab5a0e8d49f3c1b7e4a6d2c9f0e1b3a5c",
+		},
+	}, receipt.L2ToL1Logs)
+	require.Nil(receipt.GetOptionalL1FeeInfo())
+}
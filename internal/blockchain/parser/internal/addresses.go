@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+const (
+	ethereumZeroAddress = "0x0000000000000000000000000000000000000000"
+)
+
+type (
+	// TransactionAddresses lists the addresses touched by a transaction.
+	TransactionAddresses struct {
+		TransactionHash string
+		Addresses       []string
+	}
+
+	addressSet struct {
+		seen      map[string]bool
+		addresses []string
+	}
+)
+
+// GetTransactionAddresses returns the addresses touched by each transaction in the native block:
+//   - EVM: the sender, the recipient, the created contract, the emitters of the event logs
+//     and the senders and recipients of the token transfers, e.g. ERC-20 and ERC-721 Transfer events,
+//     except for the zero address used by mints and burns.
+//   - Bitcoin: the addresses of the spent outputs and the new outputs.
+//   - Solana: the account keys referenced by the transaction message.
+func GetTransactionAddresses(block *api.NativeBlock) ([]*TransactionAddresses, error) {
+	if block.GetSkipped() {
+		return nil, nil
+	}
+
+	switch {
+	case block.GetEthereum() != nil:
+		return getEthereumTransactionAddresses(block.GetEthereum()), nil
+	case block.GetBitcoin() != nil:
+		return getBitcoinTransactionAddresses(block.GetBitcoin()), nil
+	case block.GetSolana() != nil:
+		return getSolanaTransactionAddresses(block.GetSolana()), nil
+	case block.GetSolanaV2() != nil:
+		return getSolanaV2TransactionAddresses(block.GetSolanaV2()), nil
+	default:
+		return nil, xerrors.Errorf("address extraction is not supported for block type %T: %w", block.GetBlock(), ErrNotImplemented)
+	}
+}
+
+// NormalizeAddress returns the canonical form of the address used by the address index.
+// Hex-encoded addresses, e.g. EVM addresses, are case-insensitive and hence lowercased;
+// other encodings, e.g. base58, are case-sensitive and returned as is.
+func NormalizeAddress(address string) string {
+	if Has0xPrefix(address) {
+		return strings.ToLower(address)
+	}
+
+	return address
+}
+
+func getEthereumTransactionAddresses(block *api.EthereumBlock) []*TransactionAddresses {
+	result := make([]*TransactionAddresses, 0, len(block.GetTransactions()))
+	for _, transaction := range block.GetTransactions() {
+		addresses := newAddressSet()
+		addresses.add(transaction.GetFrom())
+		addresses.add(transaction.GetTo())
+		receipt := transaction.GetReceipt()
+		addresses.add(receipt.GetContractAddress())
+		for _, log := range receipt.GetLogs() {
+			addresses.add(log.GetAddress())
+		}
+		for _, tokenTransfer := range transaction.GetTokenTransfers() {
+			for _, address := range []string{tokenTransfer.GetFromAddress(), tokenTransfer.GetToAddress()} {
+				// The zero address stands for mints and burns; indexing it would only create a hot partition.
+				if NormalizeAddress(address) != ethereumZeroAddress {
+					addresses.add(address)
+				}
+			}
+		}
+
+		result = append(result, &TransactionAddresses{
+			TransactionHash: transaction.GetHash(),
+			Addresses:       addresses.addresses,
+		})
+	}
+
+	return result
+}
+
+func getBitcoinTransactionAddresses(block *api.BitcoinBlock) []*TransactionAddresses {
+	result := make([]*TransactionAddresses, 0, len(block.GetTransactions()))
+	for _, transaction := range block.GetTransactions() {
+		addresses := newAddressSet()
+		for _, input := range transaction.GetInputs() {
+			addresses.add(input.GetFromOutput().GetScriptPublicKey().GetAddress())
+		}
+		for _, output := range transaction.GetOutputs() {
+			addresses.add(output.GetScriptPublicKey().GetAddress())
+		}
+
+		result = append(result, &TransactionAddresses{
+			TransactionHash: transaction.GetTransactionId(),
+			Addresses:       addresses.addresses,
+		})
+	}
+
+	return result
+}
+
+func getSolanaTransactionAddresses(block *api.SolanaBlock) []*TransactionAddresses {
+	result := make([]*TransactionAddresses, 0, len(block.GetTransactions()))
+	for _, transaction := range block.GetTransactions() {
+		addresses := newAddressSet()
+		for _, account := range transaction.GetPayload().GetMessage().GetAccounts() {
+			addresses.add(account.GetPublicKey())
+		}
+
+		result = append(result, &TransactionAddresses{
+			TransactionHash: transaction.GetTransactionId(),
+			Addresses:       addresses.addresses,
+		})
+	}
+
+	return result
+}
+
+func getSolanaV2TransactionAddresses(block *api.SolanaBlockV2) []*TransactionAddresses {
+	result := make([]*TransactionAddresses, 0, len(block.GetTransactions()))
+	for _, transaction := range block.GetTransactions() {
+		addresses := newAddressSet()
+		for _, accountKey := range transaction.GetPayload().GetMessage().GetAccountKeys() {
+			addresses.add(accountKey.GetPubkey())
+		}
+
+		result = append(result, &TransactionAddresses{
+			TransactionHash: transaction.GetTransactionId(),
+			Addresses:       addresses.addresses,
+		})
+	}
+
+	return result
+}
+
+func newAddressSet() *addressSet {
+	return &addressSet{
+		seen: make(map[string]bool),
+	}
+}
+
+// add appends the normalized address unless it is empty or has been added before.
+func (s *addressSet) add(address string) {
+	if address == "" {
+		return
+	}
+
+	address = NormalizeAddress(address)
+	if s.seen[address] {
+		return
+	}
+
+	s.seen[address] = true
+	s.addresses = append(s.addresses, address)
+}
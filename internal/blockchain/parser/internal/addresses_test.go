@@ -0,0 +1,208 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+func TestGetTransactionAddresses_Ethereum(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_Ethereum{
+			Ethereum: &api.EthereumBlock{
+				Transactions: []*api.EthereumTransaction{
+					{
+						Hash: "0xabc",
+						From: "0xA7EFAE728D2936E78BDA97DC267687568DD593F3",
+						To:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Receipt: &api.EthereumTransactionReceipt{
+							Logs: []*api.EthereumEventLog{
+								{Address: "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+								{Address: "0x3506424f91fd33084466f402d5d97f05f8e3b4af"},
+							},
+						},
+					},
+					{
+						Hash: "0xdef",
+						From: "0xa7efae728d2936e78bda97dc267687568dd593f3",
+						Receipt: &api.EthereumTransactionReceipt{
+							ContractAddress: "0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
+						},
+					},
+					{
+						Hash: "0x123",
+						From: "0xa7efae728d2936e78bda97dc267687568dd593f3",
+						To:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Receipt: &api.EthereumTransactionReceipt{
+							Logs: []*api.EthereumEventLog{
+								{Address: "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+							},
+						},
+						TokenTransfers: []*api.EthereumTokenTransfer{
+							{
+								TokenAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+								FromAddress:  "0xa7efae728d2936e78bda97dc267687568dd593f3",
+								ToAddress:    "0x3506424F91FD33084466F402D5D97F05F8E3B4AF",
+								TokenTransfer: &api.EthereumTokenTransfer_Erc20{
+									Erc20: &api.ERC20TokenTransfer{
+										FromAddress: "0xa7efae728d2936e78bda97dc267687568dd593f3",
+										ToAddress:   "0x3506424F91FD33084466F402D5D97F05F8E3B4AF",
+										Value:       "1000000",
+									},
+								},
+							},
+							{
+								TokenAddress: "0x06012c8cf97bead5deae237070f9587f8e7a266d",
+								FromAddress:  "0x0000000000000000000000000000000000000000",
+								ToAddress:    "0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
+								TokenTransfer: &api.EthereumTokenTransfer_Erc721{
+									Erc721: &api.ERC721TokenTransfer{
+										FromAddress: "0x0000000000000000000000000000000000000000",
+										ToAddress:   "0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
+										TokenId:     "1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := GetTransactionAddresses(block)
+	require.NoError(err)
+	require.Equal([]*TransactionAddresses{
+		{
+			TransactionHash: "0xabc",
+			Addresses: []string{
+				"0xa7efae728d2936e78bda97dc267687568dd593f3",
+				"0xdac17f958d2ee523a2206206994597c13d831ec7",
+				"0x3506424f91fd33084466f402d5d97f05f8e3b4af",
+			},
+		},
+		{
+			TransactionHash: "0xdef",
+			Addresses: []string{
+				"0xa7efae728d2936e78bda97dc267687568dd593f3",
+				"0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
+			},
+		},
+		{
+			TransactionHash: "0x123",
+			Addresses: []string{
+				"0xa7efae728d2936e78bda97dc267687568dd593f3",
+				"0xdac17f958d2ee523a2206206994597c13d831ec7",
+				"0x3506424f91fd33084466f402d5d97f05f8e3b4af",
+				"0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
+			},
+		},
+	}, actual)
+}
+
+func TestGetTransactionAddresses_Bitcoin(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_Bitcoin{
+			Bitcoin: &api.BitcoinBlock{
+				Transactions: []*api.BitcoinTransaction{
+					{
+						TransactionId: "coinbase",
+						Inputs: []*api.BitcoinTransactionInput{
+							{Coinbase: "03a3a10b"},
+						},
+						Outputs: []*api.BitcoinTransactionOutput{
+							{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "bc1qminer"}},
+							{ScriptPublicKey: &api.BitcoinScriptPublicKey{Type: "nulldata"}},
+						},
+					},
+					{
+						TransactionId: "transfer",
+						Inputs: []*api.BitcoinTransactionInput{
+							{FromOutput: &api.BitcoinTransactionOutput{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "1Sender"}}},
+						},
+						Outputs: []*api.BitcoinTransactionOutput{
+							{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "3Recipient"}},
+							{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "1Sender"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := GetTransactionAddresses(block)
+	require.NoError(err)
+	require.Equal([]*TransactionAddresses{
+		{TransactionHash: "coinbase", Addresses: []string{"bc1qminer"}},
+		{TransactionHash: "transfer", Addresses: []string{"1Sender", "3Recipient"}},
+	}, actual)
+}
+
+func TestGetTransactionAddresses_Solana(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_SolanaV2{
+			SolanaV2: &api.SolanaBlockV2{
+				Transactions: []*api.SolanaTransactionV2{
+					{
+						TransactionId: "signature",
+						Payload: &api.SolanaTransactionPayloadV2{
+							Message: &api.SolanaMessageV2{
+								AccountKeys: []*api.AccountKey{
+									{Pubkey: "GdnSyH3YtwcxFvQrVVJMm1JhTS4QVX7MFsX56uJLUfiZ"},
+									{Pubkey: "Vote111111111111111111111111111111111111111"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := GetTransactionAddresses(block)
+	require.NoError(err)
+	require.Equal([]*TransactionAddresses{
+		{
+			TransactionHash: "signature",
+			Addresses: []string{
+				"GdnSyH3YtwcxFvQrVVJMm1JhTS4QVX7MFsX56uJLUfiZ",
+				"Vote111111111111111111111111111111111111111",
+			},
+		},
+	}, actual)
+}
+
+func TestGetTransactionAddresses_Skipped(t *testing.T) {
+	require := testutil.Require(t)
+
+	actual, err := GetTransactionAddresses(&api.NativeBlock{Skipped: true})
+	require.NoError(err)
+	require.Empty(actual)
+}
+
+func TestGetTransactionAddresses_NotImplemented(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_Aptos{
+			Aptos: &api.AptosBlock{},
+		},
+	}
+
+	_, err := GetTransactionAddresses(block)
+	require.ErrorIs(err, ErrNotImplemented)
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	require := testutil.Require(t)
+
+	require.Equal("0xa7efae728d2936e78bda97dc267687568dd593f3", NormalizeAddress("0xA7EFAE728D2936E78BDA97DC267687568DD593F3"))
+	require.Equal("GdnSyH3YtwcxFvQrVVJMm1JhTS4QVX7MFsX56uJLUfiZ", NormalizeAddress("GdnSyH3YtwcxFvQrVVJMm1JhTS4QVX7MFsX56uJLUfiZ"))
+}
@@ -0,0 +1,283 @@
+package internal
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	transactionFilter struct {
+		contractAddresses map[string]bool
+		topics            []string
+		fromAddresses     map[string]bool
+		toAddresses       map[string]bool
+		programIds        map[string]bool
+		bitcoinAddresses  map[string]bool
+	}
+)
+
+// SupportsTransactionFiltering returns true if the native blocks of the configured chain can be filtered by FilterTransactions,
+// i.e. the chain is parsed into EVM, Bitcoin or Solana blocks.
+func SupportsTransactionFiltering(cfg *config.Config) bool {
+	if cfg.Chain.Sidechain != api.SideChain_SIDECHAIN_NONE {
+		return false
+	}
+
+	switch cfg.Chain.Blockchain {
+	case common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		common.Blockchain_BLOCKCHAIN_POLYGON,
+		common.Blockchain_BLOCKCHAIN_BSC,
+		common.Blockchain_BLOCKCHAIN_AVACCHAIN,
+		common.Blockchain_BLOCKCHAIN_ARBITRUM,
+		common.Blockchain_BLOCKCHAIN_OPTIMISM,
+		common.Blockchain_BLOCKCHAIN_BASE,
+		common.Blockchain_BLOCKCHAIN_FANTOM,
+		common.Blockchain_BLOCKCHAIN_ZKSYNC,
+		common.Blockchain_BLOCKCHAIN_LINEA,
+		common.Blockchain_BLOCKCHAIN_SCROLL,
+		common.Blockchain_BLOCKCHAIN_BLAST,
+		common.Blockchain_BLOCKCHAIN_BITCOIN,
+		common.Blockchain_BLOCKCHAIN_LITECOIN,
+		common.Blockchain_BLOCKCHAIN_SOLANA:
+		return true
+	case common.Blockchain_BLOCKCHAIN_DOGECOIN:
+		// The networks still ingested through Rosetta are parsed into Rosetta blocks.
+		return !cfg.IsRosetta()
+	default:
+		return false
+	}
+}
+
+// FilterTransactions returns the transactions in the native block matching any of the filters.
+// See api.TransactionFilter for the matching rules.
+func FilterTransactions(block *api.NativeBlock, filters []*api.TransactionFilter) ([]*api.NativeTransaction, error) {
+	if block.GetSkipped() {
+		return nil, nil
+	}
+
+	compiledFilters := make([]*transactionFilter, len(filters))
+	for i, filter := range filters {
+		compiledFilters[i] = newTransactionFilter(filter)
+	}
+
+	var result []*api.NativeTransaction
+	switch {
+	case block.GetEthereum() != nil:
+		for _, transaction := range block.GetEthereum().GetTransactions() {
+			if matchAny(compiledFilters, func(f *transactionFilter) bool { return f.matchEthereum(transaction) }) {
+				nativeTransaction := newNativeTransaction(block, transaction.GetHash())
+				nativeTransaction.Transaction = &api.NativeTransaction_Ethereum{
+					Ethereum: transaction,
+				}
+				result = append(result, nativeTransaction)
+			}
+		}
+	case block.GetBitcoin() != nil:
+		for _, transaction := range block.GetBitcoin().GetTransactions() {
+			if matchAny(compiledFilters, func(f *transactionFilter) bool { return f.matchBitcoin(transaction) }) {
+				nativeTransaction := newNativeTransaction(block, transaction.GetTransactionId())
+				nativeTransaction.Transaction = &api.NativeTransaction_Bitcoin{
+					Bitcoin: transaction,
+				}
+				result = append(result, nativeTransaction)
+			}
+		}
+	case block.GetSolana() != nil:
+		for _, transaction := range block.GetSolana().GetTransactions() {
+			programIds := getSolanaProgramIds(transaction)
+			if matchAny(compiledFilters, func(f *transactionFilter) bool { return f.matchSolana(programIds) }) {
+				nativeTransaction := newNativeTransaction(block, transaction.GetTransactionId())
+				nativeTransaction.Transaction = &api.NativeTransaction_Solana{
+					Solana: transaction,
+				}
+				result = append(result, nativeTransaction)
+			}
+		}
+	case block.GetSolanaV2() != nil:
+		for _, transaction := range block.GetSolanaV2().GetTransactions() {
+			programIds := getSolanaV2ProgramIds(transaction)
+			if matchAny(compiledFilters, func(f *transactionFilter) bool { return f.matchSolana(programIds) }) {
+				nativeTransaction := newNativeTransaction(block, transaction.GetTransactionId())
+				nativeTransaction.Transaction = &api.NativeTransaction_SolanaV2{
+					SolanaV2: transaction,
+				}
+				result = append(result, nativeTransaction)
+			}
+		}
+	default:
+		return nil, xerrors.Errorf("transaction filtering is not supported for block type %T: %w", block.GetBlock(), ErrNotImplemented)
+	}
+
+	return result, nil
+}
+
+func newTransactionFilter(filter *api.TransactionFilter) *transactionFilter {
+	topics := make([]string, len(filter.GetTopics()))
+	for i, topic := range filter.GetTopics() {
+		topics[i] = NormalizeAddress(topic)
+	}
+
+	return &transactionFilter{
+		contractAddresses: newNormalizedSet(filter.GetContractAddresses()),
+		topics:            topics,
+		fromAddresses:     newNormalizedSet(filter.GetFromAddresses()),
+		toAddresses:       newNormalizedSet(filter.GetToAddresses()),
+		programIds:        newNormalizedSet(filter.GetProgramIds()),
+		bitcoinAddresses:  newNormalizedSet(filter.GetBitcoinAddresses()),
+	}
+}
+
+func (f *transactionFilter) hasEthereumCriteria() bool {
+	return len(f.contractAddresses) > 0 || len(f.topics) > 0 || len(f.fromAddresses) > 0 || len(f.toAddresses) > 0
+}
+
+func (f *transactionFilter) matchEthereum(transaction *api.EthereumTransaction) bool {
+	if len(f.programIds) > 0 || len(f.bitcoinAddresses) > 0 {
+		return false
+	}
+
+	if len(f.fromAddresses) > 0 && !f.fromAddresses[NormalizeAddress(transaction.GetFrom())] {
+		return false
+	}
+
+	if len(f.toAddresses) > 0 && !f.toAddresses[NormalizeAddress(transaction.GetTo())] {
+		return false
+	}
+
+	if len(f.contractAddresses) == 0 && len(f.topics) == 0 {
+		return true
+	}
+
+	for _, log := range transaction.GetReceipt().GetLogs() {
+		if f.matchEthereumLog(log) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *transactionFilter) matchEthereumLog(log *api.EthereumEventLog) bool {
+	if len(f.contractAddresses) > 0 && !f.contractAddresses[NormalizeAddress(log.GetAddress())] {
+		return false
+	}
+
+	topics := log.GetTopics()
+	for i, topic := range f.topics {
+		if topic == "" {
+			continue
+		}
+
+		if i >= len(topics) || NormalizeAddress(topics[i]) != topic {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *transactionFilter) matchBitcoin(transaction *api.BitcoinTransaction) bool {
+	if f.hasEthereumCriteria() || len(f.programIds) > 0 {
+		return false
+	}
+
+	if len(f.bitcoinAddresses) == 0 {
+		return true
+	}
+
+	for _, input := range transaction.GetInputs() {
+		if address := input.GetFromOutput().GetScriptPublicKey().GetAddress(); f.bitcoinAddresses[address] {
+			return true
+		}
+	}
+
+	for _, output := range transaction.GetOutputs() {
+		if address := output.GetScriptPublicKey().GetAddress(); f.bitcoinAddresses[address] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *transactionFilter) matchSolana(programIds []string) bool {
+	if f.hasEthereumCriteria() || len(f.bitcoinAddresses) > 0 {
+		return false
+	}
+
+	if len(f.programIds) == 0 {
+		return true
+	}
+
+	for _, programId := range programIds {
+		if f.programIds[programId] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getSolanaProgramIds(transaction *api.SolanaTransaction) []string {
+	var programIds []string
+	for _, instruction := range transaction.GetPayload().GetMessage().GetInstructions() {
+		programIds = append(programIds, instruction.GetProgramId())
+	}
+
+	for _, innerInstruction := range transaction.GetMeta().GetInnerInstructions() {
+		for _, instruction := range innerInstruction.GetInstructions() {
+			programIds = append(programIds, instruction.GetProgramId())
+		}
+	}
+
+	return programIds
+}
+
+func getSolanaV2ProgramIds(transaction *api.SolanaTransactionV2) []string {
+	var programIds []string
+	for _, instruction := range transaction.GetPayload().GetMessage().GetInstructions() {
+		programIds = append(programIds, instruction.GetProgramId())
+	}
+
+	for _, innerInstruction := range transaction.GetMeta().GetInnerInstructions() {
+		for _, instruction := range innerInstruction.GetInstructions() {
+			programIds = append(programIds, instruction.GetProgramId())
+		}
+	}
+
+	return programIds
+}
+
+func newNativeTransaction(block *api.NativeBlock, transactionHash string) *api.NativeTransaction {
+	return &api.NativeTransaction{
+		Blockchain:      block.GetBlockchain(),
+		Network:         block.GetNetwork(),
+		Tag:             block.GetTag(),
+		TransactionHash: transactionHash,
+		BlockHeight:     block.GetHeight(),
+		BlockHash:       block.GetHash(),
+		BlockTimestamp:  block.GetTimestamp(),
+	}
+}
+
+func newNormalizedSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[NormalizeAddress(value)] = true
+	}
+
+	return set
+}
+
+func matchAny(filters []*transactionFilter, match func(f *transactionFilter) bool) bool {
+	for _, filter := range filters {
+		if match(filter) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,267 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+const (
+	testTransferTopic  = "0xddf252ad1be2c89b69c2b068fc378daa3b952ba7f163c4a11628f55a4df523b3"
+	testRecipientTopic = "0x000000000000000000000000a7efae728d2936e78bda97dc267687568dd593f3"
+)
+
+func TestFilterTransactions_Ethereum(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Height: 100,
+		Hash:   "0x100",
+		Block: &api.NativeBlock_Ethereum{
+			Ethereum: &api.EthereumBlock{
+				Transactions: []*api.EthereumTransaction{
+					{
+						Hash: "0xtransfer",
+						From: "0x3506424f91fd33084466f402d5d97f05f8e3b4af",
+						To:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Receipt: &api.EthereumTransactionReceipt{
+							Logs: []*api.EthereumEventLog{
+								{
+									Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+									Topics: []string{
+										testTransferTopic,
+										"0x0000000000000000000000003506424f91fd33084466f402d5d97f05f8e3b4af",
+										testRecipientTopic,
+									},
+								},
+							},
+						},
+					},
+					{
+						Hash: "0xnative",
+						From: "0xa7efae728d2936e78bda97dc267687568dd593f3",
+						To:   "0x3506424f91fd33084466f402d5d97f05f8e3b4af",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filters  []*api.TransactionFilter
+		expected []string
+	}{
+		{
+			name:     "contractAddress",
+			filters:  []*api.TransactionFilter{{ContractAddresses: []string{"0xdAC17F958D2ee523a2206206994597C13D831ec7"}}},
+			expected: []string{"0xtransfer"},
+		},
+		{
+			name:     "topics",
+			filters:  []*api.TransactionFilter{{Topics: []string{testTransferTopic, "", testRecipientTopic}}},
+			expected: []string{"0xtransfer"},
+		},
+		{
+			name:    "topicsMismatch",
+			filters: []*api.TransactionFilter{{Topics: []string{testTransferTopic, testRecipientTopic}}},
+		},
+		{
+			name: "contractAddressAndTopics",
+			filters: []*api.TransactionFilter{{
+				ContractAddresses: []string{"0x5d3a536e4d6dbd6114cc1ead35777bab948e3643"},
+				Topics:            []string{testTransferTopic},
+			}},
+		},
+		{
+			name:     "fromAddress",
+			filters:  []*api.TransactionFilter{{FromAddresses: []string{"0xA7EFAE728D2936E78BDA97DC267687568DD593F3"}}},
+			expected: []string{"0xnative"},
+		},
+		{
+			name:     "toAddress",
+			filters:  []*api.TransactionFilter{{ToAddresses: []string{"0x3506424f91fd33084466f402d5d97f05f8e3b4af"}}},
+			expected: []string{"0xnative"},
+		},
+		{
+			name: "anyFilter",
+			filters: []*api.TransactionFilter{
+				{ToAddresses: []string{"0x3506424f91fd33084466f402d5d97f05f8e3b4af"}},
+				{Topics: []string{testTransferTopic}},
+			},
+			expected: []string{"0xtransfer", "0xnative"},
+		},
+		{
+			name:    "otherChain",
+			filters: []*api.TransactionFilter{{ProgramIds: []string{"Vote111111111111111111111111111111111111111"}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			actual, err := FilterTransactions(block, test.filters)
+			require.NoError(err)
+			require.Len(actual, len(test.expected))
+			for i, transaction := range actual {
+				require.Equal(test.expected[i], transaction.TransactionHash)
+				require.Equal(test.expected[i], transaction.GetEthereum().GetHash())
+				require.Equal(uint64(100), transaction.BlockHeight)
+				require.Equal("0x100", transaction.BlockHash)
+			}
+		})
+	}
+
+	actual, err := FilterTransactions(block, nil)
+	require.NoError(err)
+	require.Empty(actual)
+}
+
+func TestFilterTransactions_Bitcoin(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_Bitcoin{
+			Bitcoin: &api.BitcoinBlock{
+				Transactions: []*api.BitcoinTransaction{
+					{
+						TransactionId: "coinbase",
+						Outputs: []*api.BitcoinTransactionOutput{
+							{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "bc1qminer"}},
+						},
+					},
+					{
+						TransactionId: "transfer",
+						Inputs: []*api.BitcoinTransactionInput{
+							{FromOutput: &api.BitcoinTransactionOutput{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "1Sender"}}},
+						},
+						Outputs: []*api.BitcoinTransactionOutput{
+							{ScriptPublicKey: &api.BitcoinScriptPublicKey{Address: "3Recipient"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := FilterTransactions(block, []*api.TransactionFilter{{BitcoinAddresses: []string{"1Sender"}}})
+	require.NoError(err)
+	require.Len(actual, 1)
+	require.Equal("transfer", actual[0].TransactionHash)
+	require.Equal("transfer", actual[0].GetBitcoin().GetTransactionId())
+
+	actual, err = FilterTransactions(block, []*api.TransactionFilter{{BitcoinAddresses: []string{"bc1qminer", "3Recipient"}}})
+	require.NoError(err)
+	require.Len(actual, 2)
+
+	actual, err = FilterTransactions(block, []*api.TransactionFilter{{
+		BitcoinAddresses: []string{"1Sender"},
+		FromAddresses:    []string{"1Sender"},
+	}})
+	require.NoError(err)
+	require.Empty(actual)
+}
+
+func TestFilterTransactions_Solana(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_SolanaV2{
+			SolanaV2: &api.SolanaBlockV2{
+				Transactions: []*api.SolanaTransactionV2{
+					{
+						TransactionId: "vote",
+						Payload: &api.SolanaTransactionPayloadV2{
+							Message: &api.SolanaMessageV2{
+								Instructions: []*api.SolanaInstructionV2{
+									{ProgramId: "Vote111111111111111111111111111111111111111"},
+								},
+							},
+						},
+					},
+					{
+						TransactionId: "swap",
+						Payload: &api.SolanaTransactionPayloadV2{
+							Message: &api.SolanaMessageV2{
+								Instructions: []*api.SolanaInstructionV2{
+									{ProgramId: "JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4"},
+								},
+							},
+						},
+						Meta: &api.SolanaTransactionMetaV2{
+							InnerInstructions: []*api.SolanaInnerInstructionV2{
+								{
+									Instructions: []*api.SolanaInstructionV2{
+										{ProgramId: "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := FilterTransactions(block, []*api.TransactionFilter{{ProgramIds: []string{"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"}}})
+	require.NoError(err)
+	require.Len(actual, 1)
+	require.Equal("swap", actual[0].TransactionHash)
+	require.Equal("swap", actual[0].GetSolanaV2().GetTransactionId())
+}
+
+func TestFilterTransactions_Skipped(t *testing.T) {
+	require := testutil.Require(t)
+
+	actual, err := FilterTransactions(&api.NativeBlock{Skipped: true}, []*api.TransactionFilter{{ProgramIds: []string{"foo"}}})
+	require.NoError(err)
+	require.Empty(actual)
+}
+
+func TestFilterTransactions_NotImplemented(t *testing.T) {
+	require := testutil.Require(t)
+
+	block := &api.NativeBlock{
+		Block: &api.NativeBlock_Aptos{
+			Aptos: &api.AptosBlock{},
+		},
+	}
+
+	_, err := FilterTransactions(block, []*api.TransactionFilter{{ContractAddresses: []string{"0x1"}}})
+	require.ErrorIs(err, ErrNotImplemented)
+}
+
+func TestSupportsTransactionFiltering(t *testing.T) {
+	tests := []struct {
+		blockchain common.Blockchain
+		network    common.Network
+		sidechain  api.SideChain
+		expected   bool
+	}{
+		{common.Blockchain_BLOCKCHAIN_ETHEREUM, common.Network_NETWORK_ETHEREUM_MAINNET, api.SideChain_SIDECHAIN_NONE, true},
+		{common.Blockchain_BLOCKCHAIN_ETHEREUM, common.Network_NETWORK_ETHEREUM_MAINNET, api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON, false},
+		{common.Blockchain_BLOCKCHAIN_ZKSYNC, common.Network_NETWORK_ZKSYNC_MAINNET, api.SideChain_SIDECHAIN_NONE, true},
+		{common.Blockchain_BLOCKCHAIN_BITCOIN, common.Network_NETWORK_BITCOIN_MAINNET, api.SideChain_SIDECHAIN_NONE, true},
+		{common.Blockchain_BLOCKCHAIN_DOGECOIN, common.Network_NETWORK_DOGECOIN_MAINNET, api.SideChain_SIDECHAIN_NONE, false},
+		{common.Blockchain_BLOCKCHAIN_DOGECOIN, common.Network_NETWORK_DOGECOIN_TESTNET, api.SideChain_SIDECHAIN_NONE, true},
+		{common.Blockchain_BLOCKCHAIN_SOLANA, common.Network_NETWORK_SOLANA_MAINNET, api.SideChain_SIDECHAIN_NONE, true},
+		{common.Blockchain_BLOCKCHAIN_APTOS, common.Network_NETWORK_APTOS_MAINNET, api.SideChain_SIDECHAIN_NONE, false},
+		{common.Blockchain_BLOCKCHAIN_SUI, common.Network_NETWORK_SUI_MAINNET, api.SideChain_SIDECHAIN_NONE, false},
+	}
+	for _, test := range tests {
+		t.Run(test.blockchain.String()+"/"+test.sidechain.String(), func(t *testing.T) {
+			require := testutil.Require(t)
+
+			cfg, err := config.New(
+				config.WithBlockchain(test.blockchain),
+				config.WithNetwork(test.network),
+				config.WithSidechain(test.sidechain),
+			)
+			require.NoError(err)
+			require.Equal(test.expected, SupportsTransactionFiltering(cfg))
+		})
+	}
+}
@@ -20,6 +20,7 @@ type (
 		instrumentParseRosettaBlock    instrument.InstrumentWithResult[*api.RosettaBlock]
 		instrumentCompareNativeBlocks  instrument.Instrument
 		instrumentValidateBlock        instrument.Instrument
+		instrumentValidateRawBlock     instrument.Instrument
 		instrumentValidateAccountState instrument.InstrumentWithResult[*api.ValidateAccountStateResponse]
 		instrumentValidateRosettaBlock instrument.Instrument
 	}
@@ -35,6 +36,7 @@ func WithInstrumentInterceptor(parser Parser, scope tally.Scope, logger *zap.Log
 		instrumentParseRosettaBlock:    newInstrumentWithResult[*api.RosettaBlock]("parse_rosetta_block", scope, logger),
 		instrumentCompareNativeBlocks:  newInstrument("compare_native_blocks", scope, logger),
 		instrumentValidateBlock:        newInstrument("validate_block", scope, logger),
+		instrumentValidateRawBlock:     newInstrument("validate_raw_block", scope, logger),
 		instrumentValidateAccountState: newInstrumentWithResult[*api.ValidateAccountStateResponse]("validate_account_state", scope, logger),
 		instrumentValidateRosettaBlock: newInstrument("validate_rosetta_block", scope, logger),
 	}
@@ -125,6 +127,19 @@ func (i *instrumentInterceptor) ValidateBlock(ctx context.Context, nativeBlock *
 	)
 }
 
+func (i *instrumentInterceptor) ValidateRawBlock(ctx context.Context, rawBlock *api.Block, nativeBlock *api.NativeBlock) error {
+	return i.instrumentValidateRawBlock.Instrument(
+		ctx,
+		func(ctx context.Context) error {
+			return i.parser.ValidateRawBlock(ctx, rawBlock, nativeBlock)
+		},
+		instrument.WithLoggerFields(
+			zap.Uint64("blockHeight", nativeBlock.Height),
+			zap.String("blockHash", nativeBlock.Hash),
+		),
+	)
+}
+
 func (i *instrumentInterceptor) ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error) {
 	return i.instrumentValidateAccountState.Instrument(
 		ctx,
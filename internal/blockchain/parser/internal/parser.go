@@ -20,6 +20,9 @@ type (
 		CompareNativeBlocks(ctx context.Context, height uint64, expectedBlock, actualBlock *api.NativeBlock) error
 		// ValidateBlock Given a native block, validates whether the block data is cryptographically correct.
 		ValidateBlock(ctx context.Context, nativeBlock *api.NativeBlock) error
+		// ValidateRawBlock Same as ValidateBlock, but also provides the raw block which the native block is parsed from,
+		// for the validators that need the data only available in the blobdata, e.g. the solana transactions in the wire format.
+		ValidateRawBlock(ctx context.Context, rawBlock *api.Block, nativeBlock *api.NativeBlock) error
 		// ValidateAccountState Given an account's state verification request and the target block, verifies that the account state is valid. If successful, return the stored account state. Otherwise, return error.
 		ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error)
 		// ValidateRosettaBlock Given other block source (native, etc), validates whether transaction operations show the correct balance transfer.
@@ -42,13 +45,21 @@ type (
 		ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error)
 	}
 
+	// RawBlockValidator is implemented by the validators which verify the native block against its raw block.
+	// The other validators only get the native block in ValidateRawBlock.
+	RawBlockValidator interface {
+		ValidateRawBlock(ctx context.Context, rawBlock *api.Block, nativeBlock *api.NativeBlock) error
+	}
+
 	Params struct {
 		fx.In
 		fxparams.Params
 		Aleo           ParserFactory `name:"aleo" optional:"true"`
 		Bitcoin        ParserFactory `name:"bitcoin" optional:"true"`
 		Bsc            ParserFactory `name:"bsc" optional:"true"`
+		Dogecoin       ParserFactory `name:"dogecoin" optional:"true"`
 		Ethereum       ParserFactory `name:"ethereum" optional:"true"`
+		Litecoin       ParserFactory `name:"litecoin" optional:"true"`
 		Rosetta        ParserFactory `name:"rosetta" optional:"true"`
 		Solana         ParserFactory `name:"solana" optional:"true"`
 		Polygon        ParserFactory `name:"polygon" optional:"true"`
@@ -59,6 +70,11 @@ type (
 		Base           ParserFactory `name:"base" optional:"true"`
 		Aptos          ParserFactory `name:"aptos" optional:"true"`
 		EthereumBeacon ParserFactory `name:"ethereum/beacon" optional:"true"`
+		Zksync         ParserFactory `name:"zksync" optional:"true"`
+		Linea          ParserFactory `name:"linea" optional:"true"`
+		Scroll         ParserFactory `name:"scroll" optional:"true"`
+		Blast          ParserFactory `name:"blast" optional:"true"`
+		Sui            ParserFactory `name:"sui" optional:"true"`
 		CosmosStaking  ParserFactory `name:"cosmos/staking" optional:"true"`
 		CardanoStaking ParserFactory `name:"cardano/staking" optional:"true"`
 	}
@@ -84,6 +100,15 @@ func NewParser(params Params) (Parser, error) {
 		switch blockchain {
 		case common.Blockchain_BLOCKCHAIN_BITCOIN:
 			factory = params.Bitcoin
+		case common.Blockchain_BLOCKCHAIN_DOGECOIN:
+			// Dogecoin used to be ingested through Rosetta; keep using it for the networks configured with Rosetta.
+			if params.Config.IsRosetta() {
+				factory = params.Rosetta
+			} else {
+				factory = params.Dogecoin
+			}
+		case common.Blockchain_BLOCKCHAIN_LITECOIN:
+			factory = params.Litecoin
 		case common.Blockchain_BLOCKCHAIN_BSC:
 			factory = params.Bsc
 		case common.Blockchain_BLOCKCHAIN_ETHEREUM:
@@ -104,11 +129,30 @@ func NewParser(params Params) (Parser, error) {
 			factory = params.Fantom
 		case common.Blockchain_BLOCKCHAIN_APTOS:
 			factory = params.Aptos
+		case common.Blockchain_BLOCKCHAIN_ZKSYNC:
+			factory = params.Zksync
+		case common.Blockchain_BLOCKCHAIN_LINEA:
+			factory = params.Linea
+		case common.Blockchain_BLOCKCHAIN_SCROLL:
+			factory = params.Scroll
+		case common.Blockchain_BLOCKCHAIN_BLAST:
+			factory = params.Blast
+		case common.Blockchain_BLOCKCHAIN_SUI:
+			factory = params.Sui
+		case common.Blockchain_BLOCKCHAIN_COSMOS:
+			factory = params.CosmosStaking
+		case common.Blockchain_BLOCKCHAIN_CARDANO:
+			factory = params.CardanoStaking
 		default:
 			if params.Config.IsRosetta() {
 				factory = params.Rosetta
 			}
 		}
+	} else {
+		switch sidechain {
+		case api.SideChain_SIDECHAIN_ETHEREUM_MAINNET_BEACON, api.SideChain_SIDECHAIN_ETHEREUM_HOLESKY_BEACON:
+			factory = params.EthereumBeacon
+		}
 	}
 
 	if factory == nil {
@@ -146,6 +190,14 @@ func (p *parserImpl) ValidateBlock(ctx context.Context, nativeBlock *api.NativeB
 	return p.validator.ValidateBlock(ctx, nativeBlock)
 }
 
+func (p *parserImpl) ValidateRawBlock(ctx context.Context, rawBlock *api.Block, nativeBlock *api.NativeBlock) error {
+	if validator, ok := p.validator.(RawBlockValidator); ok {
+		return validator.ValidateRawBlock(ctx, rawBlock, nativeBlock)
+	}
+
+	return p.validator.ValidateBlock(ctx, nativeBlock)
+}
+
 func (p *parserImpl) ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error) {
 	return p.validator.ValidateAccountState(ctx, req)
 }
@@ -47,6 +47,10 @@ func (p nopParser) ValidateBlock(ctx context.Context, nativeBlock *api.NativeBlo
 	return nil
 }
 
+func (p nopParser) ValidateRawBlock(ctx context.Context, rawBlock *api.Block, nativeBlock *api.NativeBlock) error {
+	return nil
+}
+
 func (p nopParser) ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error) {
 	return nil, nil
 }
@@ -128,6 +128,20 @@ func (mr *MockParserMockRecorder) ValidateBlock(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBlock", reflect.TypeOf((*MockParser)(nil).ValidateBlock), arg0, arg1)
 }
 
+// ValidateRawBlock mocks base method.
+func (m *MockParser) ValidateRawBlock(arg0 context.Context, arg1 *chainstorage.Block, arg2 *chainstorage.NativeBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRawBlock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRawBlock indicates an expected call of ValidateRawBlock.
+func (mr *MockParserMockRecorder) ValidateRawBlock(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRawBlock", reflect.TypeOf((*MockParser)(nil).ValidateRawBlock), arg0, arg1, arg2)
+}
+
 // ValidateRosettaBlock mocks base method.
 func (m *MockParser) ValidateRosettaBlock(arg0 context.Context, arg1 *chainstorage.ValidateRosettaBlockRequest, arg2 *chainstorage.RosettaBlock) error {
 	m.ctrl.T.Helper()
@@ -7,16 +7,23 @@ import (
 
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/aptos"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/bitcoin"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/cardano"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/cosmos"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/ethereum"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/ethereum/beacon"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/rosetta"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser/solana"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/sui"
+	"github.com/coinbase/chainstorage/internal/config"
 )
 
 type (
 	Parser = internal.Parser
 
 	ParityCheckFailedError = internal.ParityCheckFailedError
+
+	TransactionAddresses = internal.TransactionAddresses
 )
 
 var (
@@ -30,15 +37,35 @@ var Module = fx.Options(
 	internal.Module,
 	aptos.Module,
 	bitcoin.Module,
+	cardano.Module,
+	cosmos.Module,
 	ethereum.Module,
+	beacon.Module,
 	rosetta.Module,
 	solana.Module,
+	sui.Module,
 )
 
 func ValidateChain(blocks []*api.BlockMetadata, lastBlock *api.BlockMetadata) error {
 	return internal.ValidateChain(blocks, lastBlock)
 }
 
+func GetTransactionAddresses(block *api.NativeBlock) ([]*TransactionAddresses, error) {
+	return internal.GetTransactionAddresses(block)
+}
+
+func SupportsTransactionFiltering(cfg *config.Config) bool {
+	return internal.SupportsTransactionFiltering(cfg)
+}
+
+func FilterTransactions(block *api.NativeBlock, filters []*api.TransactionFilter) ([]*api.NativeTransaction, error) {
+	return internal.FilterTransactions(block, filters)
+}
+
+func NormalizeAddress(address string) string {
+	return internal.NormalizeAddress(address)
+}
+
 func NewNop() Parser {
 	return internal.NewNop()
 }
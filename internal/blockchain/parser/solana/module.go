@@ -10,5 +10,6 @@ var Module = fx.Options(
 	internal.NewParserBuilder("solana", NewSolanaNativeParser).
 		SetRosettaParserFactory(NewSolanaRosettaParser).
 		SetCheckerFactory(NewSolanaChecker).
+		SetValidatorFactory(NewSolanaValidator).
 		Build(),
 )
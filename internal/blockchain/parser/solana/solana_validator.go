@@ -0,0 +1,663 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	solanaValidator struct {
+		config  *config.Config
+		logger  *zap.Logger
+		metrics *solanaValidatorMetrics
+	}
+
+	solanaValidatorMetrics struct {
+		lookupTableCounter tally.Counter
+	}
+
+	// solanaMessageEncoder serializes a transaction message in the wire format, which is the payload signed by the signers.
+	// https://solana.com/docs/core/transactions#message
+	solanaMessageEncoder struct {
+		buf []byte
+	}
+
+	// solanaMessageDecoder deserializes a transaction in the wire format.
+	solanaMessageDecoder struct {
+		buf    []byte
+		offset int
+	}
+
+	// solanaRawTransaction is a transaction decoded from the wire format.
+	solanaRawTransaction struct {
+		signatures   []string
+		message      []byte
+		instructions []*solanaRawInstruction
+	}
+
+	solanaRawInstruction struct {
+		programIdIndex uint64
+		accounts       []uint64
+		data           []byte
+	}
+)
+
+const (
+	solanaHashSize      = 32
+	solanaPublicKeySize = ed25519.PublicKeySize
+	solanaSignatureSize = ed25519.SignatureSize
+
+	// The first byte of a versioned message has the highest bit set, and the lower 7 bits store the version.
+	// https://docs.solanalabs.com/proposals/versioned-transactions
+	solanaVersionPrefix = byte(0x80)
+
+	solanaAccountSourceTransaction = "transaction"
+	solanaAccountSourceLookupTable = "lookupTable"
+
+	validatorMetricsReasonKey           = "reason"
+	signatureVerificationSkipped        = "signature_verification_skipped"
+	skippedReasonUnsupportedLookupTable = "lookup_table"
+)
+
+var (
+	_ internal.TrustlessValidator = (*solanaValidator)(nil)
+	_ internal.RawBlockValidator  = (*solanaValidator)(nil)
+
+	ErrInvalidBlockHash            = xerrors.New("invalid block hash")
+	ErrInvalidParentBlock          = xerrors.New("invalid parent block")
+	ErrInvalidTransactionSignature = xerrors.New("invalid transaction signature")
+	ErrRawTransactionsMissing      = xerrors.New("raw transactions are missing")
+)
+
+func NewSolanaValidator(params internal.ParserParams) internal.TrustlessValidator {
+	return &solanaValidator{
+		config:  params.Config,
+		logger:  log.WithPackage(params.Logger),
+		metrics: newSolanaValidatorMetrics(params.Metrics),
+	}
+}
+
+func newSolanaValidatorMetrics(scope tally.Scope) *solanaValidatorMetrics {
+	scope = scope.SubScope("solana_validator")
+	return &solanaValidatorMetrics{
+		lookupTableCounter: newSolanaValidatorCounter(scope, skippedReasonUnsupportedLookupTable),
+	}
+}
+
+func newSolanaValidatorCounter(scope tally.Scope, reason string) tally.Counter {
+	return scope.Tagged(map[string]string{validatorMetricsReasonKey: reason}).Counter(signatureVerificationSkipped)
+}
+
+// ValidateBlock verifies a solana block with cryptographic algorithm.
+// It performs verification for two main structures in a block
+// 1. block header
+// 2. transaction signatures
+//
+// For 1, we check that the block hash, the previous block hash and the parent slot are consistent with the block metadata.
+// For 2, we check that every transaction is signed by the signer accounts required by its message.
+// We also serialize the message and verify the ed25519 signatures against it. In the jsonParsed encoding, the node does
+// not return the raw data of the parsed instructions, so their data is taken from the raw transactions stored in the
+// blobdata, and the serialized message must match the raw one. Since ValidateBlock does not have the blobdata,
+// ErrRawTransactionsMissing is returned for the transactions with parsed instructions; use ValidateRawBlock instead.
+func (v *solanaValidator) ValidateBlock(ctx context.Context, block *api.NativeBlock) error {
+	return v.validateBlock(block, nil)
+}
+
+// ValidateRawBlock is the same as ValidateBlock, but takes the raw transactions from the blobdata of the raw block.
+// The raw transactions are only fetched if the raw_transactions_enabled feature is enabled,
+// so ErrRawTransactionsMissing is returned for the blocks ingested without them.
+func (v *solanaValidator) ValidateRawBlock(ctx context.Context, rawBlock *api.Block, block *api.NativeBlock) error {
+	return v.validateBlock(block, rawBlock.GetSolana().GetTransactions())
+}
+
+func (v *solanaValidator) validateBlock(block *api.NativeBlock, rawTransactions [][]byte) error {
+	if block.Skipped {
+		// By definition skipped blocks do not need to be validated.
+		return nil
+	}
+
+	switch {
+	case block.GetSolanaV2() != nil:
+		solanaBlock := block.GetSolanaV2()
+		if err := v.validateBlockHeader(block, solanaBlock.Header); err != nil {
+			return xerrors.Errorf("failed to validate block header: %w", err)
+		}
+
+		if len(rawTransactions) > 0 && len(rawTransactions) != len(solanaBlock.Transactions) {
+			return xerrors.Errorf(
+				"unexpected number of raw transactions (expected=%v, actual=%v): %w",
+				len(solanaBlock.Transactions), len(rawTransactions), ErrInvalidTransactionSignature,
+			)
+		}
+
+		for i, transaction := range solanaBlock.Transactions {
+			var rawTransaction []byte
+			if len(rawTransactions) > 0 {
+				rawTransaction = rawTransactions[i]
+			}
+
+			if err := v.validateTransactionV2(transaction, rawTransaction); err != nil {
+				return xerrors.Errorf("failed to validate transaction %v: %w", i, err)
+			}
+		}
+	case block.GetSolana() != nil:
+		solanaBlock := block.GetSolana()
+		if err := v.validateBlockHeader(block, solanaBlock.Header); err != nil {
+			return xerrors.Errorf("failed to validate block header: %w", err)
+		}
+
+		for i, transaction := range solanaBlock.Transactions {
+			if err := v.validateTransaction(transaction); err != nil {
+				return xerrors.Errorf("failed to validate transaction %v: %w", i, err)
+			}
+		}
+	default:
+		return xerrors.New("not a solana block")
+	}
+
+	return nil
+}
+
+func (v *solanaValidator) ValidateAccountState(ctx context.Context, req *api.ValidateAccountStateRequest) (*api.ValidateAccountStateResponse, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (v *solanaValidator) validateBlockHeader(block *api.NativeBlock, header *api.SolanaHeader) error {
+	if header == nil {
+		return xerrors.New("header is missing")
+	}
+
+	if len(internal.DecodeBase58(header.BlockHash)) != solanaHashSize {
+		return xerrors.Errorf("failed to decode block hash (%v): %w", header.BlockHash, ErrInvalidBlockHash)
+	}
+
+	if header.BlockHash != block.Hash || header.Slot != block.Height {
+		return xerrors.Errorf(
+			"unexpected block in header (expected=%v/%v, actual=%v/%v): %w",
+			block.Height, block.Hash, header.Slot, header.BlockHash, ErrInvalidBlockHash,
+		)
+	}
+
+	if len(internal.DecodeBase58(header.PreviousBlockHash)) != solanaHashSize || header.PreviousBlockHash == header.BlockHash {
+		return xerrors.Errorf("invalid previous block hash (%v): %w", header.PreviousBlockHash, ErrInvalidParentBlock)
+	}
+
+	// The parent slot may be more than one slot behind due to skipped slots. The genesis block is its own parent.
+	if header.ParentSlot >= header.Slot && header.Slot != 0 {
+		return xerrors.Errorf("parent slot %v is not before slot %v: %w", header.ParentSlot, header.Slot, ErrInvalidParentBlock)
+	}
+
+	// The parent hash in the metadata is cleared when the parent block is not available in the node.
+	if header.ParentSlot != block.ParentHeight || (block.ParentHash != "" && header.PreviousBlockHash != block.ParentHash) {
+		return xerrors.Errorf(
+			"unexpected parent block in header (expected=%v/%v, actual=%v/%v): %w",
+			block.ParentHeight, block.ParentHash, header.ParentSlot, header.PreviousBlockHash, ErrInvalidParentBlock,
+		)
+	}
+
+	return nil
+}
+
+// validateTransaction validates a transaction in the json encoding.
+func (v *solanaValidator) validateTransaction(transaction *api.SolanaTransaction) error {
+	payload := transaction.GetPayload()
+	message := payload.GetMessage()
+	if message == nil || message.Header == nil {
+		return xerrors.New("message is missing")
+	}
+
+	numSigners := int(message.Header.NumRequiredSignatures)
+	if numSigners > len(message.Accounts) {
+		return xerrors.Errorf("expected %v signers but got %v accounts: %w", numSigners, len(message.Accounts), ErrInvalidTransactionSignature)
+	}
+
+	signers := make([]string, numSigners)
+	for i, account := range message.Accounts {
+		if account.Signer != (i < numSigners) {
+			return xerrors.Errorf("unexpected signer flag of account %v (%v): %w", i, account.PublicKey, ErrInvalidTransactionSignature)
+		}
+
+		if i < numSigners {
+			signers[i] = account.PublicKey
+		}
+	}
+
+	if err := v.validateSigners(transaction.TransactionId, payload.Signatures, signers); err != nil {
+		return xerrors.Errorf("failed to validate signers: %w", err)
+	}
+
+	// The accounts loaded from the address lookup tables are appended to the message accounts,
+	// but the lookup tables themselves are not available in this encoding.
+	if transaction.Version != SolanaLegacyVersion {
+		v.metrics.lookupTableCounter.Inc(1)
+		return nil
+	}
+
+	encoder := new(solanaMessageEncoder)
+	encoder.writeHeader(message.Header)
+	encoder.writeCompactU16(len(message.Accounts))
+	for _, account := range message.Accounts {
+		if err := encoder.writeBase58(account.PublicKey, solanaPublicKeySize); err != nil {
+			return xerrors.Errorf("failed to encode account: %w", err)
+		}
+	}
+
+	if err := encoder.writeBase58(message.RecentBlockHash, solanaHashSize); err != nil {
+		return xerrors.Errorf("failed to encode recent block hash: %w", err)
+	}
+
+	encoder.writeCompactU16(len(message.Instructions))
+	for i, instruction := range message.Instructions {
+		if err := encoder.writeInstruction(instruction.ProgramIdIndex, instruction.Accounts, instruction.Data); err != nil {
+			return xerrors.Errorf("failed to encode instruction %v: %w", i, err)
+		}
+	}
+
+	if err := v.verifySignatures(encoder.buf, payload.Signatures, signers); err != nil {
+		return xerrors.Errorf("failed to verify signatures: %w", err)
+	}
+
+	return nil
+}
+
+// validateTransactionV2 validates a transaction in the jsonParsed encoding against its raw transaction, if any.
+func (v *solanaValidator) validateTransactionV2(transaction *api.SolanaTransactionV2, raw []byte) error {
+	payload := transaction.GetPayload()
+	message := payload.GetMessage()
+	if message == nil {
+		return xerrors.New("message is missing")
+	}
+
+	// The static accounts are followed by the writable and then the readonly accounts loaded from the address lookup tables.
+	var header api.SolanaMessageHeader
+	var signers []string
+	numStaticAccounts := 0
+	accountIndexes := make(map[string]uint64, len(message.AccountKeys))
+	for i, account := range message.AccountKeys {
+		accountIndexes[account.Pubkey] = uint64(i)
+
+		if account.Source == solanaAccountSourceLookupTable {
+			if account.Signer {
+				return xerrors.Errorf("unexpected signer loaded from lookup table (%v): %w", account.Pubkey, ErrInvalidTransactionSignature)
+			}
+
+			continue
+		}
+
+		if numStaticAccounts != i {
+			return xerrors.Errorf("unexpected static account after loaded accounts (%v): %w", account.Pubkey, ErrInvalidTransactionSignature)
+		}
+		numStaticAccounts += 1
+
+		if account.Signer {
+			if len(signers) != i {
+				return xerrors.Errorf("unexpected signer after non-signer accounts (%v): %w", account.Pubkey, ErrInvalidTransactionSignature)
+			}
+
+			signers = append(signers, account.Pubkey)
+			header.NumRequiredSignatures += 1
+			if !account.Writable {
+				header.NumReadonlySignedAccounts += 1
+			}
+		} else if !account.Writable {
+			header.NumReadonlyUnsignedAccounts += 1
+		}
+	}
+
+	if err := v.validateSigners(transaction.TransactionId, payload.Signatures, signers); err != nil {
+		return xerrors.Errorf("failed to validate signers: %w", err)
+	}
+
+	var rawTransaction *solanaRawTransaction
+	if len(raw) > 0 {
+		decoded, err := decodeRawTransaction(raw)
+		if err != nil {
+			return xerrors.Errorf("failed to decode raw transaction (%v): %w", err, ErrInvalidTransactionSignature)
+		}
+
+		if len(decoded.signatures) != len(payload.Signatures) {
+			return xerrors.Errorf(
+				"unexpected number of signatures in raw transaction (expected=%v, actual=%v): %w",
+				len(payload.Signatures), len(decoded.signatures), ErrInvalidTransactionSignature,
+			)
+		}
+
+		for i, signature := range decoded.signatures {
+			if signature != payload.Signatures[i] {
+				return xerrors.Errorf(
+					"unexpected signature %v in raw transaction (expected=%v, actual=%v): %w",
+					i, payload.Signatures[i], signature, ErrInvalidTransactionSignature,
+				)
+			}
+		}
+
+		rawTransaction = decoded
+	}
+
+	encoder := new(solanaMessageEncoder)
+	switch transaction.Version {
+	case SolanaLegacyVersion:
+	case 0:
+		encoder.buf = append(encoder.buf, solanaVersionPrefix)
+	default:
+		return xerrors.Errorf("unsupported transaction version %v", transaction.Version)
+	}
+
+	encoder.writeHeader(&header)
+	encoder.writeCompactU16(numStaticAccounts)
+	for _, account := range message.AccountKeys[:numStaticAccounts] {
+		if err := encoder.writeBase58(account.Pubkey, solanaPublicKeySize); err != nil {
+			return xerrors.Errorf("failed to encode account: %w", err)
+		}
+	}
+
+	if err := encoder.writeBase58(message.RecentBlockHash, solanaHashSize); err != nil {
+		return xerrors.Errorf("failed to encode recent block hash: %w", err)
+	}
+
+	encoder.writeCompactU16(len(message.Instructions))
+	for i, instruction := range message.Instructions {
+		programIdIndex, ok := accountIndexes[instruction.ProgramId]
+		if !ok {
+			return xerrors.Errorf("failed to find program %v of instruction %v", instruction.ProgramId, i)
+		}
+
+		rawInstruction := instruction.GetRawInstruction()
+		if rawInstruction == nil {
+			if rawTransaction == nil {
+				// The raw data of a parsed instruction is not available, hence the message cannot be serialized.
+				return xerrors.Errorf("failed to encode parsed instruction %v: %w", i, ErrRawTransactionsMissing)
+			}
+
+			// The parsed instruction is taken from the raw transaction. Since the whole message is compared against
+			// the raw one below, this only needs to check that it is the same instruction.
+			if i >= len(rawTransaction.instructions) || rawTransaction.instructions[i].programIdIndex != programIdIndex {
+				return xerrors.Errorf("unexpected program of instruction %v in raw transaction: %w", i, ErrInvalidTransactionSignature)
+			}
+
+			decoded := rawTransaction.instructions[i]
+			if err := encoder.writeInstruction(decoded.programIdIndex, decoded.accounts, decoded.data); err != nil {
+				return xerrors.Errorf("failed to encode instruction %v: %w", i, err)
+			}
+
+			continue
+		}
+
+		accounts := make([]uint64, len(rawInstruction.Accounts))
+		for j, account := range rawInstruction.Accounts {
+			index, ok := accountIndexes[account]
+			if !ok {
+				return xerrors.Errorf("failed to find account %v of instruction %v", account, i)
+			}
+
+			accounts[j] = index
+		}
+
+		if err := encoder.writeInstruction(programIdIndex, accounts, rawInstruction.Data); err != nil {
+			return xerrors.Errorf("failed to encode instruction %v: %w", i, err)
+		}
+	}
+
+	if transaction.Version == 0 {
+		encoder.writeCompactU16(len(message.AddressTableLookups))
+		for _, lookup := range message.AddressTableLookups {
+			if err := encoder.writeBase58(lookup.AccountKey, solanaPublicKeySize); err != nil {
+				return xerrors.Errorf("failed to encode address table: %w", err)
+			}
+
+			if err := encoder.writeIndexes(lookup.WritableIndexes); err != nil {
+				return xerrors.Errorf("failed to encode writable indexes: %w", err)
+			}
+
+			if err := encoder.writeIndexes(lookup.ReadonlyIndexes); err != nil {
+				return xerrors.Errorf("failed to encode readonly indexes: %w", err)
+			}
+		}
+	}
+
+	if rawTransaction != nil && !bytes.Equal(encoder.buf, rawTransaction.message) {
+		return xerrors.Errorf("message does not match the raw transaction: %w", ErrInvalidTransactionSignature)
+	}
+
+	if err := v.verifySignatures(encoder.buf, payload.Signatures, signers); err != nil {
+		return xerrors.Errorf("failed to verify signatures: %w", err)
+	}
+
+	return nil
+}
+
+// validateSigners checks that there is exactly one signature for each signer account,
+// and that the transaction id is the first signature.
+func (v *solanaValidator) validateSigners(transactionId string, signatures []string, signers []string) error {
+	if len(signatures) == 0 || len(signatures) != len(signers) {
+		return xerrors.Errorf("expected %v signatures but got %v: %w", len(signers), len(signatures), ErrInvalidTransactionSignature)
+	}
+
+	if transactionId != signatures[0] {
+		return xerrors.Errorf("transaction id %v is not the first signature %v: %w", transactionId, signatures[0], ErrInvalidTransactionSignature)
+	}
+
+	return nil
+}
+
+func (v *solanaValidator) verifySignatures(message []byte, signatures []string, signers []string) error {
+	for i, signer := range signers {
+		publicKey := internal.DecodeBase58(signer)
+		if len(publicKey) != solanaPublicKeySize {
+			return xerrors.Errorf("failed to decode signer (%v): %w", signer, ErrInvalidTransactionSignature)
+		}
+
+		signature := internal.DecodeBase58(signatures[i])
+		if len(signature) != solanaSignatureSize {
+			return xerrors.Errorf("failed to decode signature (%v): %w", signatures[i], ErrInvalidTransactionSignature)
+		}
+
+		if !ed25519.Verify(publicKey, message, signature) {
+			return xerrors.Errorf("signature %v does not match signer %v: %w", signatures[i], signer, ErrInvalidTransactionSignature)
+		}
+	}
+
+	return nil
+}
+
+func (e *solanaMessageEncoder) writeHeader(header *api.SolanaMessageHeader) {
+	e.buf = append(
+		e.buf,
+		byte(header.NumRequiredSignatures),
+		byte(header.NumReadonlySignedAccounts),
+		byte(header.NumReadonlyUnsignedAccounts),
+	)
+}
+
+// writeCompactU16 writes the length in the compact-u16 format, i.e. 7 bits per byte with the highest bit as the continuation flag.
+func (e *solanaMessageEncoder) writeCompactU16(n int) {
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			e.buf = append(e.buf, b)
+			return
+		}
+
+		e.buf = append(e.buf, b|0x80)
+	}
+}
+
+func (e *solanaMessageEncoder) writeBase58(s string, size int) error {
+	decoded := internal.DecodeBase58(s)
+	if len(decoded) != size {
+		return xerrors.Errorf("unexpected size of %v (expected=%v, actual=%v)", s, size, len(decoded))
+	}
+
+	e.buf = append(e.buf, decoded...)
+	return nil
+}
+
+func (e *solanaMessageEncoder) writeIndexes(indexes []uint64) error {
+	e.writeCompactU16(len(indexes))
+	for _, index := range indexes {
+		if index > 0xff {
+			return xerrors.Errorf("account index %v is out of range", index)
+		}
+
+		e.buf = append(e.buf, byte(index))
+	}
+
+	return nil
+}
+
+func (e *solanaMessageEncoder) writeInstruction(programIdIndex uint64, accounts []uint64, data []byte) error {
+	if programIdIndex > 0xff {
+		return xerrors.Errorf("program index %v is out of range", programIdIndex)
+	}
+
+	e.buf = append(e.buf, byte(programIdIndex))
+	if err := e.writeIndexes(accounts); err != nil {
+		return err
+	}
+
+	e.writeCompactU16(len(data))
+	e.buf = append(e.buf, data...)
+	return nil
+}
+
+// decodeRawTransaction decodes the signatures and the instructions of a transaction in the wire format.
+// https://solana.com/docs/core/transactions#transaction
+func decodeRawTransaction(data []byte) (*solanaRawTransaction, error) {
+	d := &solanaMessageDecoder{buf: data}
+	numSignatures, err := d.readCompactU16()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read number of signatures: %w", err)
+	}
+
+	signatures := make([]string, numSignatures)
+	for i := range signatures {
+		signature, err := d.readBytes(solanaSignatureSize)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read signature %v: %w", i, err)
+		}
+
+		signatures[i] = internal.EncodeBase58(signature)
+	}
+
+	message := data[d.offset:]
+	prefix, err := d.readBytes(1)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read message: %w", err)
+	}
+
+	// A legacy message starts with the header, whose first byte is the number of signatures and is less than 0x80.
+	headerSize := 3
+	if prefix[0]&solanaVersionPrefix == 0 {
+		headerSize -= 1
+	}
+
+	if _, err := d.readBytes(headerSize); err != nil {
+		return nil, xerrors.Errorf("failed to read header: %w", err)
+	}
+
+	numAccounts, err := d.readCompactU16()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read number of accounts: %w", err)
+	}
+
+	if _, err := d.readBytes(numAccounts*solanaPublicKeySize + solanaHashSize); err != nil {
+		return nil, xerrors.Errorf("failed to read accounts: %w", err)
+	}
+
+	numInstructions, err := d.readCompactU16()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read number of instructions: %w", err)
+	}
+
+	instructions := make([]*solanaRawInstruction, numInstructions)
+	for i := range instructions {
+		instruction, err := d.readInstruction()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read instruction %v: %w", i, err)
+		}
+
+		instructions[i] = instruction
+	}
+
+	return &solanaRawTransaction{
+		signatures:   signatures,
+		message:      message,
+		instructions: instructions,
+	}, nil
+}
+
+func (d *solanaMessageDecoder) readCompactU16() (int, error) {
+	n := 0
+	for i := 0; i < 3; i++ {
+		b, err := d.readBytes(1)
+		if err != nil {
+			return 0, err
+		}
+
+		n |= int(b[0]&0x7f) << (7 * i)
+		if b[0]&0x80 == 0 {
+			return n, nil
+		}
+	}
+
+	return 0, xerrors.New("compact-u16 is too long")
+}
+
+func (d *solanaMessageDecoder) readBytes(n int) ([]byte, error) {
+	if n > len(d.buf)-d.offset {
+		return nil, xerrors.Errorf("unexpected end of data (offset=%v, size=%v)", d.offset, n)
+	}
+
+	b := d.buf[d.offset : d.offset+n]
+	d.offset += n
+	return b, nil
+}
+
+func (d *solanaMessageDecoder) readInstruction() (*solanaRawInstruction, error) {
+	programIdIndex, err := d.readBytes(1)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read program index: %w", err)
+	}
+
+	numAccounts, err := d.readCompactU16()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read number of accounts: %w", err)
+	}
+
+	indexes, err := d.readBytes(numAccounts)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read accounts: %w", err)
+	}
+
+	accounts := make([]uint64, numAccounts)
+	for i, index := range indexes {
+		accounts[i] = uint64(index)
+	}
+
+	size, err := d.readCompactU16()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read size of data: %w", err)
+	}
+
+	data, err := d.readBytes(size)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read data: %w", err)
+	}
+
+	return &solanaRawInstruction{
+		programIdIndex: uint64(programIdIndex[0]),
+		accounts:       accounts,
+		data:           data,
+	}, nil
+}
@@ -0,0 +1,512 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/fxparams"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+func TestSolanaValidator_Success(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newSolanaParser(t)
+	ctx := context.Background()
+
+	// For this test, we cover three types of transactions:
+	// 1. block_no_preBalances: a mainnet legacy transaction with two signers.
+	// 2. block_versioned_signed: a synthetic v0 transaction which loads accounts from an address lookup table.
+	// 3. block_100000000: mainnet vote transactions, whose parsed instructions are taken from the raw transactions.
+	for _, block := range []*api.Block{newLegacyBlock(), newVersionedBlock(), newVoteBlock(t)} {
+		nativeBlock, err := parser.ParseNativeBlock(ctx, block)
+		require.NoError(err)
+
+		err = parser.ValidateRawBlock(ctx, block, nativeBlock)
+		require.NoError(err, block.Metadata.Hash)
+	}
+}
+
+func TestSolanaValidator_SolanaMessage(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newSolanaParser(t)
+	err := parser.ValidateBlock(context.Background(), newSolanaV1NativeBlock())
+	require.NoError(err)
+}
+
+func TestSolanaValidator_RawTransactionsMissing(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newSolanaParser(t)
+	ctx := context.Background()
+
+	// Without the raw transactions, the messages with parsed instructions cannot be serialized.
+	block := newVoteBlock(t)
+	block.GetSolana().Transactions = nil
+	voteBlock, err := parser.ParseNativeBlock(ctx, block)
+	require.NoError(err)
+
+	err = parser.ValidateRawBlock(ctx, block, voteBlock)
+	require.Error(err)
+	require.True(xerrors.Is(err, ErrRawTransactionsMissing), err.Error())
+
+	// ValidateBlock does not have the blobdata at all.
+	block = newVoteBlock(t)
+	voteBlock, err = parser.ParseNativeBlock(ctx, block)
+	require.NoError(err)
+	err = parser.ValidateBlock(ctx, voteBlock)
+	require.Error(err)
+	require.True(xerrors.Is(err, ErrRawTransactionsMissing), err.Error())
+	require.NoError(parser.ValidateRawBlock(ctx, block, voteBlock))
+}
+
+func TestSolanaValidator_VerificationSkipped(t *testing.T) {
+	require := testutil.Require(t)
+
+	// The lookup tables are not available in the json encoding.
+	versionedBlock := newSolanaV1NativeBlock()
+	versionedBlock.GetSolana().Transactions[0].Version = 0
+
+	app := testapp.New(t)
+	defer app.Close()
+
+	scope := tally.NewTestScope("chainstorage", nil)
+	validator := NewSolanaValidator(internal.ParserParams{
+		Params: fxparams.Params{
+			Config:  app.Config(),
+			Logger:  zap.NewNop(),
+			Metrics: scope,
+		},
+	})
+	require.NoError(validator.ValidateBlock(context.Background(), versionedBlock))
+
+	counters := scope.Snapshot().Counters()
+	counter := counters["chainstorage.solana_validator.signature_verification_skipped+reason=lookup_table"]
+	require.NotNil(counter)
+	require.Equal(int64(1), counter.Value())
+}
+
+func TestSolanaValidator_Skipped(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newSolanaParser(t)
+	err := parser.ValidateBlock(context.Background(), &api.NativeBlock{
+		Blockchain: common.Blockchain_BLOCKCHAIN_SOLANA,
+		Network:    common.Network_NETWORK_SOLANA_MAINNET,
+		Skipped:    true,
+	})
+	require.NoError(err)
+}
+
+func TestSolanaValidator_Failures(t *testing.T) {
+	require := testutil.Require(t)
+
+	parser := newSolanaParser(t)
+	ctx := context.Background()
+
+	legacyBlock, err := parser.ParseNativeBlock(ctx, newLegacyBlock())
+	require.NoError(err)
+	versionedBlock, err := parser.ParseNativeBlock(ctx, newVersionedBlock())
+	require.NoError(err)
+	voteRawBlock := newVoteBlock(t)
+	voteBlock, err := parser.ParseNativeBlock(ctx, voteRawBlock)
+	require.NoError(err)
+
+	tests := []struct {
+		name      string
+		block     *api.NativeBlock
+		rawBlock  *api.Block
+		mutate    func(block *api.NativeBlock)
+		mutateRaw func(rawTransactions [][]byte) [][]byte
+		expected  error
+	}{
+		{
+			name:  "blockHash",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolanaV2().Header.BlockHash = block.GetSolanaV2().Header.PreviousBlockHash
+			},
+			expected: ErrInvalidBlockHash,
+		},
+		{
+			name:  "slot",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolanaV2().Header.Slot += 1
+			},
+			expected: ErrInvalidBlockHash,
+		},
+		{
+			name:  "previousBlockHash",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolanaV2().Header.PreviousBlockHash = "DXFTuumL1TAV85kE8WxvzqsSmbWrPK3ZDMSdqysEGeiS"
+			},
+			expected: ErrInvalidParentBlock,
+		},
+		{
+			name:  "parentSlot",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				header := block.GetSolanaV2().Header
+				header.ParentSlot = header.Slot
+				block.ParentHeight = header.Slot
+			},
+			expected: ErrInvalidParentBlock,
+		},
+		{
+			name:  "parentHeight",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolanaV2().Header.ParentSlot -= 1
+			},
+			expected: ErrInvalidParentBlock,
+		},
+		{
+			name:  "signatureOrder",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				transaction := block.GetSolanaV2().Transactions[0]
+				signatures := transaction.Payload.Signatures
+				signatures[0], signatures[1] = signatures[1], signatures[0]
+				transaction.TransactionId = signatures[0]
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "missingSignature",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				payload := block.GetSolanaV2().Transactions[0].Payload
+				payload.Signatures = payload.Signatures[:1]
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "transactionId",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				transaction := block.GetSolanaV2().Transactions[0]
+				transaction.TransactionId = transaction.Payload.Signatures[1]
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "signer",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolanaV2().Transactions[0].Payload.Message.AccountKeys[1].Signer = false
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "writable",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolanaV2().Transactions[0].Payload.Message.AccountKeys[2].Writable = false
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "instructionData",
+			block: legacyBlock,
+			mutate: func(block *api.NativeBlock) {
+				instruction := block.GetSolanaV2().Transactions[0].Payload.Message.Instructions[0]
+				instruction.GetRawInstruction().Data[0] ^= 1
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "recentBlockHash",
+			block: versionedBlock,
+			mutate: func(block *api.NativeBlock) {
+				message := block.GetSolanaV2().Transactions[0].Payload.Message
+				message.RecentBlockHash = block.GetSolanaV2().Header.BlockHash
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "lookupTableIndex",
+			block: versionedBlock,
+			mutate: func(block *api.NativeBlock) {
+				message := block.GetSolanaV2().Transactions[0].Payload.Message
+				message.AddressTableLookups[0].WritableIndexes[0] += 1
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "lookupTableSigner",
+			block: versionedBlock,
+			mutate: func(block *api.NativeBlock) {
+				message := block.GetSolanaV2().Transactions[0].Payload.Message
+				message.AccountKeys[len(message.AccountKeys)-1].Signer = true
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:     "rawTransactionOrder",
+			block:    voteBlock,
+			rawBlock: voteRawBlock,
+			mutateRaw: func(rawTransactions [][]byte) [][]byte {
+				rawTransactions[0], rawTransactions[1] = rawTransactions[1], rawTransactions[0]
+				return rawTransactions
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:     "rawTransactionMissing",
+			block:    voteBlock,
+			rawBlock: voteRawBlock,
+			mutateRaw: func(rawTransactions [][]byte) [][]byte {
+				return rawTransactions[1:]
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:     "rawTransactionTruncated",
+			block:    voteBlock,
+			rawBlock: voteRawBlock,
+			mutateRaw: func(rawTransactions [][]byte) [][]byte {
+				rawTransactions[0] = rawTransactions[0][:len(rawTransactions[0])-1]
+				return rawTransactions
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:     "rawInstructionData",
+			block:    voteBlock,
+			rawBlock: voteRawBlock,
+			mutateRaw: func(rawTransactions [][]byte) [][]byte {
+				// The vote instruction ends with the timestamp.
+				rawTransactions[0][len(rawTransactions[0])-1] ^= 1
+				return rawTransactions
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:     "parsedInstructionProgram",
+			block:    voteBlock,
+			rawBlock: voteRawBlock,
+			mutate: func(block *api.NativeBlock) {
+				message := block.GetSolanaV2().Transactions[0].Payload.Message
+				message.Instructions[0].ProgramId = message.AccountKeys[1].Pubkey
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:     "parsedInstructionAccount",
+			block:    voteBlock,
+			rawBlock: voteRawBlock,
+			mutate: func(block *api.NativeBlock) {
+				message := block.GetSolanaV2().Transactions[0].Payload.Message
+				message.AccountKeys[1].Writable = false
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "solanaMessageHeader",
+			block: newSolanaV1NativeBlock(),
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolana().Transactions[0].Payload.Message.Header.NumReadonlyUnsignedAccounts += 1
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+		{
+			name:  "solanaMessageSigner",
+			block: newSolanaV1NativeBlock(),
+			mutate: func(block *api.NativeBlock) {
+				block.GetSolana().Transactions[0].Payload.Message.Header.NumRequiredSignatures = 1
+			},
+			expected: ErrInvalidTransactionSignature,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			block := proto.Clone(test.block).(*api.NativeBlock)
+			if test.mutate != nil {
+				test.mutate(block)
+			}
+
+			var rawBlock *api.Block
+			if test.rawBlock != nil {
+				rawBlock = proto.Clone(test.rawBlock).(*api.Block)
+				if test.mutateRaw != nil {
+					blobdata := rawBlock.GetSolana()
+					blobdata.Transactions = test.mutateRaw(blobdata.Transactions)
+				}
+			}
+
+			err := parser.ValidateRawBlock(ctx, rawBlock, block)
+			require.Error(err)
+			require.True(xerrors.Is(err, test.expected), err.Error())
+		})
+	}
+}
+
+func newSolanaParser(t *testing.T) internal.Parser {
+	var parser internal.Parser
+	app := testapp.New(
+		t,
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_SOLANA, common.Network_NETWORK_SOLANA_MAINNET),
+		Module,
+		internal.Module,
+		fx.Populate(&parser),
+	)
+	t.Cleanup(app.Close)
+	require := testutil.Require(t)
+	require.NotNil(parser)
+	return parser
+}
+
+func newLegacyBlock() *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_SOLANA,
+		Network:    common.Network_NETWORK_SOLANA_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          2,
+			Hash:         "7gjbq5pn7Pt7qFEaU8xwqetCXnQkN4jJX4SDmcgxDRFS",
+			ParentHash:   "6ePKqrZHpPNfS3Q18ykDDd6YT79fYPhAo1Y3eSsSGDgP",
+			Height:       195545750,
+			ParentHeight: 195545749,
+		},
+		Blobdata: &api.Block_Solana{
+			Solana: &api.SolanaBlobdata{
+				Header: fixtures.MustReadFile("parser/solana/block_no_preBalances.json"),
+			},
+		},
+	}
+}
+
+func newVersionedBlock() *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_SOLANA,
+		Network:    common.Network_NETWORK_SOLANA_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          2,
+			Hash:         "5wbD6GsVBReHetMUw17QcNne8BjB1xSKRoU4JYpafEx5",
+			ParentHash:   "GNk2KRaw12yY4N7kZwr3tghQaviRLZdn4ZvVZJakpXud",
+			Height:       2001,
+			ParentHeight: 2000,
+		},
+		Blobdata: &api.Block_Solana{
+			Solana: &api.SolanaBlobdata{
+				Header: fixtures.MustReadFile("parser/solana/block_versioned_signed.json"),
+			},
+		},
+	}
+}
+
+// newVoteBlock returns the mainnet block at slot 100000000 in the jsonParsed encoding, along with its raw transactions.
+// Since the jsonParsed encoding does not have the data of the vote instructions, block_100000000_base64.json was
+// rebuilt from the parsed instructions, and every transaction in it has been verified against its signatures.
+func newVoteBlock(t *testing.T) *api.Block {
+	require := testutil.Require(t)
+
+	var rawBlock struct {
+		Transactions []struct {
+			Transaction []string `json:"transaction"`
+		} `json:"transactions"`
+	}
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("parser/solana/block_100000000_base64.json"), &rawBlock))
+
+	rawTransactions := make([][]byte, len(rawBlock.Transactions))
+	for i, transaction := range rawBlock.Transactions {
+		rawTransaction, err := base64.StdEncoding.DecodeString(transaction.Transaction[0])
+		require.NoError(err)
+		rawTransactions[i] = rawTransaction
+	}
+
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_SOLANA,
+		Network:    common.Network_NETWORK_SOLANA_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          2,
+			Hash:         "GdY1gj7F8vq1nCy4dgCZK42WV19bkfQ4cp2e9evK18ry",
+			ParentHash:   "7KpgQJdgXdPhzj69gCnyvyBiw9s6DZ5gmfNrhQr3XW1t",
+			Height:       100000000,
+			ParentHeight: 99999999,
+		},
+		Blobdata: &api.Block_Solana{
+			Solana: &api.SolanaBlobdata{
+				Header:       fixtures.MustReadFile("parser/solana/block_100000000.json"),
+				Transactions: rawTransactions,
+			},
+		},
+	}
+}
+
+// newSolanaV1NativeBlock returns the transaction in block_no_preBalances.json in the json encoding.
+func newSolanaV1NativeBlock() *api.NativeBlock {
+	return &api.NativeBlock{
+		Blockchain:   common.Blockchain_BLOCKCHAIN_SOLANA,
+		Network:      common.Network_NETWORK_SOLANA_MAINNET,
+		Tag:          1,
+		Hash:         "7gjbq5pn7Pt7qFEaU8xwqetCXnQkN4jJX4SDmcgxDRFS",
+		ParentHash:   "6ePKqrZHpPNfS3Q18ykDDd6YT79fYPhAo1Y3eSsSGDgP",
+		Height:       195545750,
+		ParentHeight: 195545749,
+		Block: &api.NativeBlock_Solana{
+			Solana: &api.SolanaBlock{
+				Header: &api.SolanaHeader{
+					BlockHash:         "7gjbq5pn7Pt7qFEaU8xwqetCXnQkN4jJX4SDmcgxDRFS",
+					PreviousBlockHash: "6ePKqrZHpPNfS3Q18ykDDd6YT79fYPhAo1Y3eSsSGDgP",
+					Slot:              195545750,
+					ParentSlot:        195545749,
+					BlockHeight:       108931,
+				},
+				Transactions: []*api.SolanaTransaction{
+					{
+						TransactionId: "5UdthFFkqxNe9zwBBszrkQzY2TninhkqYkokmwC2LmCQQfc2NzA11Ue92aucFfDFEFoth6N9eHGg6mNayYRomwJK",
+						Version:       SolanaLegacyVersion,
+						Payload: &api.SolanaTransactionPayload{
+							Signatures: []string{
+								"5UdthFFkqxNe9zwBBszrkQzY2TninhkqYkokmwC2LmCQQfc2NzA11Ue92aucFfDFEFoth6N9eHGg6mNayYRomwJK",
+								"4RNyv4U7p7ysTtq6QLmnZKymWsxnAMkDTV2TMJZ8TrcuFPqR5ccyUrPKfA5pukGk2xLFTCdX3JpycRipLZsC9py7",
+							},
+							Message: &api.SolanaMessage{
+								Header: &api.SolanaMessageHeader{
+									NumRequiredSignatures:       2,
+									NumReadonlySignedAccounts:   0,
+									NumReadonlyUnsignedAccounts: 3,
+								},
+								RecentBlockHash: "G7nWcGPkHWcV6SM6AKyYy6rH8WmvSzhGpnwicScFXRx6",
+								Instructions: []*api.SolanaInstruction{
+									{
+										ProgramIdIndex: 5,
+										Accounts:       []uint64{2, 1, 3, 4},
+										Data:           internal.DecodeBase58("5Nvj6zqzopTvddP5"),
+										ProgramId:      "Stake11111111111111111111111111111111111111",
+									},
+								},
+								Accounts: []*api.SolanaAccount{
+									{PublicKey: "7vEAL3nS9CWmy1q6njUUyHE7Cf5RmyQpND6CsoHjzPiR", Signer: true, Writable: true},
+									{PublicKey: "3FFaheyqtyAXZSYxDzsr5CVKvJuvZD1WE1VEsBtDbRqB", Signer: true, Writable: true},
+									{PublicKey: "APnSR52EC1eH676m7qTBHUJ1nrGpHYpV7XKPxgRDD8gX", Writable: true},
+									{PublicKey: "SysvarC1ock11111111111111111111111111111111"},
+									{PublicKey: "SysvarStakeHistory1111111111111111111111111"},
+									{PublicKey: "Stake11111111111111111111111111111111111111"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,11 @@
+package sui
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+)
+
+var Module = fx.Options(
+	internal.NewParserBuilder("sui", NewNativeParser).Build(),
+)
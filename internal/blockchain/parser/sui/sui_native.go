@@ -0,0 +1,442 @@
+package sui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	nativeParserImpl struct {
+		logger   *zap.Logger
+		validate *validator.Validate
+	}
+
+	// BigInt is a uint64 encoded as a decimal string, which is how the Sui JSON-RPC encodes the 64-bit integers.
+	// A few fields, e.g. the object versions, are encoded as numbers instead; both forms are accepted.
+	BigInt uint64
+
+	// Checkpoint is the result of sui_getCheckpoint.
+	Checkpoint struct {
+		Epoch                      BigInt          `json:"epoch"`
+		SequenceNumber             BigInt          `json:"sequenceNumber"`
+		Digest                     string          `json:"digest" validate:"required"`
+		NetworkTotalTransactions   BigInt          `json:"networkTotalTransactions"`
+		PreviousDigest             string          `json:"previousDigest"`
+		EpochRollingGasCostSummary *GasCostSummary `json:"epochRollingGasCostSummary" validate:"required"`
+		TimestampMs                BigInt          `json:"timestampMs"`
+		EndOfEpochData             *EndOfEpochData `json:"endOfEpochData"`
+		Transactions               []string        `json:"transactions"`
+		ValidatorSignature         string          `json:"validatorSignature"`
+	}
+
+	// CheckpointPage is the result of sui_getCheckpoints.
+	CheckpointPage struct {
+		Data        []*Checkpoint `json:"data" validate:"dive,required"`
+		NextCursor  string        `json:"nextCursor"`
+		HasNextPage bool          `json:"hasNextPage"`
+	}
+
+	EndOfEpochData struct {
+		NextEpochProtocolVersion BigInt `json:"nextEpochProtocolVersion"`
+	}
+
+	GasCostSummary struct {
+		ComputationCost         BigInt `json:"computationCost"`
+		StorageCost             BigInt `json:"storageCost"`
+		StorageRebate           BigInt `json:"storageRebate"`
+		NonRefundableStorageFee BigInt `json:"nonRefundableStorageFee"`
+	}
+
+	// TransactionBlock is an element of the result of sui_multiGetTransactionBlocks,
+	// which is queried with showInput, showEffects, showEvents and showBalanceChanges.
+	TransactionBlock struct {
+		Digest         string              `json:"digest" validate:"required"`
+		Transaction    *Transaction        `json:"transaction" validate:"required"`
+		Effects        *TransactionEffects `json:"effects" validate:"required"`
+		Events         []*Event            `json:"events" validate:"dive,required"`
+		BalanceChanges []*BalanceChange    `json:"balanceChanges" validate:"dive,required"`
+		TimestampMs    BigInt              `json:"timestampMs"`
+		Checkpoint     BigInt              `json:"checkpoint"`
+	}
+
+	Transaction struct {
+		Data         *TransactionData `json:"data" validate:"required"`
+		TxSignatures []string         `json:"txSignatures"`
+	}
+
+	TransactionData struct {
+		MessageVersion string           `json:"messageVersion"`
+		Transaction    *TransactionKind `json:"transaction" validate:"required"`
+		Sender         string           `json:"sender" validate:"required"`
+		GasData        *GasData         `json:"gasData" validate:"required"`
+	}
+
+	// TransactionKind only keeps the kind; the inputs and commands are left in the blobdata.
+	TransactionKind struct {
+		Kind string `json:"kind" validate:"required"`
+	}
+
+	GasData struct {
+		Payment []*ObjectRef `json:"payment" validate:"dive,required"`
+		Owner   string       `json:"owner"`
+		Price   BigInt       `json:"price"`
+		Budget  BigInt       `json:"budget"`
+	}
+
+	ObjectRef struct {
+		ObjectId string `json:"objectId"`
+		Version  BigInt `json:"version"`
+		Digest   string `json:"digest"`
+	}
+
+	TransactionEffects struct {
+		Status            *ExecutionStatus `json:"status" validate:"required"`
+		ExecutedEpoch     BigInt           `json:"executedEpoch"`
+		GasUsed           *GasCostSummary  `json:"gasUsed" validate:"required"`
+		TransactionDigest string           `json:"transactionDigest"`
+		EventsDigest      string           `json:"eventsDigest"`
+		Dependencies      []string         `json:"dependencies"`
+	}
+
+	ExecutionStatus struct {
+		Status string `json:"status" validate:"required"`
+		Error  string `json:"error"`
+	}
+
+	Event struct {
+		Id                *EventId        `json:"id" validate:"required"`
+		PackageId         string          `json:"packageId"`
+		TransactionModule string          `json:"transactionModule"`
+		Sender            string          `json:"sender"`
+		Type              string          `json:"type"`
+		ParsedJson        json.RawMessage `json:"parsedJson"`
+		Bcs               string          `json:"bcs"`
+	}
+
+	EventId struct {
+		TxDigest string `json:"txDigest"`
+		EventSeq BigInt `json:"eventSeq"`
+	}
+
+	BalanceChange struct {
+		Owner    *Owner `json:"owner" validate:"required"`
+		CoinType string `json:"coinType"`
+		Amount   string `json:"amount"`
+	}
+
+	// Owner is encoded either as the string "Immutable" or as an object with a single key,
+	// e.g. {"AddressOwner": "0x..."}, {"ObjectOwner": "0x..."} or {"Shared": {"initial_shared_version": 1}}.
+	Owner struct {
+		Type                 api.SuiOwner_Type
+		Address              string
+		InitialSharedVersion uint64
+	}
+)
+
+const (
+	executionStatusSuccess = "success"
+	executionStatusFailure = "failure"
+
+	ownerImmutable = "Immutable"
+)
+
+func NewNativeParser(params internal.ParserParams, opts ...internal.ParserFactoryOption) (internal.NativeParser, error) {
+	return &nativeParserImpl{
+		logger:   log.WithPackage(params.Logger),
+		validate: validator.New(),
+	}, nil
+}
+
+func (v BigInt) MarshalJSON() ([]byte, error) {
+	s := fmt.Sprintf(`"%d"`, uint64(v))
+	return []byte(s), nil
+}
+
+func (v *BigInt) UnmarshalJSON(input []byte) error {
+	if bytes.Equal(input, []byte("null")) {
+		*v = 0
+		return nil
+	}
+
+	s := string(input)
+	if len(input) > 0 && input[0] == '"' {
+		if err := json.Unmarshal(input, &s); err != nil {
+			return xerrors.Errorf("failed to unmarshal BigInt into string: %w", err)
+		}
+	}
+
+	if s == "" {
+		*v = 0
+		return nil
+	}
+
+	i, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return xerrors.Errorf("failed to decode BigInt %v: %w", s, err)
+	}
+
+	*v = BigInt(i)
+	return nil
+}
+
+func (v BigInt) Value() uint64 {
+	return uint64(v)
+}
+
+func (o *Owner) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err == nil {
+		if s != ownerImmutable {
+			return xerrors.Errorf("unknown owner %v", s)
+		}
+
+		*o = Owner{Type: api.SuiOwner_IMMUTABLE}
+		return nil
+	}
+
+	var owner struct {
+		AddressOwner *string `json:"AddressOwner"`
+		ObjectOwner  *string `json:"ObjectOwner"`
+		Shared       *struct {
+			InitialSharedVersion BigInt `json:"initial_shared_version"`
+		} `json:"Shared"`
+	}
+	if err := json.Unmarshal(input, &owner); err != nil {
+		return xerrors.Errorf("failed to unmarshal owner: %w", err)
+	}
+
+	switch {
+	case owner.AddressOwner != nil:
+		*o = Owner{Type: api.SuiOwner_ADDRESS, Address: *owner.AddressOwner}
+	case owner.ObjectOwner != nil:
+		*o = Owner{Type: api.SuiOwner_OBJECT, Address: *owner.ObjectOwner}
+	case owner.Shared != nil:
+		*o = Owner{Type: api.SuiOwner_SHARED, InitialSharedVersion: owner.Shared.InitialSharedVersion.Value()}
+	default:
+		// Keep the newer kinds of ownership as unknown instead of failing the whole checkpoint.
+		*o = Owner{Type: api.SuiOwner_UNKNOWN}
+	}
+
+	return nil
+}
+
+// GetTimestamp converts the timestamp in milliseconds.
+func (c *Checkpoint) GetTimestamp() *timestamppb.Timestamp {
+	return toTimestamp(c.TimestampMs)
+}
+
+func (p *nativeParserImpl) ParseBlock(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+	metadata := rawBlock.GetMetadata()
+	if metadata == nil {
+		return nil, xerrors.New("metadata not found")
+	}
+
+	blobdata := rawBlock.GetSui()
+	if blobdata == nil {
+		return nil, xerrors.Errorf("blobdata not found (metadata={%+v})", metadata)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(blobdata.Checkpoint, &checkpoint); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal checkpoint (metadata={%+v}): %w", metadata, err)
+	}
+
+	if err := p.validate.Struct(checkpoint); err != nil {
+		return nil, xerrors.Errorf("failed to validate checkpoint (metadata={%+v}): %w", metadata, err)
+	}
+
+	header, err := p.parseCheckpoint(&checkpoint, metadata)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse checkpoint (metadata={%+v}): %w", metadata, err)
+	}
+
+	transactions, err := p.parseTransactions(&checkpoint, blobdata.Transactions)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse transactions (metadata={%+v}): %w", metadata, err)
+	}
+
+	return &api.NativeBlock{
+		Blockchain:      rawBlock.Blockchain,
+		Network:         rawBlock.Network,
+		SideChain:       rawBlock.SideChain,
+		Tag:             metadata.Tag,
+		Hash:            metadata.Hash,
+		ParentHash:      metadata.ParentHash,
+		Height:          metadata.Height,
+		ParentHeight:    metadata.ParentHeight,
+		Timestamp:       metadata.Timestamp,
+		NumTransactions: uint64(len(transactions)),
+		Block: &api.NativeBlock_Sui{
+			Sui: &api.SuiBlock{
+				Checkpoint:   header,
+				Transactions: transactions,
+			},
+		},
+	}, nil
+}
+
+func (p *nativeParserImpl) GetTransaction(ctx context.Context, nativeBlock *api.NativeBlock, transactionHash string) (*api.NativeTransaction, error) {
+	return nil, internal.ErrNotImplemented
+}
+
+func (p *nativeParserImpl) parseCheckpoint(checkpoint *Checkpoint, metadata *api.BlockMetadata) (*api.SuiCheckpoint, error) {
+	if checkpoint.SequenceNumber.Value() != metadata.Height {
+		return nil, xerrors.Errorf("unexpected sequence number in checkpoint: expected=%v, actual=%v", metadata.Height, checkpoint.SequenceNumber.Value())
+	}
+
+	if checkpoint.Digest != metadata.Hash {
+		return nil, xerrors.Errorf("unexpected digest in checkpoint: expected=%v, actual=%v", metadata.Hash, checkpoint.Digest)
+	}
+
+	return &api.SuiCheckpoint{
+		Epoch:                      checkpoint.Epoch.Value(),
+		SequenceNumber:             checkpoint.SequenceNumber.Value(),
+		Digest:                     checkpoint.Digest,
+		PreviousDigest:             checkpoint.PreviousDigest,
+		NetworkTotalTransactions:   checkpoint.NetworkTotalTransactions.Value(),
+		Timestamp:                  checkpoint.GetTimestamp(),
+		EpochRollingGasCostSummary: p.parseGasCostSummary(checkpoint.EpochRollingGasCostSummary),
+		Transactions:               checkpoint.Transactions,
+		ValidatorSignature:         checkpoint.ValidatorSignature,
+		EndOfEpoch:                 checkpoint.EndOfEpochData != nil,
+	}, nil
+}
+
+func (p *nativeParserImpl) parseTransactions(checkpoint *Checkpoint, rawTransactions [][]byte) ([]*api.SuiTransactionBlock, error) {
+	if len(rawTransactions) != len(checkpoint.Transactions) {
+		return nil, xerrors.Errorf("unexpected number of transactions: expected=%v, actual=%v", len(checkpoint.Transactions), len(rawTransactions))
+	}
+
+	transactions := make([]*api.SuiTransactionBlock, len(rawTransactions))
+	for i, rawTransaction := range rawTransactions {
+		var tx TransactionBlock
+		if err := json.Unmarshal(rawTransaction, &tx); err != nil {
+			return nil, xerrors.Errorf("failed to unmarshal transaction %v: %w", i, err)
+		}
+
+		if err := p.validate.Struct(tx); err != nil {
+			return nil, xerrors.Errorf("failed to validate transaction %v: %w", i, err)
+		}
+
+		if tx.Digest != checkpoint.Transactions[i] {
+			return nil, xerrors.Errorf("unexpected digest of transaction %v: expected=%v, actual=%v", i, checkpoint.Transactions[i], tx.Digest)
+		}
+
+		transaction, err := p.parseTransaction(&tx, uint64(i))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to parse transaction %v: %w", i, err)
+		}
+
+		transactions[i] = transaction
+	}
+
+	return transactions, nil
+}
+
+func (p *nativeParserImpl) parseTransaction(tx *TransactionBlock, index uint64) (*api.SuiTransactionBlock, error) {
+	data := tx.Transaction.Data
+	effects, err := p.parseEffects(tx.Effects)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse effects: %w", err)
+	}
+
+	payment := make([]*api.SuiObjectRef, len(data.GasData.Payment))
+	for i, ref := range data.GasData.Payment {
+		payment[i] = &api.SuiObjectRef{
+			ObjectId: ref.ObjectId,
+			Version:  ref.Version.Value(),
+			Digest:   ref.Digest,
+		}
+	}
+
+	events := make([]*api.SuiEvent, len(tx.Events))
+	for i, event := range tx.Events {
+		events[i] = &api.SuiEvent{
+			TransactionDigest: event.Id.TxDigest,
+			EventSequence:     event.Id.EventSeq.Value(),
+			PackageId:         event.PackageId,
+			TransactionModule: event.TransactionModule,
+			Sender:            event.Sender,
+			Type:              event.Type,
+			ParsedJson:        string(event.ParsedJson),
+			Bcs:               event.Bcs,
+		}
+	}
+
+	balanceChanges := make([]*api.SuiBalanceChange, len(tx.BalanceChanges))
+	for i, change := range tx.BalanceChanges {
+		balanceChanges[i] = &api.SuiBalanceChange{
+			Owner: &api.SuiOwner{
+				Type:                 change.Owner.Type,
+				Address:              change.Owner.Address,
+				InitialSharedVersion: change.Owner.InitialSharedVersion,
+			},
+			CoinType: change.CoinType,
+			Amount:   change.Amount,
+		}
+	}
+
+	return &api.SuiTransactionBlock{
+		Digest: tx.Digest,
+		Index:  index,
+		Sender: data.Sender,
+		Kind:   data.Transaction.Kind,
+		GasData: &api.SuiGasData{
+			Owner:   data.GasData.Owner,
+			Price:   data.GasData.Price.Value(),
+			Budget:  data.GasData.Budget.Value(),
+			Payment: payment,
+		},
+		Effects:        effects,
+		Events:         events,
+		BalanceChanges: balanceChanges,
+		Timestamp:      toTimestamp(tx.TimestampMs),
+	}, nil
+}
+
+func (p *nativeParserImpl) parseEffects(effects *TransactionEffects) (*api.SuiTransactionEffects, error) {
+	var status api.SuiTransactionEffects_Status
+	switch effects.Status.Status {
+	case executionStatusSuccess:
+		status = api.SuiTransactionEffects_SUCCESS
+	case executionStatusFailure:
+		status = api.SuiTransactionEffects_FAILURE
+	default:
+		return nil, xerrors.Errorf("unknown execution status %v", effects.Status.Status)
+	}
+
+	return &api.SuiTransactionEffects{
+		Status:        status,
+		Error:         effects.Status.Error,
+		ExecutedEpoch: effects.ExecutedEpoch.Value(),
+		GasUsed:       p.parseGasCostSummary(effects.GasUsed),
+		EventsDigest:  effects.EventsDigest,
+		Dependencies:  effects.Dependencies,
+	}, nil
+}
+
+func (p *nativeParserImpl) parseGasCostSummary(summary *GasCostSummary) *api.SuiGasCostSummary {
+	return &api.SuiGasCostSummary{
+		ComputationCost:         summary.ComputationCost.Value(),
+		StorageCost:             summary.StorageCost.Value(),
+		StorageRebate:           summary.StorageRebate.Value(),
+		NonRefundableStorageFee: summary.NonRefundableStorageFee.Value(),
+	}
+}
+
+func toTimestamp(timestampMs BigInt) *timestamppb.Timestamp {
+	return timestamppb.New(time.UnixMilli(int64(timestampMs.Value())))
+}
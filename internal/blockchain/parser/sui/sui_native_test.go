@@ -0,0 +1,280 @@
+package sui
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type suiNativeParserTestSuite struct {
+	suite.Suite
+
+	app    testapp.TestApp
+	parser internal.NativeParser
+}
+
+// The Sui fixtures are NOT mainnet data: the checkpoint and its transactions were written by hand in the shape of the
+// JSON-RPC responses, so the digests, signatures and amounts are not real. They only exercise the decoding and the
+// consistency checks, and should be replaced with real sui_getCheckpoint and sui_multiGetTransactionBlocks responses.
+const (
+	suiTag          = uint32(1)
+	suiHeight       = uint64(50000001)
+	suiParentHeight = uint64(50000000)
+	suiHash         = "4G1Zz82Vbq3tLXVrmtrDALCa4U1k4bauYLGnfLhvDzvY"
+	suiParentHash   = "H2ZwmDyVHXx9d8JFZVpooTHKmuGRew5KGVzFLLq7mN7Q"
+	suiTimestamp    = "2024-01-10T08:50:12.345Z"
+
+	suiSender    = "0x0a367b92cf0b037dfd89960ee832d56f7fc151681bb41e53690e776f5786998a"
+	suiSender2   = "0x62618a985139e9107e5da557444cbc05f88a2a8653045b72e72f03f077659296"
+	suiValidator = "0xf82af32160bc53112ca118abbf57fa6fed47eb90291a1d1d92f438ae2ed74ef6"
+)
+
+var suiTransactionDigests = []string{
+	"B5mPBoD75UTsWp3N5v9hsJZLKC7MuWUfuqcqtSGM9tYd",
+	"8aa3PPhXJkUJzgYy7wLaTsnKG5hGbyt6kyGPFkq2ev5g",
+	"3gKu6EJAgtcudQtcfASjvPnfyETmjqnmcLEALrreFSsk",
+}
+
+func TestSuiNativeParserTestSuite(t *testing.T) {
+	suite.Run(t, new(suiNativeParserTestSuite))
+}
+
+func (s *suiNativeParserTestSuite) SetupTest() {
+	s.app = testapp.New(
+		s.T(),
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_SUI, common.Network_NETWORK_SUI_MAINNET),
+		fx.Provide(NewNativeParser),
+		fx.Populate(&s.parser),
+	)
+	s.NotNil(s.parser)
+}
+
+func (s *suiNativeParserTestSuite) TearDownTest() {
+	s.app.Close()
+}
+
+func (s *suiNativeParserTestSuite) TestParseBlock() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/sui/synthetic_checkpoint.json"), s.readTransactions())
+	nativeBlock, err := s.parser.ParseBlock(context.Background(), block)
+	require.NoError(err)
+
+	expectedTimestamp := testutil.MustTimestamp(suiTimestamp)
+	require.Equal(common.Blockchain_BLOCKCHAIN_SUI, nativeBlock.Blockchain)
+	require.Equal(common.Network_NETWORK_SUI_MAINNET, nativeBlock.Network)
+	require.Equal(suiTag, nativeBlock.Tag)
+	require.Equal(suiHeight, nativeBlock.Height)
+	require.Equal(suiParentHeight, nativeBlock.ParentHeight)
+	require.Equal(suiHash, nativeBlock.Hash)
+	require.Equal(suiParentHash, nativeBlock.ParentHash)
+	require.Equal(expectedTimestamp, nativeBlock.Timestamp)
+	require.Equal(uint64(3), nativeBlock.NumTransactions)
+	require.False(nativeBlock.Skipped)
+
+	suiBlock := nativeBlock.GetSui()
+	require.NotNil(suiBlock)
+
+	checkpoint := suiBlock.Checkpoint
+	require.Equal(uint64(290), checkpoint.Epoch)
+	require.Equal(suiHeight, checkpoint.SequenceNumber)
+	require.Equal(suiHash, checkpoint.Digest)
+	require.Equal(suiParentHash, checkpoint.PreviousDigest)
+	require.Equal(uint64(1550000001), checkpoint.NetworkTotalTransactions)
+	require.Equal(expectedTimestamp, checkpoint.Timestamp)
+	require.Equal(&api.SuiGasCostSummary{
+		ComputationCost:         123456789000,
+		StorageCost:             987654321000,
+		StorageRebate:           876543210000,
+		NonRefundableStorageFee: 8765432100,
+	}, checkpoint.EpochRollingGasCostSummary)
+	require.Equal(suiTransactionDigests, checkpoint.Transactions)
+	require.NotEmpty(checkpoint.ValidatorSignature)
+	require.False(checkpoint.EndOfEpoch)
+
+	transactions := suiBlock.Transactions
+	require.Equal(3, len(transactions))
+
+	// The consensus commit prologue is a system transaction without gas.
+	tx := transactions[0]
+	require.Equal(suiTransactionDigests[0], tx.Digest)
+	require.Equal(uint64(0), tx.Index)
+	require.Equal("ConsensusCommitPrologueV3", tx.Kind)
+	require.Equal(api.SuiTransactionEffects_SUCCESS, tx.Effects.Status)
+	require.Equal(uint64(0), tx.Effects.GasUsed.ComputationCost)
+	require.Empty(tx.Events)
+	require.Empty(tx.BalanceChanges)
+
+	// Stake 1 SUI with a validator.
+	tx = transactions[1]
+	require.Equal(suiTransactionDigests[1], tx.Digest)
+	require.Equal(uint64(1), tx.Index)
+	require.Equal(suiSender, tx.Sender)
+	require.Equal("ProgrammableTransaction", tx.Kind)
+	require.Equal(expectedTimestamp, tx.Timestamp)
+	require.Equal(&api.SuiGasData{
+		Owner:  suiSender,
+		Price:  750,
+		Budget: 50000000,
+		Payment: []*api.SuiObjectRef{
+			{
+				ObjectId: "0x3644e00fd3196b1ad88f89473972558af0928fc5e2179c856081ea8a732ec9b6",
+				Version:  412345678,
+				Digest:   "4eqvu8z64JzaAs3WSsTLbMGVQZSarTJCn3dnv7sdjtoF",
+			},
+		},
+	}, tx.GasData)
+	require.Equal(&api.SuiTransactionEffects{
+		Status:        api.SuiTransactionEffects_SUCCESS,
+		ExecutedEpoch: 290,
+		GasUsed: &api.SuiGasCostSummary{
+			ComputationCost:         750000,
+			StorageCost:             14592000,
+			StorageRebate:           13068180,
+			NonRefundableStorageFee: 132002,
+		},
+		EventsDigest: "Cu5nLgPXeSH3TaTaBC1j3PTPcp7LJ7f64Wozdg5APQe9",
+		Dependencies: []string{
+			suiTransactionDigests[0],
+			"6bfrZ9VNT2VS2VaB4iPw3JViNGhB5tKdujqQCHDe8aS7",
+		},
+	}, tx.Effects)
+	require.Equal(1, len(tx.Events))
+	event := tx.Events[0]
+	require.Equal(suiTransactionDigests[1], event.TransactionDigest)
+	require.Equal(uint64(0), event.EventSequence)
+	require.Equal("0x0000000000000000000000000000000000000000000000000000000000000003", event.PackageId)
+	require.Equal("sui_system", event.TransactionModule)
+	require.Equal(suiSender, event.Sender)
+	require.Equal("0x3::validator::StakingRequestEvent", event.Type)
+	require.NotEmpty(event.Bcs)
+	var stakingRequest map[string]string
+	require.NoError(json.Unmarshal([]byte(event.ParsedJson), &stakingRequest))
+	require.Equal("1000000000", stakingRequest["amount"])
+	require.Equal(suiValidator, stakingRequest["validator_address"])
+	require.Equal([]*api.SuiBalanceChange{
+		{
+			Owner: &api.SuiOwner{
+				Type:    api.SuiOwner_ADDRESS,
+				Address: suiSender,
+			},
+			CoinType: "0x2::sui::SUI",
+			Amount:   "-1002273820",
+		},
+	}, tx.BalanceChanges)
+
+	// The failed transaction is still charged for gas.
+	tx = transactions[2]
+	require.Equal(suiSender2, tx.Sender)
+	require.Equal(api.SuiTransactionEffects_FAILURE, tx.Effects.Status)
+	require.Equal("InsufficientCoinBalance in command 0", tx.Effects.Error)
+	require.Empty(tx.Events)
+	require.Equal(1, len(tx.BalanceChanges))
+	require.Equal("-759880", tx.BalanceChanges[0].Amount)
+}
+
+func (s *suiNativeParserTestSuite) TestParseBlock_UnexpectedDigest() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/sui/synthetic_checkpoint.json"), s.readTransactions())
+	block.Metadata.Hash = suiParentHash
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected digest in checkpoint")
+}
+
+func (s *suiNativeParserTestSuite) TestParseBlock_MissingTransactions() {
+	require := testutil.Require(s.T())
+
+	block := s.newBlock(fixtures.MustReadFile("parser/sui/synthetic_checkpoint.json"), s.readTransactions()[:2])
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected number of transactions")
+}
+
+func (s *suiNativeParserTestSuite) TestParseBlock_UnexpectedTransactionOrder() {
+	require := testutil.Require(s.T())
+
+	transactions := s.readTransactions()
+	transactions[1], transactions[2] = transactions[2], transactions[1]
+	block := s.newBlock(fixtures.MustReadFile("parser/sui/synthetic_checkpoint.json"), transactions)
+	_, err := s.parser.ParseBlock(context.Background(), block)
+	require.Error(err)
+	require.Contains(err.Error(), "unexpected digest of transaction 1")
+}
+
+func (s *suiNativeParserTestSuite) TestUnmarshalOwner() {
+	require := testutil.Require(s.T())
+
+	tests := []struct {
+		input    string
+		expected Owner
+	}{
+		{
+			input:    `{"AddressOwner":"` + suiSender + `"}`,
+			expected: Owner{Type: api.SuiOwner_ADDRESS, Address: suiSender},
+		},
+		{
+			input:    `{"ObjectOwner":"` + suiSender2 + `"}`,
+			expected: Owner{Type: api.SuiOwner_OBJECT, Address: suiSender2},
+		},
+		{
+			input:    `{"Shared":{"initial_shared_version":1}}`,
+			expected: Owner{Type: api.SuiOwner_SHARED, InitialSharedVersion: 1},
+		},
+		{
+			input:    `"Immutable"`,
+			expected: Owner{Type: api.SuiOwner_IMMUTABLE},
+		},
+	}
+	for _, test := range tests {
+		var owner Owner
+		require.NoError(json.Unmarshal([]byte(test.input), &owner))
+		require.Equal(test.expected, owner)
+	}
+}
+
+func (s *suiNativeParserTestSuite) readTransactions() [][]byte {
+	require := testutil.Require(s.T())
+
+	var results []json.RawMessage
+	require.NoError(json.Unmarshal(fixtures.MustReadFile("parser/sui/synthetic_transactions.json"), &results))
+
+	transactions := make([][]byte, len(results))
+	for i, result := range results {
+		transactions[i] = result
+	}
+
+	return transactions
+}
+
+func (s *suiNativeParserTestSuite) newBlock(checkpoint []byte, transactions [][]byte) *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_SUI,
+		Network:    common.Network_NETWORK_SUI_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:          suiTag,
+			Hash:         suiHash,
+			ParentHash:   suiParentHash,
+			Height:       suiHeight,
+			ParentHeight: suiParentHeight,
+			Timestamp:    testutil.MustTimestamp(suiTimestamp),
+		},
+		Blobdata: &api.Block_Sui{
+			Sui: &api.SuiBlobdata{
+				Checkpoint:   checkpoint,
+				Transactions: transactions,
+			},
+		},
+	}
+}
@@ -31,6 +31,8 @@ type (
 		Chain          ChainConfig          `mapstructure:"chain"`
 		AWS            AwsConfig            `mapstructure:"aws"`
 		GCP            *GcpConfig           `mapstructure:"gcp"`
+		Postgres       *PostgresConfig      `mapstructure:"postgres"`
+		Filesystem     *FilesystemConfig    `mapstructure:"filesystem"`
 		Cadence        CadenceConfig        `mapstructure:"cadence"`
 		Workflows      WorkflowsConfig      `mapstructure:"workflows"`
 		Api            ApiConfig            `mapstructure:"api"`
@@ -92,9 +94,14 @@ type (
 		RosettaParser               bool `mapstructure:"rosetta_parser"`
 		DefaultStableEvent          bool `mapstructure:"default_stable_event"`
 		TransactionIndexing         bool `mapstructure:"transaction_indexing"`
+		AddressIndexing             bool `mapstructure:"address_indexing"`
 		BlockValidationEnabled      bool `mapstructure:"block_validation_enabled"`
 		BlockValidationMuted        bool `mapstructure:"block_validation_muted"`
 		VerifiedAccountStateEnabled bool `mapstructure:"verified_account_state_enabled"`
+		// RawTransactionsEnabled fetches the transactions in the wire format as well, at the cost of a second getBlock call
+		// per block, and stores them in the blobdata. Only solana supports it, where the raw transactions are needed to
+		// verify the signatures of the transactions with parsed instructions.
+		RawTransactionsEnabled bool `mapstructure:"raw_transactions_enabled"`
 	}
 
 	BlockTagConfig struct {
@@ -131,6 +138,28 @@ type (
 		PresignedUrlExpiration time.Duration `mapstructure:"presigned_url_expiration" validate:"required"`
 	}
 
+	// FilesystemConfig configures the blob storage backed by a local directory.
+	// Presigned urls are served by the blob server started alongside the API server,
+	// which is expected to be reachable at BaseUrl.
+	FilesystemConfig struct {
+		RootDirectory          string        `mapstructure:"root_directory" validate:"required"`
+		BaseUrl                string        `mapstructure:"base_url" validate:"required"`
+		SigningKey             string        `mapstructure:"signing_key" validate:"required"`
+		PresignedUrlExpiration time.Duration `mapstructure:"presigned_url_expiration" validate:"required"`
+	}
+
+	PostgresConfig struct {
+		Host           string        `mapstructure:"host" validate:"required"`
+		Port           int           `mapstructure:"port" validate:"required"`
+		User           string        `mapstructure:"user" validate:"required"`
+		Password       string        `mapstructure:"password"`
+		Database       string        `mapstructure:"database" validate:"required"`
+		Schema         string        `mapstructure:"schema"`
+		SSLMode        string        `mapstructure:"ssl_mode"`
+		MaxConnections int           `mapstructure:"max_connections"`
+		ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	}
+
 	DynamoDBConfig struct {
 		BlockTable                    string `mapstructure:"block_table" validate:"required"`
 		EventTable                    string `mapstructure:"event_table"`
@@ -138,6 +167,7 @@ type (
 		VersionedEventTable           string `mapstructure:"versioned_event_table" validate:"required"`
 		VersionedEventTableBlockIndex string `mapstructure:"versioned_event_table_block_index" validate:"required"`
 		TransactionTable              string `mapstructure:"transaction_table"`
+		AddressTable                  string `mapstructure:"address_table"`
 		Arn                           string `mapstructure:"arn"`
 	}
 
@@ -320,6 +350,8 @@ type (
 	ApiConfig struct {
 		MaxNumBlocks            uint64          `mapstructure:"max_num_blocks" validate:"required"`
 		MaxNumBlockFiles        uint64          `mapstructure:"max_num_block_files" validate:"required"`
+		MaxNumAddressBlocks     uint64          `mapstructure:"max_num_address_blocks" validate:"required"`
+		MaxNumAddressTxs        uint64          `mapstructure:"max_num_address_txs" validate:"required"`
 		NumWorkers              uint64          `mapstructure:"num_workers" validate:"required"`
 		StreamingInterval       time.Duration   `mapstructure:"streaming_interval" validate:"required"`
 		StreamingBatchSize      uint64          `mapstructure:"streaming_batch_size" validate:"required"`
@@ -337,7 +369,8 @@ type (
 	}
 
 	ServerConfig struct {
-		BindAddress string `mapstructure:"bind_address" validate:"required"`
+		BindAddress     string `mapstructure:"bind_address" validate:"required"`
+		BlobBindAddress string `mapstructure:"blob_bind_address"`
 	}
 
 	CronConfig struct {
@@ -351,6 +384,9 @@ type (
 
 	StorageConfig struct {
 		DataCompression api.Compression `mapstructure:"data_compression"`
+		// ZstdDictionaryId is the id of the embedded dictionary used by the ZSTD compression, or zero if no dictionary is used.
+		// See internal/storage/utils/zstd_dictionaries for the available dictionaries.
+		ZstdDictionaryId uint32 `mapstructure:"zstd_dictionary_id"`
 	}
 
 	SLAConfig struct {
@@ -447,12 +483,14 @@ var (
 		"UNSPECIFIED": 0,
 		"S3":          1,
 		"GCS":         2,
+		"FILESYSTEM":  3,
 	}
 
 	MetaStorageType_value = map[string]int32{
 		"UNSPECIFIED": 0,
 		"DYNAMODB":    1,
 		"FIRESTORE":   2,
+		"POSTGRES":    3,
 	}
 
 	DLQType_value = map[string]int32{
@@ -485,10 +523,12 @@ const (
 	BlobStorageType_UNSPECIFIED BlobStorageType = 0
 	BlobStorageType_S3          BlobStorageType = 1
 	BlobStorageType_GCS         BlobStorageType = 2
+	BlobStorageType_FILESYSTEM  BlobStorageType = 3
 
 	MetaStorageType_UNSPECIFIED MetaStorageType = 0
 	MetaStorageType_DYNAMODB    MetaStorageType = 1
 	MetaStorageType_FIRESTORE   MetaStorageType = 2
+	MetaStorageType_POSTGRES    MetaStorageType = 3
 
 	DLQType_UNSPECIFIED DLQType = 0
 	DLQType_SQS         DLQType = 1
@@ -136,6 +136,7 @@ func TestDerivedConfigValues(t *testing.T) {
 			VersionedEventTable:           fmt.Sprintf("example_chainstorage_versioned_block_events_%v", configName),
 			VersionedEventTableBlockIndex: fmt.Sprintf("example_chainstorage_versioned_block_events_by_block_id_%v", configName),
 			TransactionTable:              cfg.AWS.DynamoDB.TransactionTable,
+			AddressTable:                  cfg.AWS.DynamoDB.AddressTable,
 			// Skip DynamoDB.Arn verification
 			Arn: "",
 		}
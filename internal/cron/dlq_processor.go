@@ -146,13 +146,20 @@ func (t *dlqProcessorTask) processFailedTransactionTrace(ctx context.Context, me
 	objectKey := metadata.ObjectKeyMain
 
 	compression := storage_utils.GetCompressionType(objectKey)
-	_, err = t.blobStorage.Upload(ctx, block, compression)
+	_, err = t.blobStorage.Upload(ctx, block, compression, blobstorage.WithDeduplication())
 	if err != nil {
 		return xerrors.Errorf("failed to upload to blob store with compression type %v: %w", compression.String(), err)
 	}
 
 	// Note that the block is already persisted in meta storage.
-	// Since the S3 object key stays the same, we don't need to persist the block in meta storage again.
+	// Since the S3 object key stays the same, we don't need to persist the block in meta storage again,
+	// unless the checksum of the re-uploaded payload has changed.
+	if block.Metadata.Checksum != metadata.Checksum {
+		metadata.Checksum = block.Metadata.Checksum
+		if err := t.metaStorage.PersistBlockMetas(ctx, false, []*api.BlockMetadata{metadata}, nil); err != nil {
+			return xerrors.Errorf("failed to update checksum in meta storage: %w", err)
+		}
+	}
 
 	t.logger.Info("processed message from failed_transaction_trace topic", zap.Reflect("msg", message))
 	return nil
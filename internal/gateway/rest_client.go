@@ -165,6 +165,18 @@ func (c *restClient) StreamChainEvents(ctx context.Context, request *api.ChainEv
 	return nil, xerrors.Errorf("streaming is not supported under restful mode: %w", ErrNotImplemented)
 }
 
+func (c *restClient) StreamFilteredTransactions(ctx context.Context, request *api.StreamFilteredTransactionsRequest, _ ...grpc.CallOption) (api.ChainStorage_StreamFilteredTransactionsClient, error) {
+	return nil, xerrors.Errorf("streaming is not supported under restful mode: %w", ErrNotImplemented)
+}
+
+func (c *restClient) StreamRawBlocksByRange(ctx context.Context, request *api.StreamRawBlocksByRangeRequest, _ ...grpc.CallOption) (api.ChainStorage_StreamRawBlocksByRangeClient, error) {
+	return nil, xerrors.Errorf("streaming is not supported under restful mode: %w", ErrNotImplemented)
+}
+
+func (c *restClient) StreamNativeBlocksByRange(ctx context.Context, request *api.StreamNativeBlocksByRangeRequest, _ ...grpc.CallOption) (api.ChainStorage_StreamNativeBlocksByRangeClient, error) {
+	return nil, xerrors.Errorf("streaming is not supported under restful mode: %w", ErrNotImplemented)
+}
+
 func (c *restClient) GetChainEvents(ctx context.Context, request *api.GetChainEventsRequest, _ ...grpc.CallOption) (*api.GetChainEventsResponse, error) {
 	var response api.GetChainEventsResponse
 	if err := c.makeRequest(ctx, "GetChainEvents", request, &response); err != nil {
@@ -219,6 +231,42 @@ func (c *restClient) GetVerifiedAccountState(ctx context.Context, in *api.GetVer
 	return &response, nil
 }
 
+func (c *restClient) GetTransactionsByAddress(ctx context.Context, in *api.GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*api.GetTransactionsByAddressResponse, error) {
+	var response api.GetTransactionsByAddressResponse
+	if err := c.makeRequest(ctx, "GetTransactionsByAddress", in, &response); err != nil {
+		return nil, xerrors.Errorf("failed to make request: %w", err)
+	}
+
+	return &response, nil
+}
+
+func (c *restClient) GetBlockByTimestamp(ctx context.Context, in *api.GetBlockByTimestampRequest, opts ...grpc.CallOption) (*api.GetBlockByTimestampResponse, error) {
+	var response api.GetBlockByTimestampResponse
+	if err := c.makeRequest(ctx, "GetBlockByTimestamp", in, &response); err != nil {
+		return nil, xerrors.Errorf("failed to make request: %w", err)
+	}
+
+	return &response, nil
+}
+
+func (c *restClient) GetCanonicalBlockAsOfEvent(ctx context.Context, in *api.GetCanonicalBlockAsOfEventRequest, opts ...grpc.CallOption) (*api.GetCanonicalBlockAsOfEventResponse, error) {
+	var response api.GetCanonicalBlockAsOfEventResponse
+	if err := c.makeRequest(ctx, "GetCanonicalBlockAsOfEvent", in, &response); err != nil {
+		return nil, xerrors.Errorf("failed to make request: %w", err)
+	}
+
+	return &response, nil
+}
+
+func (c *restClient) GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, in *api.GetCanonicalBlocksByRangeAsOfEventRequest, opts ...grpc.CallOption) (*api.GetCanonicalBlocksByRangeAsOfEventResponse, error) {
+	var response api.GetCanonicalBlocksByRangeAsOfEventResponse
+	if err := c.makeRequest(ctx, "GetCanonicalBlocksByRangeAsOfEvent", in, &response); err != nil {
+		return nil, xerrors.Errorf("failed to make request: %w", err)
+	}
+
+	return &response, nil
+}
+
 func (c *restClient) makeRequest(ctx context.Context, method string, request proto.Message, response proto.Message) error {
 	return c.retry.Retry(ctx, func(ctx context.Context) error {
 		marshaler := protojson.MarshalOptions{}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/storage/blobstorage/filesystem"
+	"github.com/coinbase/chainstorage/sdk/services"
+)
+
+const (
+	blobServerReadHeaderTimeout = 10 * time.Second
+)
+
+// daemonizeBlobServer starts the http server serving the presigned urls of the filesystem blob storage.
+// It is a no-op for the other blob storage types, whose presigned urls are served by the cloud providers.
+func daemonizeBlobServer(
+	manager services.SystemManager,
+	cfg *config.Config,
+) error {
+	if cfg.StorageType.BlobStorageType != config.BlobStorageType_FILESYSTEM {
+		return nil
+	}
+
+	bindAddress := cfg.Server.BlobBindAddress
+	if bindAddress == "" {
+		return xerrors.New("blob bind address is required by filesystem blob storage")
+	}
+
+	handler, err := filesystem.NewHandler(cfg)
+	if err != nil {
+		return xerrors.Errorf("failed to create blob handler: %w", err)
+	}
+
+	hs := &http.Server{
+		Addr:              bindAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: blobServerReadHeaderTimeout,
+	}
+	runBlobServer := func(ctx context.Context) (services.ShutdownFunction, chan error) {
+		return startBlobServer(manager.Logger(), hs)
+	}
+	manager.ServiceWaitGroup().Add(1)
+	go func() {
+		defer manager.ServiceWaitGroup().Done()
+		services.Daemonize(manager, runBlobServer, "Blob Server")
+	}()
+	return nil
+}
+
+func startBlobServer(
+	logger *zap.Logger,
+	hs *http.Server,
+) (services.ShutdownFunction, chan error) {
+	errorChannel := make(chan error)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("Recovered from panic in blob server", zap.Any("panic", r), zap.Stack("stack"))
+			}
+		}()
+		logger.Info("Listening", zap.String("blobBindAddress", hs.Addr))
+		if err := hs.ListenAndServe(); err != nil && !xerrors.Is(err, http.ErrServerClosed) {
+			logger.Error("Failed to serve", zap.Error(err))
+			errorChannel <- err
+			return
+		}
+	}()
+	return func(ctx context.Context) error {
+		err := hs.Shutdown(ctx)
+		<-done
+		return err
+	}, errorChannel
+}
@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"regexp"
@@ -97,6 +99,18 @@ type (
 		GetHash() string
 	}
 
+	blockResult[T any] struct {
+		block T
+		err   error
+	}
+
+	// addressPageToken is the position of the last address transaction returned by GetTransactionsByAddress.
+	addressPageToken struct {
+		BlockNumber     uint64 `json:"block_number"`
+		TransactionHash string `json:"transaction_hash"`
+		BlockHash       string `json:"block_hash"`
+	}
+
 	parseChainEventsRequestInput interface {
 		// Deprecated: Use GetSequenceNum instead.
 		GetSequence() string
@@ -159,6 +173,8 @@ const (
 	streamingBackoffMultiplier          = 1.5
 	streamingBackoffRandomizationFactor = 0.5
 	streamingBackoffStop                = backoff.Stop
+
+	timestampSearchBatchSize = 10
 )
 
 var (
@@ -182,14 +198,17 @@ var registerServerError error
 // Each request consumes 1 RCU unless it is explicitly defined below.
 // When the total RCUs exceed the rate limit, the request would be rejected.
 var rcuByMethod = map[string]int{
-	"GetRawBlock":             10,
-	"GetRawBlocksByRange":     50,
-	"GetNativeBlock":          10,
-	"GetNativeBlocksByRange":  50,
-	"GetRosettaBlock":         10,
-	"GetRosettaBlocksByRange": 50,
-	"GetNativeTransaction":    10,
-	"GetVerifiedAccountState": 10,
+	"GetRawBlock":                        10,
+	"GetRawBlocksByRange":                50,
+	"GetNativeBlock":                     10,
+	"GetNativeBlocksByRange":             50,
+	"GetRosettaBlock":                    10,
+	"GetRosettaBlocksByRange":            50,
+	"GetNativeTransaction":               10,
+	"GetVerifiedAccountState":            10,
+	"GetBlockByTimestamp":                10,
+	"StreamFilteredTransactions":         10,
+	"GetCanonicalBlocksByRangeAsOfEvent": 10,
 }
 
 func NewServer(params ServerParams) *Server {
@@ -255,6 +274,10 @@ func Register(params RegisterParams) error {
 		api.RegisterChainStorageServer(gs, server)
 		reflection.Register(gs)
 		daemonizeServer(manager, gs, config)
+
+		if err := daemonizeBlobServer(manager, config); err != nil {
+			registerServerError = xerrors.Errorf("failed to start blob server: %w", err)
+		}
 	})
 
 	return registerServerError
@@ -484,6 +507,178 @@ func (s *Server) GetNativeBlocksByRange(ctx context.Context, req *api.GetNativeB
 	}, nil
 }
 
+func (s *Server) StreamRawBlocksByRange(req *api.StreamRawBlocksByRangeRequest, stream api.ChainStorage_StreamRawBlocksByRangeServer) error {
+	ctx := stream.Context()
+	clientID := getClientID(ctx)
+
+	return streamBlocksByRange(
+		ctx, s, req,
+		func(ctx context.Context, rawBlock *api.Block) (*api.Block, error) {
+			return rawBlock, nil
+		},
+		func(block *api.Block) error {
+			// Each block is charged as much as a GetRawBlock request.
+			if err := s.waitForRCU(ctx, clientID, s.getRCUByMethod("GetRawBlock")); err != nil {
+				return err
+			}
+
+			if err := stream.Send(&api.StreamRawBlocksByRangeResponse{Block: block}); err != nil {
+				return xerrors.Errorf("failed to stream block to client: %w", err)
+			}
+
+			s.emitBlocksMetric(formatRaw, clientID, 1)
+			return nil
+		},
+	)
+}
+
+func (s *Server) StreamNativeBlocksByRange(req *api.StreamNativeBlocksByRangeRequest, stream api.ChainStorage_StreamNativeBlocksByRangeServer) error {
+	ctx := stream.Context()
+	clientID := getClientID(ctx)
+
+	return streamBlocksByRange(
+		ctx, s, req,
+		func(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+			nativeBlock, err := s.parser.ParseNativeBlock(ctx, rawBlock)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse block: %w", err)
+			}
+
+			return nativeBlock, nil
+		},
+		func(block *api.NativeBlock) error {
+			// Each block is charged as much as a GetNativeBlock request.
+			if err := s.waitForRCU(ctx, clientID, s.getRCUByMethod("GetNativeBlock")); err != nil {
+				return err
+			}
+
+			if err := stream.Send(&api.StreamNativeBlocksByRangeResponse{Block: block}); err != nil {
+				return xerrors.Errorf("failed to stream block to client: %w", err)
+			}
+
+			s.emitBlocksMetric(formatNative, clientID, 1)
+			return nil
+		},
+	)
+}
+
+// streamBlocksByRange downloads the blocks in [req.StartHeight, req.EndHeight),
+// converts them using `transform`, and passes them to `send` in height order.
+// The blocks are fetched by Api.NumWorkers goroutines ahead of the block being sent.
+// To bound the memory usage, the prefetching pauses when `send` falls behind,
+// e.g. when stream.Send is blocked by the flow control of a slow client.
+func streamBlocksByRange[T any](
+	ctx context.Context,
+	s *Server,
+	req requestByRange,
+	transform func(ctx context.Context, rawBlock *api.Block) (T, error),
+	send func(block T) error,
+) error {
+	tag := s.config.GetEffectiveBlockTag(req.GetTag())
+	startHeight := req.GetStartHeight()
+	endHeight := req.GetEndHeight()
+
+	if err := s.validateTag(tag); err != nil {
+		return err
+	}
+
+	if startHeight >= endHeight {
+		return status.Error(codes.InvalidArgument, "invalid range: start_height must be less than end_height")
+	}
+
+	latestBlock, err := s.metaStorage.GetLatestBlock(ctx, tag)
+	if err != nil {
+		return xerrors.Errorf("failed to get latest block: %w", err)
+	}
+
+	if latest := latestBlock.Height; endHeight-1 > latest {
+		return status.Errorf(codes.FailedPrecondition, "block end height exceeded latest watermark %d", latest)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each future is resolved by a goroutine fetching the corresponding block.
+	// The capacity of the channel limits the number of blocks being fetched or buffered.
+	futures := make(chan chan blockResult[T], s.config.Api.NumWorkers)
+	go func() {
+		defer close(futures)
+
+		batchSize := s.config.Api.MaxNumBlocks
+		for batchStartHeight := startHeight; batchStartHeight < endHeight; batchStartHeight += batchSize {
+			batchEndHeight := batchStartHeight + batchSize
+			if batchEndHeight > endHeight {
+				batchEndHeight = endHeight
+			}
+
+			blocks, err := s.metaStorage.GetBlocksByHeightRange(ctx, tag, batchStartHeight, batchEndHeight)
+			if err != nil {
+				future := make(chan blockResult[T], 1)
+				future <- blockResult[T]{
+					err: xerrors.Errorf("failed to get blocks from meta storage [%v, %v): %w", batchStartHeight, batchEndHeight, err),
+				}
+				select {
+				case futures <- future:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, block := range blocks {
+				block := block
+				future := make(chan blockResult[T], 1)
+				select {
+				case futures <- future:
+				case <-ctx.Done():
+					return
+				}
+
+				go func() {
+					rawBlock, err := s.getBlockFromBlobStorage(ctx, block)
+					if err != nil {
+						future <- blockResult[T]{err: xerrors.Errorf("failed to get raw block: %w", err)}
+						return
+					}
+
+					output, err := transform(ctx, rawBlock)
+					future <- blockResult[T]{block: output, err: err}
+				}()
+			}
+		}
+	}()
+
+	for future := range futures {
+		result := <-future
+		if result.err != nil {
+			return xerrors.Errorf("failed to fetch block: %w", result.err)
+		}
+
+		if err := send(result.block); err != nil {
+			if code := status.Code(err); code == codes.Unavailable {
+				// The client's transport is closing. Close the stream now.
+				s.logger.Debug("client's transport is closing", zap.Error(err))
+				return nil
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForRCU blocks until the throttler permits the RCUs consumed by a streamed item.
+func (s *Server) waitForRCU(ctx context.Context, clientID string, rcu int) error {
+	if err := s.throttler.WaitN(ctx, clientID, rcu); err != nil {
+		if ctx.Err() != nil {
+			return xerrors.Errorf("failed to wait for rate limiter: %w", ctx.Err())
+		}
+
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	return nil
+}
+
 func (s *Server) GetRosettaBlock(ctx context.Context, req *api.GetRosettaBlockRequest) (*api.GetRosettaBlockResponse, error) {
 	// TODO: short-circuit fetching block from blob-storage if RosettaParser is not implemented for chain
 	clientID := getClientID(ctx)
@@ -653,6 +848,223 @@ func (s *Server) GetVerifiedAccountState(ctx context.Context, req *api.GetVerifi
 	}, nil
 }
 
+func (s *Server) GetTransactionsByAddress(ctx context.Context, req *api.GetTransactionsByAddressRequest) (*api.GetTransactionsByAddressResponse, error) {
+	if !s.config.Chain.Feature.AddressIndexing {
+		return nil, errNotImplemented
+	}
+
+	tag := s.config.GetEffectiveBlockTag(req.GetTag())
+	address := parser.NormalizeAddress(req.GetAddress())
+	startHeight := req.GetStartHeight()
+	endHeight := req.GetEndHeight()
+
+	if endHeight == 0 {
+		endHeight = startHeight + 1
+	}
+
+	if err := s.validateTag(tag); err != nil {
+		return nil, err
+	}
+
+	if address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	if err := s.validateBlockRange(startHeight, endHeight, s.config.Api.MaxNumAddressBlocks); err != nil {
+		return nil, err
+	}
+
+	limit := uint64(req.GetLimit())
+	if limit == 0 {
+		limit = s.config.Api.MaxNumAddressTxs
+	}
+	if limit > s.config.Api.MaxNumAddressTxs {
+		return nil, status.Errorf(codes.InvalidArgument, "limit exceeded maximum of %d", s.config.Api.MaxNumAddressTxs)
+	}
+
+	cursor, err := decodeAddressPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+
+	// One more transaction is requested to tell whether there is a next page.
+	addressTransactions, err := s.transactionStorage.GetTransactionsByAddress(ctx, tag, address, startHeight, endHeight, cursor, int(limit+1))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get transactions by address from transaction storage: %w", err)
+	}
+
+	var nextPageToken string
+	if uint64(len(addressTransactions)) > limit {
+		addressTransactions = addressTransactions[:limit]
+		nextPageToken, err = encodeAddressPageToken(addressTransactions[limit-1])
+		if err != nil {
+			return nil, xerrors.Errorf("failed to encode page token: %w", err)
+		}
+	}
+
+	results := make([]*api.AddressTransaction, 0, len(addressTransactions))
+	if len(addressTransactions) > 0 {
+		// use map to dedup in blockNums
+		blockNumberToMetadataMap := make(map[uint64]*api.BlockMetadata)
+		for _, tx := range addressTransactions {
+			blockNumberToMetadataMap[tx.BlockNumber] = nil
+		}
+
+		blockNums := maps.Keys(blockNumberToMetadataMap)
+		blocksMetadata, err := s.metaStorage.GetBlocksByHeights(ctx, tag, blockNums)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get blockMetadata for blocks=%v: %w", blockNums, err)
+		}
+
+		for _, blockMetadata := range blocksMetadata {
+			blockNumberToMetadataMap[blockMetadata.Height] = blockMetadata
+		}
+
+		for _, tx := range addressTransactions {
+			canonicalBlock := blockNumberToMetadataMap[tx.BlockNumber]
+			if canonicalBlock == nil || canonicalBlock.Hash != tx.BlockHash {
+				// tx.BlockHash got reorged
+				continue
+			}
+
+			results = append(results, &api.AddressTransaction{
+				TransactionHash: tx.TransactionHash,
+				Block: &api.BlockIdentifier{
+					Hash:      canonicalBlock.GetHash(),
+					Height:    canonicalBlock.GetHeight(),
+					Tag:       canonicalBlock.GetTag(),
+					Skipped:   canonicalBlock.GetSkipped(),
+					Timestamp: canonicalBlock.GetTimestamp(),
+				},
+			})
+		}
+	}
+
+	clientID := getClientID(ctx)
+	s.emitTransactionsMetric(formatRaw, clientID, int64(len(results)))
+
+	return &api.GetTransactionsByAddressResponse{
+		Transactions:  results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) GetBlockByTimestamp(ctx context.Context, req *api.GetBlockByTimestampRequest) (*api.GetBlockByTimestampResponse, error) {
+	tag := s.config.GetEffectiveBlockTag(req.GetTag())
+	if err := s.validateTag(tag); err != nil {
+		return nil, err
+	}
+
+	if req.GetTimestamp() == nil {
+		return nil, status.Error(codes.InvalidArgument, "timestamp is required")
+	}
+
+	if err := req.GetTimestamp().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp: %v", err)
+	}
+
+	target := req.GetTimestamp().AsTime()
+	var match func(block *api.BlockMetadata) bool
+	switch req.GetMode() {
+	case api.GetBlockByTimestampRequest_AT_OR_BEFORE:
+		match = func(block *api.BlockMetadata) bool {
+			return !block.GetTimestamp().AsTime().After(target)
+		}
+	case api.GetBlockByTimestampRequest_AT_OR_AFTER:
+		match = func(block *api.BlockMetadata) bool {
+			return !block.GetTimestamp().AsTime().Before(target)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported mode: %v", req.GetMode())
+	}
+
+	latestBlock, err := s.metaStorage.GetLatestBlock(ctx, tag)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get latest block: %w", err)
+	}
+
+	// Binary search over the canonical chain, assuming the block timestamps are non-decreasing.
+	// The predicate `match` is monotonic: for AT_OR_BEFORE it holds for a prefix of the chain;
+	// for AT_OR_AFTER it holds for a suffix of the chain.
+	// Skipped blocks do not have a timestamp, so each probe lands on the first non-skipped block at or after `mid`.
+	var result *api.BlockMetadata
+	startHeight := s.config.Chain.BlockStartHeight
+	endHeight := latestBlock.Height + 1
+	for startHeight < endHeight {
+		mid := startHeight + (endHeight-startHeight)/2
+		block, err := s.getFirstNonSkippedBlock(ctx, tag, mid, endHeight)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get first non-skipped block (tag=%v, height=%v): %w", tag, mid, err)
+		}
+
+		if block == nil {
+			// Every block in [mid, endHeight) is skipped.
+			endHeight = mid
+			continue
+		}
+
+		isMatch := match(block)
+		if isMatch {
+			result = block
+		}
+
+		if isMatch == (req.GetMode() == api.GetBlockByTimestampRequest_AT_OR_BEFORE) {
+			// Look for a later match (AT_OR_BEFORE) or skip the blocks preceding the target (AT_OR_AFTER).
+			startHeight = block.Height + 1
+		} else {
+			endHeight = mid
+		}
+	}
+
+	if result == nil {
+		return nil, xerrors.Errorf("no block found %v %v: %w", req.GetMode(), target, storage.ErrItemNotFound)
+	}
+
+	return &api.GetBlockByTimestampResponse{
+		Block: &api.BlockIdentifier{
+			Hash:      result.GetHash(),
+			Height:    result.GetHeight(),
+			Tag:       result.GetTag(),
+			Skipped:   result.GetSkipped(),
+			Timestamp: result.GetTimestamp(),
+		},
+	}, nil
+}
+
+// getFirstNonSkippedBlock returns the first non-skipped block in [startHeight, endHeight),
+// or nil if every block in the range is skipped.
+func (s *Server) getFirstNonSkippedBlock(ctx context.Context, tag uint32, startHeight uint64, endHeight uint64) (*api.BlockMetadata, error) {
+	block, err := s.metaStorage.GetBlockByHeight(ctx, tag, startHeight)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block by height: %w", err)
+	}
+
+	if !block.Skipped {
+		return block, nil
+	}
+
+	// Skipped blocks usually come in short runs, so scan the following blocks in small batches.
+	for height := startHeight + 1; height < endHeight; height += timestampSearchBatchSize {
+		batchEndHeight := height + timestampSearchBatchSize
+		if batchEndHeight > endHeight {
+			batchEndHeight = endHeight
+		}
+
+		blocks, err := s.metaStorage.GetBlocksByHeightRange(ctx, tag, height, batchEndHeight)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get blocks by height range [%v, %v): %w", height, batchEndHeight, err)
+		}
+
+		for _, block := range blocks {
+			if !block.Skipped {
+				return block, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
 // getBlocksFromTransactionStorage returns the blocks associated with the transaction.
 // If the transaction is not found, storage.ErrItemNotFound is returned.
 func (s *Server) getBlocksFromTransactionStorage(ctx context.Context, tag uint32, transactionHash string) ([]*api.BlockMetadata, error) {
@@ -726,6 +1138,7 @@ func (s *Server) newBlockFile(block *api.BlockMetadata) (*api.BlockFile, error)
 		ParentHeight: block.ParentHeight,
 		FileUrl:      fileUrl,
 		Compression:  compression,
+		Checksum:     block.Checksum,
 	}, nil
 }
 
@@ -737,6 +1150,47 @@ func (s *Server) validateTag(tag uint32) error {
 	return nil
 }
 
+// encodeAddressPageToken returns an opaque token which resumes the listing right after the address transaction.
+func encodeAddressPageToken(addressTransaction *model.AddressTransaction) (string, error) {
+	data, err := json.Marshal(&addressPageToken{
+		BlockNumber:     addressTransaction.BlockNumber,
+		TransactionHash: addressTransaction.TransactionHash,
+		BlockHash:       addressTransaction.BlockHash,
+	})
+	if err != nil {
+		return "", xerrors.Errorf("failed to marshal page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeAddressPageToken returns the cursor encoded by encodeAddressPageToken, or nil if the token is empty.
+func decodeAddressPageToken(token string) (*model.AddressTransaction, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode page token: %w", err)
+	}
+
+	var pageToken addressPageToken
+	if err := json.Unmarshal(data, &pageToken); err != nil {
+		return nil, xerrors.Errorf("failed to unmarshal page token: %w", err)
+	}
+
+	if pageToken.TransactionHash == "" || pageToken.BlockHash == "" {
+		return nil, xerrors.New("page token is incomplete")
+	}
+
+	return &model.AddressTransaction{
+		BlockNumber:     pageToken.BlockNumber,
+		TransactionHash: pageToken.TransactionHash,
+		BlockHash:       pageToken.BlockHash,
+	}, nil
+}
+
 func (s *Server) validateBlockRange(startHeight uint64, endHeight uint64, maxNumBlocks uint64) error {
 	if startHeight >= endHeight {
 		return status.Error(codes.InvalidArgument, "invalid range: start_height must be less than end_height")
@@ -1137,22 +1591,8 @@ func (s *Server) StreamChainEvents(request *api.ChainEventsRequest, stream api.C
 		}
 
 		for _, e := range events {
-			event := &api.BlockchainEvent{
-				Sequence:    encodeEventIdToSequence(e.EventId),
-				SequenceNum: e.EventId,
-				Type:        e.EventType,
-				Block: &api.BlockIdentifier{
-					Tag:       e.Tag,
-					Hash:      e.BlockHash,
-					Height:    e.BlockHeight,
-					Skipped:   e.BlockSkipped,
-					Timestamp: utils.ToTimestamp(e.BlockTimestamp),
-				},
-				EventTag: e.EventTag,
-			}
-
 			res := &api.ChainEventsResponse{
-				Event: event,
+				Event: newBlockchainEvent(e),
 			}
 			if err := stream.Send(res); err != nil {
 				if code := status.Code(err); code == codes.Unavailable {
@@ -1185,6 +1625,183 @@ func (s *Server) StreamChainEvents(request *api.ChainEventsRequest, stream api.C
 	}
 }
 
+func (s *Server) StreamFilteredTransactions(request *api.StreamFilteredTransactionsRequest, stream api.ChainStorage_StreamFilteredTransactionsServer) error {
+	ctx := stream.Context()
+	clientID := getClientID(ctx)
+
+	// Fail fast instead of erroring out on the first block, after the stream has been established.
+	if !parser.SupportsTransactionFiltering(s.config) {
+		return status.Errorf(codes.Unimplemented, "transaction filtering is not supported for %v", s.config.Chain.Blockchain)
+	}
+
+	filters := request.GetFilters()
+	if err := validateTransactionFilters(filters); err != nil {
+		return err
+	}
+
+	eventTag := request.EventTag
+	if s.config.Chain.Feature.DefaultStableEvent {
+		eventTag = s.config.GetEffectiveEventTag(request.EventTag)
+	}
+
+	lastSentEventId, err := s.parseChainEventsRequest(ctx, &api.ChainEventsRequest{
+		InitialPositionInStream: request.InitialPositionInStream,
+		EventTag:                eventTag,
+		SequenceNum:             request.SequenceNum,
+	}, eventTag)
+	if err != nil {
+		return xerrors.Errorf("failed to parse chain events request: %w", err)
+	}
+
+	tick := time.NewTicker(s.config.Api.StreamingInterval)
+	defer tick.Stop()
+
+	backoff := s.newStreamingBackoff()
+	for {
+		events, err := s.metaStorage.GetEventsAfterEventId(ctx, eventTag, lastSentEventId, s.config.Api.StreamingBatchSize)
+		if err != nil {
+			return xerrors.Errorf("failed to retrieve events: %w", err)
+		}
+
+		if len(events) > 0 {
+			backoff.Reset()
+			tick.Reset(streamingShortWaitTime)
+		} else {
+			waitTime := backoff.NextBackOff()
+			if waitTime == streamingBackoffStop {
+				return xerrors.Errorf("max wait time exceeded: %w", errNoNewEventForTooLong)
+			}
+			tick.Reset(waitTime)
+		}
+
+		transactions, err := s.filterTransactions(ctx, events, filters)
+		if err != nil {
+			return xerrors.Errorf("failed to filter transactions: %w", err)
+		}
+
+		for i, e := range events {
+			if e.EventType == api.BlockchainEvent_BLOCK_ADDED && len(transactions[i]) == 0 {
+				// Nothing to stream for this block.
+				lastSentEventId = e.EventId
+				continue
+			}
+
+			res := &api.StreamFilteredTransactionsResponse{
+				Event:        newBlockchainEvent(e),
+				Transactions: transactions[i],
+			}
+			if err := stream.Send(res); err != nil {
+				if code := status.Code(err); code == codes.Unavailable {
+					// The client's transport is closing. Close the stream now.
+					s.logger.Debug("client's transport is closing", zap.Error(err))
+					return nil
+				}
+				return xerrors.Errorf("failed to stream transactions to client: %w", err)
+			}
+
+			eventTagString := strconv.Itoa(int(e.EventTag))
+			if e.EventType == api.BlockchainEvent_BLOCK_ADDED {
+				s.emitEventsMetric(eventTypeBlockAdded, clientID, eventTagString, 1)
+				s.emitTransactionsMetric(formatNative, clientID, int64(len(transactions[i])))
+			} else if e.EventType == api.BlockchainEvent_BLOCK_REMOVED {
+				s.emitEventsMetric(eventTypeBlockRemoved, clientID, eventTagString, 1)
+			}
+
+			lastSentEventId = e.EventId
+		}
+
+		select {
+		case <-tick.C:
+		case <-s.streamDone:
+			return xerrors.Errorf("server is being redeployed: %w", errServerShutDown)
+		case <-ctx.Done():
+			// The client is canceled. Close the stream now.
+			s.logger.Debug("client is canceled", zap.Error(err))
+			return nil
+		}
+	}
+}
+
+// filterTransactions parses the blocks added by the events and returns the matching transactions of each event.
+// The blocks are processed in parallel. No transaction is returned for BLOCK_REMOVED events or skipped blocks.
+func (s *Server) filterTransactions(ctx context.Context, events []*model.EventEntry, filters []*api.TransactionFilter) ([][]*api.NativeTransaction, error) {
+	result := make([][]*api.NativeTransaction, len(events))
+	group, ctx := syncgroup.New(ctx, syncgroup.WithThrottling(int(s.config.Api.NumWorkers)))
+	for i := range events {
+		i := i
+		event := events[i]
+		if event.EventType != api.BlockchainEvent_BLOCK_ADDED || event.BlockSkipped {
+			continue
+		}
+
+		group.Go(func() error {
+			block, err := s.metaStorage.GetBlockByHash(ctx, event.Tag, event.BlockHeight, event.BlockHash)
+			if err != nil {
+				return xerrors.Errorf("failed to get block by hash (tag=%v, height=%v, hash=%v): %w", event.Tag, event.BlockHeight, event.BlockHash, err)
+			}
+
+			rawBlock, err := s.getBlockFromBlobStorage(ctx, block)
+			if err != nil {
+				return xerrors.Errorf("failed to get raw block: %w", err)
+			}
+
+			nativeBlock, err := s.parser.ParseNativeBlock(ctx, rawBlock)
+			if err != nil {
+				return xerrors.Errorf("failed to parse block (height=%v, hash=%v): %w", event.BlockHeight, event.BlockHash, err)
+			}
+
+			transactions, err := parser.FilterTransactions(nativeBlock, filters)
+			if err != nil {
+				return xerrors.Errorf("failed to filter transactions (height=%v, hash=%v): %w", event.BlockHeight, event.BlockHash, err)
+			}
+
+			result[i] = transactions
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, xerrors.Errorf("failed to filter transactions of blocks: %w", err)
+	}
+
+	return result, nil
+}
+
+func validateTransactionFilters(filters []*api.TransactionFilter) error {
+	if len(filters) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one filter is required")
+	}
+
+	for i, filter := range filters {
+		if len(filter.GetContractAddresses()) == 0 &&
+			len(filter.GetTopics()) == 0 &&
+			len(filter.GetFromAddresses()) == 0 &&
+			len(filter.GetToAddresses()) == 0 &&
+			len(filter.GetProgramIds()) == 0 &&
+			len(filter.GetBitcoinAddresses()) == 0 {
+			return status.Errorf(codes.InvalidArgument, "filter %d does not have any criteria", i)
+		}
+	}
+
+	return nil
+}
+
+func newBlockchainEvent(e *model.EventEntry) *api.BlockchainEvent {
+	return &api.BlockchainEvent{
+		Sequence:    encodeEventIdToSequence(e.EventId),
+		SequenceNum: e.EventId,
+		Type:        e.EventType,
+		Block: &api.BlockIdentifier{
+			Tag:       e.Tag,
+			Hash:      e.BlockHash,
+			Height:    e.BlockHeight,
+			Skipped:   e.BlockSkipped,
+			Timestamp: utils.ToTimestamp(e.BlockTimestamp),
+		},
+		EventTag: e.EventTag,
+	}
+}
+
 func (s *Server) newStreamingBackoff() backoff.BackOff {
 	b := &backoff.ExponentialBackOff{
 		InitialInterval:     s.config.Api.StreamingInterval,
@@ -1220,19 +1837,7 @@ func (s *Server) GetChainEvents(ctx context.Context, req *api.GetChainEventsRequ
 	blockchainEvents := make([]*api.BlockchainEvent, 0, len(events))
 	var numBlockAddedEvents, numBlockRemovedEvents int64
 	for _, e := range events {
-		blockchainEvents = append(blockchainEvents, &api.BlockchainEvent{
-			Sequence:    encodeEventIdToSequence(e.EventId),
-			SequenceNum: e.EventId,
-			Type:        e.EventType,
-			Block: &api.BlockIdentifier{
-				Tag:       e.Tag,
-				Hash:      e.BlockHash,
-				Height:    e.BlockHeight,
-				Skipped:   e.BlockSkipped,
-				Timestamp: utils.ToTimestamp(e.BlockTimestamp),
-			},
-			EventTag: e.EventTag,
-		})
+		blockchainEvents = append(blockchainEvents, newBlockchainEvent(e))
 
 		if e.EventType == api.BlockchainEvent_BLOCK_ADDED {
 			numBlockAddedEvents += 1
@@ -1372,6 +1977,111 @@ func (s *Server) GetVersionedChainEvent(ctx context.Context, req *api.GetVersion
 	}, nil
 }
 
+func (s *Server) GetCanonicalBlockAsOfEvent(ctx context.Context, req *api.GetCanonicalBlockAsOfEventRequest) (*api.GetCanonicalBlockAsOfEventResponse, error) {
+	eventTag, err := s.validateSequenceNum(ctx, req.GetEventTag(), req.GetSequenceNum())
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := s.getCanonicalBlockAsOfEvent(ctx, eventTag, req.GetSequenceNum(), req.GetHeight())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get canonical block as of event: %w", err)
+	}
+
+	return &api.GetCanonicalBlockAsOfEventResponse{
+		Block: block,
+	}, nil
+}
+
+func (s *Server) GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, req *api.GetCanonicalBlocksByRangeAsOfEventRequest) (*api.GetCanonicalBlocksByRangeAsOfEventResponse, error) {
+	startHeight := req.GetStartHeight()
+	endHeight := req.GetEndHeight()
+	if err := s.validateBlockRange(startHeight, endHeight, s.config.Api.MaxNumBlocks); err != nil {
+		return nil, err
+	}
+
+	eventTag, err := s.validateSequenceNum(ctx, req.GetEventTag(), req.GetSequenceNum())
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*api.BlockIdentifier, endHeight-startHeight)
+	group, ctx := syncgroup.New(ctx, syncgroup.WithThrottling(int(s.config.Api.NumWorkers)))
+	for i := range blocks {
+		i := i
+		group.Go(func() error {
+			block, err := s.getCanonicalBlockAsOfEvent(ctx, eventTag, req.GetSequenceNum(), startHeight+uint64(i))
+			if err != nil {
+				return err
+			}
+
+			blocks[i] = block
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, xerrors.Errorf("failed to get canonical blocks as of event: %w", err)
+	}
+
+	return &api.GetCanonicalBlocksByRangeAsOfEventResponse{
+		Blocks: blocks,
+	}, nil
+}
+
+// validateSequenceNum returns the effective event tag after making sure the event identified by sequenceNum exists.
+// Events beyond the latest one are rejected, because the result would not be reproducible once more events are added.
+func (s *Server) validateSequenceNum(ctx context.Context, eventTag uint32, sequenceNum int64) (uint32, error) {
+	if s.config.Chain.Feature.DefaultStableEvent {
+		eventTag = s.config.GetEffectiveEventTag(eventTag)
+	}
+
+	if sequenceNum < metastorage.EventIdStartValue {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid sequence_num: must be at least %v", metastorage.EventIdStartValue)
+	}
+
+	maxEventId, err := s.metaStorage.GetMaxEventId(ctx, eventTag)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to get max event id for eventTag=%v: %w", eventTag, err)
+	}
+
+	if sequenceNum > maxEventId {
+		return 0, status.Errorf(codes.FailedPrecondition, "sequence_num is beyond the latest event: latest sequence_num is %v", maxEventId)
+	}
+
+	return eventTag, nil
+}
+
+// getCanonicalBlockAsOfEvent replays the events of the given height up to and including eventId.
+// The last of these events determines the state of the height at that point:
+// BLOCK_ADDED means the block was on the canonical chain,
+// while BLOCK_REMOVED means the chain had been rolled back below the height.
+func (s *Server) getCanonicalBlockAsOfEvent(ctx context.Context, eventTag uint32, eventId int64, height uint64) (*api.BlockIdentifier, error) {
+	events, err := s.metaStorage.GetEventsByBlockHeight(ctx, eventTag, height)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get events for eventTag=%v, height=%v: %w", eventTag, height, err)
+	}
+
+	var lastEvent *model.EventEntry
+	for _, event := range events {
+		if event.EventId <= eventId && (lastEvent == nil || event.EventId > lastEvent.EventId) {
+			lastEvent = event
+		}
+	}
+
+	if lastEvent == nil || lastEvent.EventType != api.BlockchainEvent_BLOCK_ADDED {
+		return nil, xerrors.Errorf("no canonical block at height %v as of sequence_num %v: %w", height, eventId, storage.ErrItemNotFound)
+	}
+
+	return &api.BlockIdentifier{
+		Tag:       lastEvent.Tag,
+		Hash:      lastEvent.BlockHash,
+		Height:    lastEvent.BlockHeight,
+		Skipped:   lastEvent.BlockSkipped,
+		Timestamp: utils.ToTimestamp(lastEvent.BlockTimestamp),
+	}, nil
+}
+
 func (s *Server) onStart(ctx context.Context) error {
 	s.logger.Info(
 		"starting server",
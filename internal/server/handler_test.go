@@ -22,6 +22,7 @@ import (
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/coinbase/chainstorage/internal/blockchain/client"
 	clientmocks "github.com/coinbase/chainstorage/internal/blockchain/client/mocks"
@@ -128,6 +129,7 @@ func (s *handlerTestSuite) SetupTest() {
 	s.tagForTestEvents = 1
 	s.eventTagForTestEvents = s.config.Chain.EventTag.Stable
 	s.config.Chain.Feature.TransactionIndexing = true
+	s.config.Chain.Feature.AddressIndexing = true
 	s.config.Chain.Feature.VerifiedAccountStateEnabled = true
 }
 
@@ -263,6 +265,7 @@ func (s *handlerTestSuite) TestGetBlockFile_Gzip() {
 		hash                 = "0xda5a0439434adf072394e0b94f78e56032c5409a2c58668995f306b171ff4ace"
 		parentHash           = "0xba6a6c85739b50384625e10718524fb2c1fcf88858eabf6db9bd851902b53546"
 		objectKeyMain        = "foo/bar.gzip"
+		checksum             = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
 	)
 
 	require := testutil.Require(s.T())
@@ -274,6 +277,7 @@ func (s *handlerTestSuite) TestGetBlockFile_Gzip() {
 		Height:      height,
 		FileUrl:     "http://endpoint/foo/bar.gzip",
 		Compression: api.Compression_GZIP,
+		Checksum:    checksum,
 	}
 	gomock.InOrder(
 		s.metaStorage.EXPECT().GetBlockByHash(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
@@ -287,6 +291,7 @@ func (s *handlerTestSuite) TestGetBlockFile_Gzip() {
 					ParentHash:    parentHash,
 					Height:        height,
 					ObjectKeyMain: objectKeyMain,
+					Checksum:      checksum,
 				}, nil
 			},
 		),
@@ -976,7 +981,7 @@ func (s *handlerTestSuite) TestGetRosettaBlock_NotImplemented() {
 	var server *Server
 	app := testapp.New(
 		s.T(),
-		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_AVACCHAIN, common.Network_NETWORK_AVACCHAIN_MAINNET), // AVACCHAIN RosettaParser is not implemented
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_ETHEREUM, common.Network_NETWORK_ETHEREUM_HOLESKY), // Holesky RosettaParser is not implemented
 		parser.Module,
 		fx.Provide(func() metastorage.MetaStorage { return s.metaStorage }),
 		fx.Provide(func() blobstorage.BlobStorage { return s.blobStorage }),
@@ -1091,7 +1096,7 @@ func (s *handlerTestSuite) TestGetRosettaBlocksByRange_NotImplemented() {
 	var server *Server
 	app := testapp.New(
 		s.T(),
-		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_AVACCHAIN, common.Network_NETWORK_AVACCHAIN_MAINNET), // Avacchain RosettaParser is not implemented
+		testapp.WithBlockchainNetwork(common.Blockchain_BLOCKCHAIN_ETHEREUM, common.Network_NETWORK_ETHEREUM_HOLESKY), // Holesky RosettaParser is not implemented
 		parser.Module,
 		fx.Provide(func() metastorage.MetaStorage { return s.metaStorage }),
 		fx.Provide(func() blobstorage.BlobStorage { return s.blobStorage }),
@@ -1822,6 +1827,293 @@ func (m *mockStreamChainEventsServer) Context() context.Context {
 	return m.ctx
 }
 
+type mockStreamRawBlocksByRangeServer struct {
+	api.ChainStorage_StreamRawBlocksByRangeServer
+	blocks []*api.Block
+	ctx    context.Context
+}
+
+func (m *mockStreamRawBlocksByRangeServer) Send(res *api.StreamRawBlocksByRangeResponse) error {
+	m.blocks = append(m.blocks, res.Block)
+	return nil
+}
+
+func (m *mockStreamRawBlocksByRangeServer) Context() context.Context {
+	return m.ctx
+}
+
+type mockStreamNativeBlocksByRangeServer struct {
+	api.ChainStorage_StreamNativeBlocksByRangeServer
+	blocks []*api.NativeBlock
+	ctx    context.Context
+}
+
+func (m *mockStreamNativeBlocksByRangeServer) Send(res *api.StreamNativeBlocksByRangeResponse) error {
+	m.blocks = append(m.blocks, res.Block)
+	return nil
+}
+
+func (m *mockStreamNativeBlocksByRangeServer) Context() context.Context {
+	return m.ctx
+}
+
+func (s *handlerTestSuite) TestStreamRawBlocksByRange() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+	s.setupStreamBlocksByRange(stableTag)
+
+	mockServer := &mockStreamRawBlocksByRangeServer{
+		ctx: context.Background(),
+	}
+	err := s.server.StreamRawBlocksByRange(&api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   125,
+	}, mockServer)
+	require.NoError(err)
+	require.Len(mockServer.blocks, 25)
+	for i, block := range mockServer.blocks {
+		require.Equal(uint64(100+i), block.Metadata.Height)
+		require.Equal(fmt.Sprintf("hash%d", 100+i), block.Metadata.Hash)
+	}
+}
+
+func (s *handlerTestSuite) TestStreamNativeBlocksByRange() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+	s.setupStreamBlocksByRange(stableTag)
+	s.parser.EXPECT().ParseNativeBlock(gomock.Any(), gomock.Any()).Times(25).
+		DoAndReturn(func(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+			return &api.NativeBlock{
+				Tag:    rawBlock.Metadata.Tag,
+				Height: rawBlock.Metadata.Height,
+				Hash:   rawBlock.Metadata.Hash,
+			}, nil
+		})
+
+	mockServer := &mockStreamNativeBlocksByRangeServer{
+		ctx: context.Background(),
+	}
+	err := s.server.StreamNativeBlocksByRange(&api.StreamNativeBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   125,
+	}, mockServer)
+	require.NoError(err)
+	require.Len(mockServer.blocks, 25)
+	for i, block := range mockServer.blocks {
+		require.Equal(uint64(100+i), block.Height)
+		require.Equal(fmt.Sprintf("hash%d", 100+i), block.Hash)
+	}
+}
+
+func (s *handlerTestSuite) TestStreamRawBlocksByRange_DownloadFailure() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+
+	s.metaStorage.EXPECT().GetLatestBlock(gomock.Any(), stableTag).Return(&api.BlockMetadata{Height: 200}, nil)
+	s.metaStorage.EXPECT().GetBlocksByHeightRange(gomock.Any(), stableTag, uint64(100), uint64(102)).
+		Return(testutil.MakeBlockMetadatasFromStartHeight(100, 2, stableTag), nil)
+	s.blobStorage.EXPECT().Download(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, xerrors.New("failed to download"))
+
+	mockServer := &mockStreamRawBlocksByRangeServer{
+		ctx: context.Background(),
+	}
+	err := s.server.StreamRawBlocksByRange(&api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   102,
+	}, mockServer)
+	require.Error(err)
+	require.Empty(mockServer.blocks)
+}
+
+func (s *handlerTestSuite) TestStreamRawBlocksByRange_InvalidRange() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+
+	mockServer := &mockStreamRawBlocksByRangeServer{
+		ctx: context.Background(),
+	}
+	err := s.server.StreamRawBlocksByRange(&api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+	}, mockServer)
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	s.metaStorage.EXPECT().GetLatestBlock(gomock.Any(), stableTag).Return(&api.BlockMetadata{Height: 200}, nil)
+	err = s.server.StreamRawBlocksByRange(&api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   202,
+	}, mockServer)
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+// setupStreamBlocksByRange mocks the blocks [100, 125) with a small batch size,
+// and the blocks are downloaded with random delays so that they complete out of order.
+func (s *handlerTestSuite) setupStreamBlocksByRange(tag uint32) {
+	require := testutil.Require(s.T())
+	s.config.Api.MaxNumBlocks = 10
+	s.config.Api.NumWorkers = 4
+
+	s.metaStorage.EXPECT().GetLatestBlock(gomock.Any(), tag).Return(&api.BlockMetadata{Height: 200}, nil)
+	s.metaStorage.EXPECT().GetBlocksByHeightRange(gomock.Any(), tag, gomock.Any(), gomock.Any()).Times(3).
+		DoAndReturn(func(ctx context.Context, tag uint32, startHeight uint64, endHeight uint64) ([]*api.BlockMetadata, error) {
+			expectedEndHeight := startHeight + 10
+			if expectedEndHeight > 125 {
+				expectedEndHeight = 125
+			}
+			require.Contains([]uint64{100, 110, 120}, startHeight)
+			require.Equal(expectedEndHeight, endHeight)
+			blocks := testutil.MakeBlockMetadatasFromStartHeight(startHeight, int(endHeight-startHeight), tag)
+			for _, block := range blocks {
+				block.Hash = fmt.Sprintf("hash%d", block.Height)
+			}
+			return blocks, nil
+		})
+	s.blobStorage.EXPECT().Download(gomock.Any(), gomock.Any()).Times(25).
+		DoAndReturn(func(ctx context.Context, metadata *api.BlockMetadata) (*api.Block, error) {
+			time.Sleep(time.Duration(metadata.Height%3) * 10 * time.Millisecond)
+			return &api.Block{Metadata: metadata}, nil
+		})
+}
+
+type mockStreamFilteredTransactionsServer struct {
+	api.ChainStorage_StreamFilteredTransactionsServer
+	responses []*api.StreamFilteredTransactionsResponse
+	ctx       context.Context
+}
+
+func (m *mockStreamFilteredTransactionsServer) Send(res *api.StreamFilteredTransactionsResponse) error {
+	m.responses = append(m.responses, res)
+	return nil
+}
+
+func (m *mockStreamFilteredTransactionsServer) Context() context.Context {
+	return m.ctx
+}
+
+func (s *handlerTestSuite) TestStreamFilteredTransactions() {
+	require := testutil.Require(s.T())
+	const contractAddress = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+
+	events := []*model.EventEntry{
+		{EventId: 100, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 1000, BlockHash: "0xa", Tag: s.tagForTestEvents, EventTag: s.eventTagForTestEvents},
+		{EventId: 101, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 1001, BlockHash: "0xb", Tag: s.tagForTestEvents, EventTag: s.eventTagForTestEvents},
+		{EventId: 102, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 1002, BlockSkipped: true, Tag: s.tagForTestEvents, EventTag: s.eventTagForTestEvents},
+		{EventId: 103, EventType: api.BlockchainEvent_BLOCK_REMOVED, BlockHeight: 1001, BlockHash: "0xb", Tag: s.tagForTestEvents, EventTag: s.eventTagForTestEvents},
+	}
+	s.metaStorage.EXPECT().GetEventsAfterEventId(gomock.Any(), s.eventTagForTestEvents, gomock.Any(), s.config.Api.StreamingBatchSize).AnyTimes().
+		DoAndReturn(func(ctx context.Context, eventTag uint32, eventId int64, maxEvents uint64) ([]*model.EventEntry, error) {
+			if eventId == 99 {
+				return events, nil
+			}
+
+			require.Equal(int64(103), eventId)
+			return nil, nil
+		})
+	s.metaStorage.EXPECT().GetBlockByHash(gomock.Any(), s.tagForTestEvents, gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(ctx context.Context, tag uint32, height uint64, hash string) (*api.BlockMetadata, error) {
+			return &api.BlockMetadata{
+				Tag:           tag,
+				Height:        height,
+				Hash:          hash,
+				ObjectKeyMain: hash,
+			}, nil
+		})
+	s.blobStorage.EXPECT().Download(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(ctx context.Context, metadata *api.BlockMetadata) (*api.Block, error) {
+			return &api.Block{Metadata: metadata}, nil
+		})
+	s.parser.EXPECT().ParseNativeBlock(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(ctx context.Context, rawBlock *api.Block) (*api.NativeBlock, error) {
+			to := "0x3506424f91fd33084466f402d5d97f05f8e3b4af"
+			if rawBlock.Metadata.Hash == "0xa" {
+				to = contractAddress
+			}
+
+			return &api.NativeBlock{
+				Tag:    rawBlock.Metadata.Tag,
+				Height: rawBlock.Metadata.Height,
+				Hash:   rawBlock.Metadata.Hash,
+				Block: &api.NativeBlock_Ethereum{
+					Ethereum: &api.EthereumBlock{
+						Transactions: []*api.EthereumTransaction{
+							{Hash: "0xtx" + rawBlock.Metadata.Hash, To: to},
+						},
+					},
+				},
+			}, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mockServer := &mockStreamFilteredTransactionsServer{
+		ctx: ctx,
+	}
+	go func() {
+		time.Sleep(time.Second)
+		cancel()
+	}()
+	err := s.server.StreamFilteredTransactions(&api.StreamFilteredTransactionsRequest{
+		SequenceNum: 99,
+		EventTag:    s.eventTagForTestEvents,
+		Filters: []*api.TransactionFilter{
+			{ToAddresses: []string{contractAddress}},
+		},
+	}, mockServer)
+	require.NoError(err)
+	require.Len(mockServer.responses, 2)
+
+	added := mockServer.responses[0]
+	require.Equal(int64(100), added.Event.SequenceNum)
+	require.Equal(api.BlockchainEvent_BLOCK_ADDED, added.Event.Type)
+	require.Len(added.Transactions, 1)
+	require.Equal("0xtx0xa", added.Transactions[0].TransactionHash)
+	require.Equal(uint64(1000), added.Transactions[0].BlockHeight)
+
+	removed := mockServer.responses[1]
+	require.Equal(int64(103), removed.Event.SequenceNum)
+	require.Equal(api.BlockchainEvent_BLOCK_REMOVED, removed.Event.Type)
+	require.Equal("0xb", removed.Event.Block.Hash)
+	require.Empty(removed.Transactions)
+}
+
+func (s *handlerTestSuite) TestStreamFilteredTransactions_InvalidFilters() {
+	require := testutil.Require(s.T())
+
+	mockServer := &mockStreamFilteredTransactionsServer{
+		ctx: context.Background(),
+	}
+	err := s.server.StreamFilteredTransactions(&api.StreamFilteredTransactionsRequest{}, mockServer)
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	err = s.server.StreamFilteredTransactions(&api.StreamFilteredTransactionsRequest{
+		Filters: []*api.TransactionFilter{
+			{ProgramIds: []string{"Vote111111111111111111111111111111111111111"}},
+			{},
+		},
+	}, mockServer)
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *handlerTestSuite) TestStreamFilteredTransactions_UnsupportedChain() {
+	require := testutil.Require(s.T())
+
+	// No event is read from the storage before the request is rejected.
+	s.config.Chain.Blockchain = common.Blockchain_BLOCKCHAIN_APTOS
+	mockServer := &mockStreamFilteredTransactionsServer{
+		ctx: context.Background(),
+	}
+	err := s.server.StreamFilteredTransactions(&api.StreamFilteredTransactionsRequest{
+		Filters: []*api.TransactionFilter{
+			{ToAddresses: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"}},
+		},
+	}, mockServer)
+	require.Error(err)
+	require.Equal(codes.Unimplemented, status.Code(err))
+	require.Empty(mockServer.responses)
+}
+
 func (s *handlerTestSuite) TestGetChainEvents_WithSequence() {
 	require := testutil.Require(s.T())
 	lastSeenEventId := int64(99)
@@ -2368,6 +2660,148 @@ func (s *handlerTestSuite) TestGetVersionedChainEvent_NoMatchingEvent() {
 	require.Error(err)
 }
 
+// setupEventHistory sets up the following events:
+// +100(a) -> +101(b) -> -101(b) -> +101(c) -> +102(d) -> -102(d)
+func (s *handlerTestSuite) setupEventHistory(eventTag uint32) {
+	eventsByHeight := map[uint64][]*model.EventEntry{
+		100: {
+			{EventId: 10, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 100, BlockHash: "a", Tag: 1, EventTag: eventTag, BlockTimestamp: 1000},
+		},
+		101: {
+			{EventId: 11, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 101, BlockHash: "b", ParentHash: "a", Tag: 1, EventTag: eventTag, BlockTimestamp: 1001},
+			{EventId: 12, EventType: api.BlockchainEvent_BLOCK_REMOVED, BlockHeight: 101, BlockHash: "b", ParentHash: "a", Tag: 1, EventTag: eventTag, BlockTimestamp: 1001},
+			{EventId: 13, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 101, BlockHash: "c", ParentHash: "a", Tag: 1, EventTag: eventTag, BlockTimestamp: 1002},
+		},
+		102: {
+			{EventId: 14, EventType: api.BlockchainEvent_BLOCK_ADDED, BlockHeight: 102, BlockHash: "d", ParentHash: "c", Tag: 1, EventTag: eventTag, BlockTimestamp: 1003},
+			{EventId: 15, EventType: api.BlockchainEvent_BLOCK_REMOVED, BlockHeight: 102, BlockHash: "d", ParentHash: "c", Tag: 1, EventTag: eventTag, BlockTimestamp: 1003},
+		},
+	}
+
+	s.metaStorage.EXPECT().GetMaxEventId(gomock.Any(), eventTag).AnyTimes().Return(int64(15), nil)
+	s.metaStorage.EXPECT().GetEventsByBlockHeight(gomock.Any(), eventTag, gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, eventTag uint32, blockHeight uint64) ([]*model.EventEntry, error) {
+			events, ok := eventsByHeight[blockHeight]
+			if !ok {
+				return nil, storage.ErrItemNotFound
+			}
+
+			return events, nil
+		},
+	)
+}
+
+func (s *handlerTestSuite) TestGetCanonicalBlockAsOfEvent() {
+	require := testutil.Require(s.T())
+	eventTag := s.eventTagForTestEvents
+	s.setupEventHistory(eventTag)
+
+	tests := []struct {
+		sequenceNum  int64
+		height       uint64
+		expectedHash string
+	}{
+		{sequenceNum: 10, height: 100, expectedHash: "a"},
+		{sequenceNum: 15, height: 100, expectedHash: "a"},
+		{sequenceNum: 11, height: 101, expectedHash: "b"},
+		{sequenceNum: 12, height: 101},
+		{sequenceNum: 13, height: 101, expectedHash: "c"},
+		{sequenceNum: 15, height: 101, expectedHash: "c"},
+		{sequenceNum: 13, height: 102},
+		{sequenceNum: 14, height: 102, expectedHash: "d"},
+		{sequenceNum: 15, height: 102},
+		{sequenceNum: 15, height: 103},
+		{sequenceNum: 9, height: 100},
+	}
+	for _, test := range tests {
+		resp, err := s.server.GetCanonicalBlockAsOfEvent(context.Background(), &api.GetCanonicalBlockAsOfEventRequest{
+			EventTag:    eventTag,
+			SequenceNum: test.sequenceNum,
+			Height:      test.height,
+		})
+		if test.expectedHash == "" {
+			require.Nil(resp)
+			s.verifyStatusCode(codes.NotFound, err)
+			continue
+		}
+
+		require.NoError(err)
+		require.Equal(test.expectedHash, resp.Block.Hash, "sequenceNum=%v, height=%v", test.sequenceNum, test.height)
+		require.Equal(test.height, resp.Block.Height)
+		require.Equal(uint32(1), resp.Block.Tag)
+		require.NotNil(resp.Block.Timestamp)
+	}
+}
+
+func (s *handlerTestSuite) TestGetCanonicalBlocksByRangeAsOfEvent() {
+	require := testutil.Require(s.T())
+	eventTag := s.eventTagForTestEvents
+	s.setupEventHistory(eventTag)
+
+	resp, err := s.server.GetCanonicalBlocksByRangeAsOfEvent(context.Background(), &api.GetCanonicalBlocksByRangeAsOfEventRequest{
+		EventTag:    eventTag,
+		SequenceNum: 11,
+		StartHeight: 100,
+		EndHeight:   102,
+	})
+	require.NoError(err)
+	require.Len(resp.Blocks, 2)
+	require.Equal("a", resp.Blocks[0].Hash)
+	require.Equal("b", resp.Blocks[1].Hash)
+
+	resp, err = s.server.GetCanonicalBlocksByRangeAsOfEvent(context.Background(), &api.GetCanonicalBlocksByRangeAsOfEventRequest{
+		EventTag:    eventTag,
+		SequenceNum: 14,
+		StartHeight: 100,
+		EndHeight:   103,
+	})
+	require.NoError(err)
+	require.Len(resp.Blocks, 3)
+	require.Equal("a", resp.Blocks[0].Hash)
+	require.Equal("c", resp.Blocks[1].Hash)
+	require.Equal("d", resp.Blocks[2].Hash)
+
+	resp, err = s.server.GetCanonicalBlocksByRangeAsOfEvent(context.Background(), &api.GetCanonicalBlocksByRangeAsOfEventRequest{
+		EventTag:    eventTag,
+		SequenceNum: 15,
+		StartHeight: 100,
+		EndHeight:   103,
+	})
+	require.Nil(resp)
+	s.verifyStatusCode(codes.NotFound, err)
+}
+
+func (s *handlerTestSuite) TestGetCanonicalBlockAsOfEvent_InvalidRequest() {
+	require := testutil.Require(s.T())
+	eventTag := s.eventTagForTestEvents
+	s.setupEventHistory(eventTag)
+
+	_, err := s.server.GetCanonicalBlockAsOfEvent(context.Background(), &api.GetCanonicalBlockAsOfEventRequest{
+		EventTag:    eventTag,
+		SequenceNum: 0,
+		Height:      100,
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.server.GetCanonicalBlockAsOfEvent(context.Background(), &api.GetCanonicalBlockAsOfEventRequest{
+		EventTag:    eventTag,
+		SequenceNum: 16,
+		Height:      100,
+	})
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.server.GetCanonicalBlocksByRangeAsOfEvent(context.Background(), &api.GetCanonicalBlocksByRangeAsOfEventRequest{
+		EventTag:    eventTag,
+		SequenceNum: 15,
+		StartHeight: 100,
+		EndHeight:   100,
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func (s *handlerTestSuite) TestGetBlockByTransaction() {
 	require := testutil.Require(s.T())
 	stableTag := s.app.Config().GetStableBlockTag()
@@ -2615,6 +3049,340 @@ func (s *handlerTestSuite) TestGetBlockByTransaction_ErrGetBlockByHeight() {
 	require.Nil(resp)
 }
 
+func (s *handlerTestSuite) TestGetTransactionsByAddress() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+	address := "0xdac17f958d2ee523a2206206994597c13d831ec7"
+
+	addressTransactions := []*model.AddressTransaction{
+		{
+			Address:         address,
+			TransactionHash: "foo",
+			BlockNumber:     100,
+			BlockHash:       "100a",
+			BlockTag:        stableTag,
+		},
+		{
+			Address:         address,
+			TransactionHash: "bar",
+			BlockNumber:     100,
+			BlockHash:       "100b",
+			BlockTag:        stableTag,
+		},
+		{
+			Address:         address,
+			TransactionHash: "baz",
+			BlockNumber:     101,
+			BlockHash:       "101a",
+			BlockTag:        stableTag,
+		},
+	}
+
+	limit := int(s.app.Config().Api.MaxNumAddressTxs + 1)
+	s.transactionStorage.EXPECT().GetTransactionsByAddress(gomock.Any(), stableTag, address, uint64(100), uint64(110), nil, limit).Times(1).Return(addressTransactions, nil)
+	s.metaStorage.EXPECT().GetBlocksByHeights(gomock.Any(), stableTag, gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, tag uint32, heights []uint64) ([]*api.BlockMetadata, error) {
+			sort.Slice(heights, func(i, j int) bool {
+				return heights[i] < heights[j]
+			})
+			require.Equal([]uint64{100, 101}, heights)
+			return []*api.BlockMetadata{
+				{
+					Tag:    stableTag,
+					Hash:   "100b",
+					Height: 100,
+				},
+				{
+					Tag:    stableTag,
+					Hash:   "101a",
+					Height: 101,
+				},
+			}, nil
+		})
+
+	resp, err := s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		StartHeight: 100,
+		EndHeight:   110,
+	})
+	require.NoError(err)
+	require.Equal([]*api.AddressTransaction{
+		{
+			TransactionHash: "bar",
+			Block: &api.BlockIdentifier{
+				Tag:    stableTag,
+				Hash:   "100b",
+				Height: 100,
+			},
+		},
+		{
+			TransactionHash: "baz",
+			Block: &api.BlockIdentifier{
+				Tag:    stableTag,
+				Hash:   "101a",
+				Height: 101,
+			},
+		},
+	}, resp.GetTransactions())
+	require.Empty(resp.GetNextPageToken())
+}
+
+func (s *handlerTestSuite) TestGetTransactionsByAddress_Pagination() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+	address := "foo"
+
+	addressTransactions := []*model.AddressTransaction{
+		{
+			Address:         address,
+			TransactionHash: "bar",
+			BlockNumber:     100,
+			BlockHash:       "100a",
+			BlockTag:        stableTag,
+		},
+		{
+			Address:         address,
+			TransactionHash: "baz",
+			BlockNumber:     101,
+			BlockHash:       "101a",
+			BlockTag:        stableTag,
+		},
+		{
+			Address:         address,
+			TransactionHash: "qux",
+			BlockNumber:     102,
+			BlockHash:       "102a",
+			BlockTag:        stableTag,
+		},
+	}
+	blocks := []*api.BlockMetadata{
+		{
+			Tag:    stableTag,
+			Hash:   "100a",
+			Height: 100,
+		},
+		{
+			Tag:    stableTag,
+			Hash:   "101a",
+			Height: 101,
+		},
+		{
+			Tag:    stableTag,
+			Hash:   "102a",
+			Height: 102,
+		},
+	}
+
+	// One more transaction than the limit is requested to find out whether there is a next page.
+	s.transactionStorage.EXPECT().GetTransactionsByAddress(gomock.Any(), stableTag, address, uint64(100), uint64(110), nil, 3).Times(1).Return(addressTransactions, nil)
+	s.metaStorage.EXPECT().GetBlocksByHeights(gomock.Any(), stableTag, gomock.Any()).Times(1).Return(blocks[:2], nil)
+
+	resp, err := s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     address,
+		StartHeight: 100,
+		EndHeight:   110,
+		Limit:       2,
+	})
+	require.NoError(err)
+	require.Equal(2, len(resp.GetTransactions()))
+	require.Equal("bar", resp.GetTransactions()[0].TransactionHash)
+	require.Equal("baz", resp.GetTransactions()[1].TransactionHash)
+	require.NotEmpty(resp.GetNextPageToken())
+
+	// The next page resumes right after the last transaction of the previous page.
+	s.transactionStorage.EXPECT().GetTransactionsByAddress(gomock.Any(), stableTag, address, uint64(100), uint64(110), &model.AddressTransaction{
+		TransactionHash: "baz",
+		BlockNumber:     101,
+		BlockHash:       "101a",
+	}, 3).Times(1).Return(addressTransactions[2:], nil)
+	s.metaStorage.EXPECT().GetBlocksByHeights(gomock.Any(), stableTag, []uint64{102}).Times(1).Return(blocks[2:], nil)
+
+	resp, err = s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     address,
+		StartHeight: 100,
+		EndHeight:   110,
+		Limit:       2,
+		PageToken:   resp.GetNextPageToken(),
+	})
+	require.NoError(err)
+	require.Equal(1, len(resp.GetTransactions()))
+	require.Equal("qux", resp.GetTransactions()[0].TransactionHash)
+	require.Empty(resp.GetNextPageToken())
+}
+
+func (s *handlerTestSuite) TestGetTransactionsByAddress_NoTransactions() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+
+	limit := int(s.app.Config().Api.MaxNumAddressTxs + 1)
+	s.transactionStorage.EXPECT().GetTransactionsByAddress(gomock.Any(), stableTag, "foo", uint64(100), uint64(101), nil, limit).Times(1).Return(nil, nil)
+
+	resp, err := s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     "foo",
+		StartHeight: 100,
+	})
+	require.NoError(err)
+	require.Empty(resp.GetTransactions())
+}
+
+func (s *handlerTestSuite) TestGetTransactionsByAddress_InvalidRequest() {
+	require := testutil.Require(s.T())
+
+	_, err := s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		StartHeight: 100,
+		EndHeight:   110,
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     "foo",
+		StartHeight: 100,
+		EndHeight:   100 + s.app.Config().Api.MaxNumAddressBlocks + 1,
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     "foo",
+		StartHeight: 100,
+		Limit:       uint32(s.app.Config().Api.MaxNumAddressTxs + 1),
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     "foo",
+		StartHeight: 100,
+		PageToken:   "not a token",
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *handlerTestSuite) TestGetTransactionsByAddress_NotImplemented() {
+	require := testutil.Require(s.T())
+
+	s.config.Chain.Feature.AddressIndexing = false
+	_, err := s.server.GetTransactionsByAddress(context.Background(), &api.GetTransactionsByAddressRequest{
+		Address:     "foo",
+		StartHeight: 100,
+	})
+	require.ErrorIs(err, errNotImplemented)
+}
+
+func (s *handlerTestSuite) TestGetBlockByTimestamp() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+	s.setupTimestampChain(stableTag)
+
+	tests := []struct {
+		name      string
+		timestamp int64
+		mode      api.GetBlockByTimestampRequest_Mode
+		expected  uint64
+	}{
+		{name: "beforeExact", timestamp: 1240, mode: api.GetBlockByTimestampRequest_AT_OR_BEFORE, expected: 120},
+		{name: "afterExact", timestamp: 1240, mode: api.GetBlockByTimestampRequest_AT_OR_AFTER, expected: 120},
+		{name: "beforeInBetween", timestamp: 1245, mode: api.GetBlockByTimestampRequest_AT_OR_BEFORE, expected: 120},
+		{name: "afterInBetween", timestamp: 1245, mode: api.GetBlockByTimestampRequest_AT_OR_AFTER, expected: 121},
+		{name: "beforeSkipped", timestamp: 1612, mode: api.GetBlockByTimestampRequest_AT_OR_BEFORE, expected: 149},
+		{name: "afterSkipped", timestamp: 1612, mode: api.GetBlockByTimestampRequest_AT_OR_AFTER, expected: 153},
+		{name: "beforeLatest", timestamp: 9999, mode: api.GetBlockByTimestampRequest_AT_OR_BEFORE, expected: 199},
+		{name: "afterEarliest", timestamp: 0, mode: api.GetBlockByTimestampRequest_AT_OR_AFTER, expected: 100},
+	}
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			resp, err := s.server.GetBlockByTimestamp(context.Background(), &api.GetBlockByTimestampRequest{
+				Timestamp: &timestamppb.Timestamp{Seconds: test.timestamp},
+				Mode:      test.mode,
+			})
+			require.NoError(err)
+			require.Equal(test.expected, resp.GetBlock().GetHeight())
+			require.Equal(fmt.Sprintf("hash%d", test.expected), resp.GetBlock().GetHash())
+			require.False(resp.GetBlock().GetSkipped())
+		})
+	}
+}
+
+func (s *handlerTestSuite) TestGetBlockByTimestamp_NotFound() {
+	require := testutil.Require(s.T())
+	stableTag := s.app.Config().GetStableBlockTag()
+	s.setupTimestampChain(stableTag)
+
+	resp, err := s.server.GetBlockByTimestamp(context.Background(), &api.GetBlockByTimestampRequest{
+		Timestamp: &timestamppb.Timestamp{Seconds: 999},
+		Mode:      api.GetBlockByTimestampRequest_AT_OR_BEFORE,
+	})
+	require.Nil(resp)
+	s.verifyStatusCode(codes.NotFound, err)
+
+	resp, err = s.server.GetBlockByTimestamp(context.Background(), &api.GetBlockByTimestampRequest{
+		Timestamp: &timestamppb.Timestamp{Seconds: 9999},
+		Mode:      api.GetBlockByTimestampRequest_AT_OR_AFTER,
+	})
+	require.Nil(resp)
+	s.verifyStatusCode(codes.NotFound, err)
+}
+
+func (s *handlerTestSuite) TestGetBlockByTimestamp_InvalidRequest() {
+	require := testutil.Require(s.T())
+
+	_, err := s.server.GetBlockByTimestamp(context.Background(), &api.GetBlockByTimestampRequest{})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.server.GetBlockByTimestamp(context.Background(), &api.GetBlockByTimestampRequest{
+		Timestamp: &timestamppb.Timestamp{Seconds: 1240},
+		Mode:      api.GetBlockByTimestampRequest_Mode(100),
+	})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+// setupTimestampChain mocks a chain of blocks [100, 200), where block N is produced at 1000 + (N - 100) * 12 seconds
+// and blocks [150, 153) are skipped.
+func (s *handlerTestSuite) setupTimestampChain(tag uint32) {
+	require := testutil.Require(s.T())
+	s.config.Chain.BlockStartHeight = 100
+
+	getBlock := func(height uint64) *api.BlockMetadata {
+		if height >= 150 && height < 153 {
+			return &api.BlockMetadata{
+				Tag:     tag,
+				Height:  height,
+				Skipped: true,
+			}
+		}
+
+		return &api.BlockMetadata{
+			Tag:       tag,
+			Hash:      fmt.Sprintf("hash%d", height),
+			Height:    height,
+			Timestamp: &timestamppb.Timestamp{Seconds: int64(1000 + (height-100)*12)},
+		}
+	}
+
+	s.metaStorage.EXPECT().GetLatestBlock(gomock.Any(), tag).AnyTimes().Return(getBlock(199), nil)
+	s.metaStorage.EXPECT().GetBlockByHeight(gomock.Any(), tag, gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, tag uint32, height uint64) (*api.BlockMetadata, error) {
+			require.GreaterOrEqual(height, uint64(100))
+			require.Less(height, uint64(200))
+			return getBlock(height), nil
+		})
+	s.metaStorage.EXPECT().GetBlocksByHeightRange(gomock.Any(), tag, gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, tag uint32, startHeight uint64, endHeight uint64) ([]*api.BlockMetadata, error) {
+			require.GreaterOrEqual(startHeight, uint64(100))
+			require.LessOrEqual(endHeight, uint64(200))
+			require.Less(startHeight, endHeight)
+			var blocks []*api.BlockMetadata
+			for height := startHeight; height < endHeight; height++ {
+				blocks = append(blocks, getBlock(height))
+			}
+			return blocks, nil
+		})
+}
+
 func (s *handlerTestSuite) TestGetNativeTransaction() {
 	require := testutil.Require(s.T())
 
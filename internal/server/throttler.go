@@ -1,8 +1,11 @@
 package server
 
 import (
+	"context"
 	"sync"
 
+	"golang.org/x/xerrors"
+
 	"github.com/coinbase/chainstorage/internal/config"
 	"github.com/coinbase/chainstorage/internal/utils/ratelimiter"
 )
@@ -58,6 +61,21 @@ func (t *Throttler) AllowN(clientID string, units int) bool {
 	return perClientAllowed && globalAllowed
 }
 
+// WaitN blocks until both the per-client and the global rate limits permit the units.
+// Unlike AllowN, it is meant for long-lived streams, which should slow down instead of failing.
+// An error is returned if ctx is done or the units exceed the burst size of either rate limiter.
+func (t *Throttler) WaitN(ctx context.Context, clientID string, units int) error {
+	if err := t.getPerClientRateLimiter(clientID).WaitN(ctx, units); err != nil {
+		return xerrors.Errorf("failed to wait for per-client rate limiter: %w", err)
+	}
+
+	if err := t.globalRateLimiter.WaitN(ctx, units); err != nil {
+		return xerrors.Errorf("failed to wait for global rate limiter: %w", err)
+	}
+
+	return nil
+}
+
 func (t *Throttler) getPerClientRateLimiter(clientID string) *ratelimiter.RateLimiter {
 	// Cache new limiter in a pool to avoid creating a new object for every request.
 	newRateLimiter := t.rateLimiterPool.Get()
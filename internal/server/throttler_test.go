@@ -1,7 +1,9 @@
 package server
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -110,3 +112,29 @@ func TestThrottler_NoGlobalLimit(t *testing.T) {
 		require.True(throttler.Allow("baz"))
 	}
 }
+
+func TestThrottler_WaitN(t *testing.T) {
+	require := require.New(t)
+	cfg := &config.ApiConfig{
+		RateLimit: config.RateLimitConfig{
+			GlobalRPS:    500,
+			PerClientRPS: 20,
+		},
+	}
+
+	throttler := NewThrottler(cfg)
+	ctx := context.Background()
+	start := time.Now()
+	require.NoError(throttler.WaitN(ctx, "foo", 20))
+	require.NoError(throttler.WaitN(ctx, "foo", 10))
+	require.GreaterOrEqual(time.Since(start), 400*time.Millisecond)
+	require.False(throttler.AllowN("foo", 10))
+	require.True(throttler.AllowN("bar", 10))
+
+	// The units exceed the burst size.
+	require.Error(throttler.WaitN(ctx, "foo", 21))
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.Error(throttler.WaitN(ctx, "baz", 10))
+}
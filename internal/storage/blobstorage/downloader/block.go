@@ -2,14 +2,13 @@ package downloader
 
 import (
 	"context"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"time"
 
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"golang.org/x/xerrors"
-	"google.golang.org/protobuf/proto"
 	tracehttp "gopkg.in/DataDog/dd-trace-go.v1/contrib/net/http"
 
 	"github.com/coinbase/chainstorage/internal/config"
@@ -41,22 +40,34 @@ type (
 		config     *config.Config
 		logger     *zap.Logger
 		httpClient HTTPClient
+		codec      *storage_utils.Codec
 		retry      retry.RetryWithResult[*api.Block]
 	}
+
+	bodyReader struct {
+		reader io.Reader
+		err    error
+	}
 )
 
 const (
 	timeout = time.Second * 30
 )
 
-func NewBlockDownloader(params BlockDownloaderParams) BlockDownloader {
+func NewBlockDownloader(params BlockDownloaderParams) (BlockDownloader, error) {
+	codec, err := storage_utils.NewCodec(params.Config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create codec: %w", err)
+	}
+
 	logger := log.WithPackage(params.Logger)
 	return &blockDownloaderImpl{
 		config:     params.Config,
 		logger:     logger,
 		httpClient: params.HttpClient,
+		codec:      codec,
 		retry:      retry.NewWithResult[*api.Block](retry.WithLogger(logger)),
-	}
+	}, nil
 }
 
 func NewHTTPClient() HTTPClient {
@@ -109,25 +120,35 @@ func (d *blockDownloaderImpl) Download(ctx context.Context, blockFile *api.Block
 			}
 		}
 
-		bodyBytes, err := ioutil.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, retry.Retryable(xerrors.Errorf("failed to read body: %w", err))
-		}
-
-		block := new(api.Block)
-		blockData, err := storage_utils.Decompress(bodyBytes, blockFile.Compression)
+		// Decompress the body as it is being read, so that the compressed data is never buffered as a whole.
+		body := &bodyReader{reader: httpResp.Body}
+		block, err := d.codec.ReadBlock(body, blockFile.Compression, blockFile.Checksum)
 		if err != nil {
-			return nil, xerrors.Errorf("failed to decompress block data with type %v: %w", blockFile.Compression.String(), err)
+			if body.err != nil {
+				return nil, retry.Retryable(xerrors.Errorf("failed to read body: %w", body.err))
+			}
+			return nil, xerrors.Errorf("failed to read block file: %w", err)
 		}
 
-		if err := proto.Unmarshal(blockData, block); err != nil {
-			return nil, xerrors.Errorf("failed to unmarshal file contents: %w", err)
+		// The checksum is excluded from the payload and thus needs to be restored from the block file.
+		if block.Metadata != nil {
+			block.Metadata.Checksum = blockFile.Checksum
 		}
 
 		return block, finalizer.Close()
 	})
 }
 
+// Read implements io.Reader.
+// The read error is recorded to tell network failures apart from corrupted data.
+func (r *bodyReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
+}
+
 func (c *blockDownloaderImpl) logDuration(start time.Time) {
 	c.logger.Debug(
 		"downloader.request",
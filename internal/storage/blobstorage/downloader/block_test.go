@@ -13,8 +13,10 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	"github.com/coinbase/chainstorage/internal/config"
 	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
 	storage_utils "github.com/coinbase/chainstorage/internal/storage/utils"
+	"github.com/coinbase/chainstorage/internal/utils/retry"
 	"github.com/coinbase/chainstorage/internal/utils/testapp"
 	"github.com/coinbase/chainstorage/internal/utils/testutil"
 	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
@@ -37,8 +39,11 @@ var (
 		},
 	}
 
+	testCodec, _                    = storage_utils.NewCodec(new(config.Config))
 	expectedBlockBytes, _           = proto.Marshal(expectedBlock)
-	expectedBlockCompressedBytes, _ = storage_utils.Compress(expectedBlockBytes, api.Compression_GZIP)
+	expectedBlockCompressedBytes, _ = testCodec.Compress(expectedBlockBytes, api.Compression_GZIP)
+	expectedBlockZstdBytes, _       = testCodec.Compress(expectedBlockBytes, api.Compression_ZSTD)
+	expectedBlockSnappyBytes, _     = testCodec.Compress(expectedBlockBytes, api.Compression_SNAPPY)
 )
 
 type (
@@ -114,6 +119,25 @@ func (s *blockDownloaderTestSuite) TestUnmarshalFailure() {
 	require.Error(err)
 }
 
+func (s *blockDownloaderTestSuite) TestDecompressFailure() {
+	require := testutil.Require(s.T())
+	s.app = testapp.New(
+		s.T(),
+		fx.Provide(s.newHttpServerFunc(http.MethodGet, http.StatusOK, expectedBlockBytes)),
+		fx.Populate(&s.httpServer),
+		fx.Provide(s.newHttpClientFunc()),
+		fx.Provide(NewBlockDownloader),
+		fx.Populate(&s.downloader),
+	)
+
+	s.blockFile.Compression = api.Compression_ZSTD
+
+	resp, err := s.downloader.Download(context.Background(), s.blockFile)
+	require.Nil(resp)
+	require.Error(err)
+	require.False(xerrors.As(err, new(*retry.RetryableError)))
+}
+
 func (s *blockDownloaderTestSuite) TestSuccess() {
 	require := testutil.Require(s.T())
 	s.app = testapp.New(
@@ -152,6 +176,87 @@ func (s *blockDownloaderTestSuite) TestSuccess_Gzip() {
 	}
 }
 
+func (s *blockDownloaderTestSuite) TestSuccess_Zstd() {
+	require := testutil.Require(s.T())
+	s.app = testapp.New(
+		s.T(),
+		fx.Provide(s.newHttpServerFunc(http.MethodGet, http.StatusOK, expectedBlockZstdBytes)),
+		fx.Populate(&s.httpServer),
+		fx.Provide(s.newHttpClientFunc()),
+		fx.Provide(NewBlockDownloader),
+		fx.Populate(&s.downloader),
+	)
+
+	s.blockFile.Compression = api.Compression_ZSTD
+
+	rawBlock, err := s.downloader.Download(context.Background(), s.blockFile)
+	require.NoError(err)
+	if diff := cmp.Diff(expectedBlock, rawBlock, protocmp.Transform()); diff != "" {
+		require.FailNow(diff)
+	}
+}
+
+func (s *blockDownloaderTestSuite) TestSuccess_Snappy() {
+	require := testutil.Require(s.T())
+	s.app = testapp.New(
+		s.T(),
+		fx.Provide(s.newHttpServerFunc(http.MethodGet, http.StatusOK, expectedBlockSnappyBytes)),
+		fx.Populate(&s.httpServer),
+		fx.Provide(s.newHttpClientFunc()),
+		fx.Provide(NewBlockDownloader),
+		fx.Populate(&s.downloader),
+	)
+
+	s.blockFile.Compression = api.Compression_SNAPPY
+
+	rawBlock, err := s.downloader.Download(context.Background(), s.blockFile)
+	require.NoError(err)
+	if diff := cmp.Diff(expectedBlock, rawBlock, protocmp.Transform()); diff != "" {
+		require.FailNow(diff)
+	}
+}
+
+func (s *blockDownloaderTestSuite) TestSuccess_Checksum() {
+	require := testutil.Require(s.T())
+	s.app = testapp.New(
+		s.T(),
+		fx.Provide(s.newHttpServerFunc(http.MethodGet, http.StatusOK, expectedBlockCompressedBytes)),
+		fx.Populate(&s.httpServer),
+		fx.Provide(s.newHttpClientFunc()),
+		fx.Provide(NewBlockDownloader),
+		fx.Populate(&s.downloader),
+	)
+
+	s.blockFile.Compression = api.Compression_GZIP
+	s.blockFile.Checksum = storage_utils.ComputeChecksum(expectedBlockBytes)
+
+	rawBlock, err := s.downloader.Download(context.Background(), s.blockFile)
+	require.NoError(err)
+	if diff := cmp.Diff(expectedBlock, rawBlock, protocmp.Transform()); diff != "" {
+		require.FailNow(diff)
+	}
+}
+
+func (s *blockDownloaderTestSuite) TestChecksumMismatch() {
+	require := testutil.Require(s.T())
+	s.app = testapp.New(
+		s.T(),
+		fx.Provide(s.newHttpServerFunc(http.MethodGet, http.StatusOK, expectedBlockCompressedBytes)),
+		fx.Populate(&s.httpServer),
+		fx.Provide(s.newHttpClientFunc()),
+		fx.Provide(NewBlockDownloader),
+		fx.Populate(&s.downloader),
+	)
+
+	s.blockFile.Compression = api.Compression_GZIP
+	s.blockFile.Checksum = storage_utils.ComputeChecksum([]byte("foo"))
+
+	rawBlock, err := s.downloader.Download(context.Background(), s.blockFile)
+	require.Nil(rawBlock)
+	require.Error(err)
+	require.True(xerrors.Is(err, errors.ErrChecksumMismatch))
+}
+
 func (s *blockDownloaderTestSuite) TestSkipped() {
 	require := testutil.Require(s.T())
 	s.app = testapp.New(
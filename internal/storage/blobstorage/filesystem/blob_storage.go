@@ -0,0 +1,314 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/storage/blobstorage/internal"
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	storage_utils "github.com/coinbase/chainstorage/internal/storage/utils"
+	"github.com/coinbase/chainstorage/internal/utils/finalizer"
+	"github.com/coinbase/chainstorage/internal/utils/fxparams"
+	"github.com/coinbase/chainstorage/internal/utils/instrument"
+	"github.com/coinbase/chainstorage/internal/utils/log"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	BlobStorageParams struct {
+		fx.In
+		fxparams.Params
+	}
+
+	blobStorageFactory struct {
+		params BlobStorageParams
+	}
+
+	blobStorageImpl struct {
+		logger                 *zap.Logger
+		config                 *config.Config
+		rootDirectory          string
+		baseUrl                *url.URL
+		signer                 *signer
+		codec                  *storage_utils.Codec
+		presignedUrlExpiration time.Duration
+		blobStorageMetrics     *blobStorageMetrics
+		instrumentUpload       instrument.InstrumentWithResult[string]
+		instrumentDownload     instrument.InstrumentWithResult[*api.Block]
+	}
+
+	blobStorageMetrics struct {
+		blobDownloadedSize     tally.Timer
+		blobUploadedSize       tally.Timer
+		blobUploadDeduplicated tally.Counter
+	}
+
+	countingReader struct {
+		reader io.Reader
+		size   int
+	}
+)
+
+const (
+	blobUploaderScopeName   = "uploader"
+	blobDownloaderScopeName = "downloader"
+	blobSizeMetricName      = "blob_size"
+	blobDeduplicatedMetric  = "deduplicated"
+
+	directoryPermission = 0o755
+	filePermission      = 0o644
+)
+
+var _ internal.BlobStorage = (*blobStorageImpl)(nil)
+
+func NewFactory(params BlobStorageParams) internal.BlobStorageFactory {
+	return &blobStorageFactory{params}
+}
+
+// Create implements BlobStorageFactory.
+func (f *blobStorageFactory) Create() (internal.BlobStorage, error) {
+	return New(f.params)
+}
+
+func New(params BlobStorageParams) (internal.BlobStorage, error) {
+	metrics := params.Metrics.SubScope("blob_storage").Tagged(map[string]string{
+		"storage_type": "filesystem",
+	})
+	cfg := params.Config.Filesystem
+	if cfg == nil {
+		return nil, xerrors.Errorf("filesystem not configured for blob storage")
+	}
+	if len(cfg.RootDirectory) == 0 {
+		return nil, xerrors.Errorf("filesystem root directory not configured for blob storage")
+	}
+	if len(cfg.SigningKey) == 0 {
+		return nil, xerrors.Errorf("filesystem signing key not configured for blob storage")
+	}
+	if cfg.PresignedUrlExpiration == 0 {
+		return nil, xerrors.Errorf("filesystem presign url expiration not configured for blob storage")
+	}
+	baseUrl, err := url.Parse(cfg.BaseUrl)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse filesystem base url %v: %w", cfg.BaseUrl, err)
+	}
+	if !baseUrl.IsAbs() {
+		return nil, xerrors.Errorf("filesystem base url must be absolute: %v", cfg.BaseUrl)
+	}
+	if err := os.MkdirAll(cfg.RootDirectory, directoryPermission); err != nil {
+		return nil, xerrors.Errorf("failed to create root directory %v: %w", cfg.RootDirectory, err)
+	}
+	codec, err := storage_utils.NewCodec(params.Config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create codec: %w", err)
+	}
+	blobStorageMetrics := &blobStorageMetrics{
+		blobDownloadedSize:     metrics.SubScope(blobDownloaderScopeName).Timer(blobSizeMetricName),
+		blobUploadedSize:       metrics.SubScope(blobUploaderScopeName).Timer(blobSizeMetricName),
+		blobUploadDeduplicated: metrics.SubScope(blobUploaderScopeName).Counter(blobDeduplicatedMetric),
+	}
+	return &blobStorageImpl{
+		logger:                 log.WithPackage(params.Logger),
+		config:                 params.Config,
+		rootDirectory:          cfg.RootDirectory,
+		baseUrl:                baseUrl,
+		signer:                 newSigner(cfg.SigningKey),
+		codec:                  codec,
+		presignedUrlExpiration: cfg.PresignedUrlExpiration,
+		blobStorageMetrics:     blobStorageMetrics,
+		instrumentUpload:       instrument.NewWithResult[string](metrics, "upload"),
+		instrumentDownload:     instrument.NewWithResult[*api.Block](metrics, "download"),
+	}, nil
+}
+
+func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compression api.Compression, opts ...internal.UploadOption) (string, error) {
+	return s.instrumentUpload.Instrument(ctx, func(ctx context.Context) (string, error) {
+		var key string
+		defer s.logDuration("upload", time.Now())
+
+		// Skip the upload if the block itself is skipped.
+		if block.Metadata.Skipped {
+			return "", nil
+		}
+
+		data, err := storage_utils.MarshalBlock(block)
+		if err != nil {
+			return "", xerrors.Errorf("failed to marshal block: %w", err)
+		}
+		checksum := storage_utils.ComputeChecksum(data)
+
+		blockchainNetwork := fmt.Sprintf("%s/%s", block.Blockchain, block.Network)
+		tagHeightHash := fmt.Sprintf("%d/%d/%s", block.Metadata.Tag, block.Metadata.Height, block.Metadata.Hash)
+		if s.config.Chain.Sidechain != api.SideChain_SIDECHAIN_NONE {
+			key = fmt.Sprintf(
+				"%s/%s/%s", blockchainNetwork, block.SideChain, tagHeightHash,
+			)
+		} else {
+			key = fmt.Sprintf(
+				"%s/%s", blockchainNetwork, tagHeightHash,
+			)
+		}
+
+		data, err = s.codec.Compress(data, compression)
+		if err != nil {
+			return "", xerrors.Errorf("failed to compress data with type %v: %w", compression.String(), err)
+		}
+		key, err = storage_utils.GetObjectKey(key, compression)
+		if err != nil {
+			return "", xerrors.Errorf("failed to get object key: %w", err)
+		}
+
+		path, err := getObjectPath(s.rootDirectory, key)
+		if err != nil {
+			return "", xerrors.Errorf("failed to get object path: %w", err)
+		}
+
+		// Skip the upload if an identical payload has been uploaded, e.g. when a block is backfilled again.
+		// The compression is deterministic, hence the files can be compared directly.
+		if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+			s.blobStorageMetrics.blobUploadDeduplicated.Inc(1)
+			block.Metadata.Checksum = checksum
+			return key, nil
+		}
+
+		if err := writeFile(path, data); err != nil {
+			return "", xerrors.Errorf("failed to upload block data (key=%s): %w", key, err)
+		}
+
+		// a workaround to use timer
+		s.blobStorageMetrics.blobUploadedSize.Record(time.Duration(len(data)) * time.Millisecond)
+
+		block.Metadata.Checksum = checksum
+		return key, nil
+	})
+}
+
+func (s *blobStorageImpl) Download(ctx context.Context, metadata *api.BlockMetadata) (*api.Block, error) {
+	return s.instrumentDownload.Instrument(ctx, func(ctx context.Context) (*api.Block, error) {
+		defer s.logDuration("download", time.Now())
+
+		if metadata.Skipped {
+			// No blob data is available when the block is skipped.
+			return &api.Block{
+				Blockchain: s.config.Chain.Blockchain,
+				Network:    s.config.Chain.Network,
+				SideChain:  s.config.Chain.Sidechain,
+				Metadata:   metadata,
+				Blobdata:   nil,
+			}, nil
+		}
+
+		key := metadata.ObjectKeyMain
+		path, err := getObjectPath(s.rootDirectory, key)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get object path: %w", err)
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, xerrors.Errorf("block data not found (key=%s): %w", key, errors.ErrItemNotFound)
+			}
+			return nil, xerrors.Errorf("failed to read block data (key=%s): %w", key, err)
+		}
+
+		finalizer := finalizer.WithCloser(file)
+		defer finalizer.Finalize()
+
+		// The file is decompressed as it is being read, instead of being buffered as a whole.
+		body := &countingReader{reader: file}
+		compression := storage_utils.GetCompressionType(key)
+		block, err := s.codec.ReadBlock(body, compression, metadata.Checksum)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read block data (key=%s): %w", key, err)
+		}
+
+		// a workaround to use timer
+		s.blobStorageMetrics.blobDownloadedSize.Record(time.Duration(body.size) * time.Millisecond)
+
+		// When metadata is loaded from meta storage,
+		// the new fields, e.g. ParentHeight, may be populated with default values.
+		// Overwrite metadata using the one loaded from meta storage.
+		block.Metadata = metadata
+		return block, finalizer.Close()
+	})
+}
+
+// PreSign implements internal.BlobStorage.
+// The returned url is served by the handler created by NewHandler.
+func (s *blobStorageImpl) PreSign(ctx context.Context, objectKey string) (string, error) {
+	if _, err := getObjectPath(s.rootDirectory, objectKey); err != nil {
+		return "", xerrors.Errorf("failed to generate presigned url: %w", err)
+	}
+
+	expires := time.Now().Add(s.presignedUrlExpiration).Unix()
+	fileUrl := s.baseUrl.JoinPath(objectKey)
+	query := fileUrl.Query()
+	query.Set(expiresParam, strconv.FormatInt(expires, 10))
+	query.Set(signatureParam, s.signer.sign(objectKey, expires))
+	fileUrl.RawQuery = query.Encode()
+	return fileUrl.String(), nil
+}
+
+func (s *blobStorageImpl) logDuration(method string, start time.Time) {
+	s.logger.Debug(
+		"blob_storage",
+		zap.String("storage_type", "filesystem"),
+		zap.String("method", method),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
+
+// writeFile writes the data to a temporary file first and then renames it,
+// so that readers never observe a partially written object.
+func writeFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, directoryPermission); err != nil {
+		return xerrors.Errorf("failed to create directory %v: %w", dir, err)
+	}
+
+	file, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return xerrors.Errorf("failed to create temporary file: %w", err)
+	}
+	tempPath := file.Name()
+	defer func() {
+		// The removal fails harmlessly once the file has been renamed.
+		_ = os.Remove(tempPath)
+	}()
+	finalizer := finalizer.WithCloser(file)
+	defer finalizer.Finalize()
+
+	if _, err := file.Write(data); err != nil {
+		return xerrors.Errorf("failed to write file: %w", err)
+	}
+	if err := file.Chmod(filePermission); err != nil {
+		return xerrors.Errorf("failed to change file mode: %w", err)
+	}
+	if err := finalizer.Close(); err != nil {
+		return xerrors.Errorf("failed to close file: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return xerrors.Errorf("failed to rename file: %w", err)
+	}
+
+	return nil
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += n
+	return n, err
+}
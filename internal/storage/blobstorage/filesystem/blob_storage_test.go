@@ -0,0 +1,305 @@
+package filesystem
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/storage/blobstorage/downloader"
+	"github.com/coinbase/chainstorage/internal/storage/blobstorage/internal"
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	storage_utils "github.com/coinbase/chainstorage/internal/storage/utils"
+	"github.com/coinbase/chainstorage/internal/utils/testapp"
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+func newTestConfig(t *testing.T, baseUrl string) *config.Config {
+	require := testutil.Require(t)
+
+	cfg, err := config.New()
+	require.NoError(err)
+	cfg.StorageType.BlobStorageType = config.BlobStorageType_FILESYSTEM
+	cfg.Filesystem = &config.FilesystemConfig{
+		RootDirectory:          t.TempDir(),
+		BaseUrl:                baseUrl,
+		SigningKey:             "secret",
+		PresignedUrlExpiration: time.Minute,
+	}
+	return cfg
+}
+
+func newTestBlock() *api.Block {
+	return &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:    1,
+			Height: 12345,
+			Hash:   "0xabcde",
+		},
+	}
+}
+
+func TestBlobStorage_NoCompression(t *testing.T) {
+	const expectedObjectKey = "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde"
+
+	require := testutil.Require(t)
+
+	cfg := newTestConfig(t, "http://localhost:9091/blobs")
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		testapp.WithConfig(cfg),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	block := newTestBlock()
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_NONE)
+	require.NoError(err)
+	require.Equal(expectedObjectKey, objectKey)
+	require.FileExists(filepath.Join(cfg.Filesystem.RootDirectory, expectedObjectKey))
+
+	metadata := proto.Clone(block.Metadata).(*api.BlockMetadata)
+	metadata.ObjectKeyMain = objectKey
+	actual, err := storage.Download(context.Background(), metadata)
+	require.NoError(err)
+	require.Equal(block.Blockchain, actual.Blockchain)
+	require.Equal(block.Network, actual.Network)
+	require.Equal(metadata, actual.Metadata)
+
+	entries, err := os.ReadDir(filepath.Dir(filepath.Join(cfg.Filesystem.RootDirectory, expectedObjectKey)))
+	require.NoError(err)
+	require.Len(entries, 1, "temporary files should be removed")
+}
+
+func TestBlobStorage_GzipCompression(t *testing.T) {
+	const expectedObjectKey = "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde.gzip"
+
+	require := testutil.Require(t)
+
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		testapp.WithConfig(newTestConfig(t, "http://localhost:9091")),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	block := newTestBlock()
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_GZIP)
+	require.NoError(err)
+	require.Equal(expectedObjectKey, objectKey)
+
+	metadata := proto.Clone(block.Metadata).(*api.BlockMetadata)
+	metadata.ObjectKeyMain = objectKey
+	actual, err := storage.Download(context.Background(), metadata)
+	require.NoError(err)
+	require.Equal(metadata, actual.Metadata)
+}
+
+func TestBlobStorage_Deduplicated(t *testing.T) {
+	require := testutil.Require(t)
+
+	cfg := newTestConfig(t, "http://localhost:9091")
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		testapp.WithConfig(cfg),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	block := newTestBlock()
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_ZSTD)
+	require.NoError(err)
+	require.NotEmpty(block.Metadata.Checksum)
+	path := filepath.Join(cfg.Filesystem.RootDirectory, objectKey)
+	info, err := os.Stat(path)
+	require.NoError(err)
+
+	// Re-uploading an identical block does not rewrite the file.
+	checksum := block.Metadata.Checksum
+	block = newTestBlock()
+	duplicatedKey, err := storage.Upload(context.Background(), block, api.Compression_ZSTD)
+	require.NoError(err)
+	require.Equal(objectKey, duplicatedKey)
+	require.Equal(checksum, block.Metadata.Checksum)
+	duplicatedInfo, err := os.Stat(path)
+	require.NoError(err)
+	require.Equal(info.ModTime(), duplicatedInfo.ModTime())
+
+	// Uploading a different payload under the same key overwrites the file.
+	block = newTestBlock()
+	block.Blobdata = &api.Block_Ethereum{
+		Ethereum: &api.EthereumBlobdata{
+			Header: []byte("header"),
+		},
+	}
+	_, err = storage.Upload(context.Background(), block, api.Compression_ZSTD)
+	require.NoError(err)
+	require.NotEqual(checksum, block.Metadata.Checksum)
+}
+
+func TestBlobStorage_ChecksumMismatch(t *testing.T) {
+	require := testutil.Require(t)
+
+	cfg := newTestConfig(t, "http://localhost:9091")
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		testapp.WithConfig(cfg),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	block := newTestBlock()
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_NONE)
+	require.NoError(err)
+
+	metadata := proto.Clone(block.Metadata).(*api.BlockMetadata)
+	metadata.ObjectKeyMain = objectKey
+	metadata.Checksum = storage_utils.ComputeChecksum([]byte("corrupted"))
+	_, err = storage.Download(context.Background(), metadata)
+	require.Error(err)
+	require.ErrorIs(err, errors.ErrChecksumMismatch)
+}
+
+func TestBlobStorage_DownloadNotFound(t *testing.T) {
+	require := testutil.Require(t)
+
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		testapp.WithConfig(newTestConfig(t, "http://localhost:9091")),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	_, err := storage.Download(context.Background(), &api.BlockMetadata{
+		Tag:           1,
+		Height:        12345,
+		Hash:          "0xabcde",
+		ObjectKeyMain: "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde",
+	})
+	require.Error(err)
+	require.ErrorIs(err, errors.ErrItemNotFound)
+
+	_, err = storage.Download(context.Background(), &api.BlockMetadata{
+		Tag:           1,
+		Height:        12345,
+		Hash:          "0xabcde",
+		ObjectKeyMain: "../../etc/passwd",
+	})
+	require.Error(err)
+}
+
+func TestBlobStorage_PreSign(t *testing.T) {
+	require := testutil.Require(t)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := newTestConfig(t, server.URL+"/blobs")
+	handler, err := NewHandler(cfg)
+	require.NoError(err)
+	mux.Handle("/blobs/", handler)
+
+	var storage internal.BlobStorage
+	var blockDownloader downloader.BlockDownloader
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		fx.Provide(downloader.NewBlockDownloader),
+		fx.Provide(func() downloader.HTTPClient { return server.Client() }),
+		testapp.WithConfig(cfg),
+		fx.Populate(&storage),
+		fx.Populate(&blockDownloader),
+	)
+	defer app.Close()
+
+	block := newTestBlock()
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_GZIP)
+	require.NoError(err)
+
+	fileUrl, err := storage.PreSign(context.Background(), objectKey)
+	require.NoError(err)
+
+	actual, err := blockDownloader.Download(context.Background(), &api.BlockFile{
+		Tag:         block.Metadata.Tag,
+		Hash:        block.Metadata.Hash,
+		Height:      block.Metadata.Height,
+		FileUrl:     fileUrl,
+		Compression: api.Compression_GZIP,
+		Checksum:    block.Metadata.Checksum,
+	})
+	require.NoError(err)
+	require.True(proto.Equal(block, actual))
+
+	// Tampering with the signature is rejected.
+	parsed, err := url.Parse(fileUrl)
+	require.NoError(err)
+	query := parsed.Query()
+	query.Set(signatureParam, query.Get(signatureParam)+"00")
+	parsed.RawQuery = query.Encode()
+	resp, err := server.Client().Get(parsed.String())
+	require.NoError(err)
+	require.NoError(resp.Body.Close())
+	require.Equal(http.StatusForbidden, resp.StatusCode)
+
+	// Reusing the signature for another object is rejected.
+	parsed, err = url.Parse(fileUrl)
+	require.NoError(err)
+	parsed.Path = parsed.Path + ".other"
+	resp, err = server.Client().Get(parsed.String())
+	require.NoError(err)
+	require.NoError(resp.Body.Close())
+	require.Equal(http.StatusForbidden, resp.StatusCode)
+}
+
+func TestHandler_Expired(t *testing.T) {
+	const objectKey = "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde"
+
+	require := testutil.Require(t)
+
+	cfg := newTestConfig(t, "http://localhost:9091")
+	handler, err := NewHandler(cfg)
+	require.NoError(err)
+	require.NoError(writeFile(filepath.Join(cfg.Filesystem.RootDirectory, objectKey), []byte("data")))
+
+	newRequest := func(expires time.Time) *http.Request {
+		signature := newSigner(cfg.Filesystem.SigningKey).sign(objectKey, expires.Unix())
+		query := url.Values{
+			expiresParam:   {strconv.FormatInt(expires.Unix(), 10)},
+			signatureParam: {signature},
+		}
+		return httptest.NewRequest(http.MethodGet, "/"+objectKey+"?"+query.Encode(), nil)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(time.Now().Add(-time.Minute)))
+	require.Equal(http.StatusForbidden, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(time.Now().Add(time.Minute)))
+	require.Equal(http.StatusOK, recorder.Code)
+	require.Equal("data", recorder.Body.String())
+}
@@ -0,0 +1,145 @@
+package filesystem
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/config"
+)
+
+type (
+	handler struct {
+		rootDirectory string
+		pathPrefix    string
+		signer        *signer
+	}
+
+	signer struct {
+		key []byte
+	}
+)
+
+const (
+	expiresParam   = "expires"
+	signatureParam = "signature"
+)
+
+// NewHandler creates the http handler serving the presigned urls generated by the filesystem blob storage.
+// The handler is expected to be reachable at config.Filesystem.BaseUrl.
+func NewHandler(cfg *config.Config) (http.Handler, error) {
+	if cfg.Filesystem == nil {
+		return nil, xerrors.Errorf("filesystem not configured for blob storage")
+	}
+	baseUrl, err := url.Parse(cfg.Filesystem.BaseUrl)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse filesystem base url %v: %w", cfg.Filesystem.BaseUrl, err)
+	}
+
+	return &handler{
+		rootDirectory: cfg.Filesystem.RootDirectory,
+		pathPrefix:    strings.TrimSuffix(baseUrl.Path, "/") + "/",
+		signer:        newSigner(cfg.Filesystem.SigningKey),
+	}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, h.pathPrefix)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid expiration", http.StatusForbidden)
+		return
+	}
+	if !h.signer.verify(key, expires, query.Get(signatureParam)) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if time.Now().Unix() > expires {
+		http.Error(w, "url expired", http.StatusForbidden)
+		return
+	}
+
+	objectPath, err := getObjectPath(h.rootDirectory, key)
+	if err != nil {
+		http.Error(w, "invalid object key", http.StatusBadRequest)
+		return
+	}
+
+	file, err := os.Open(objectPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
+
+func newSigner(key string) *signer {
+	return &signer{key: []byte(key)}
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of the object key and its expiration.
+func (s *signer) sign(objectKey string, expires int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(objectKey))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *signer) verify(objectKey string, expires int64, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	actual, err := hex.DecodeString(s.sign(objectKey, expires))
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, actual)
+}
+
+// getObjectPath maps the object key to a file under the root directory.
+// Keys which are not in the canonical form, e.g. containing "..", are rejected so that a key never escapes the root directory.
+func getObjectPath(rootDirectory string, objectKey string) (string, error) {
+	if objectKey == "" || path.Clean("/"+objectKey) != "/"+objectKey {
+		return "", xerrors.Errorf("invalid object key: %v", objectKey)
+	}
+
+	return filepath.Join(rootDirectory, filepath.FromSlash(objectKey)), nil
+}
@@ -0,0 +1,12 @@
+package filesystem
+
+import (
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(fx.Annotated{
+		Name:   "blobstorage/filesystem",
+		Target: NewFactory,
+	}),
+)
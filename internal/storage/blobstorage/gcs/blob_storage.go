@@ -13,7 +13,6 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"golang.org/x/xerrors"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/coinbase/chainstorage/internal/config"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage/internal"
@@ -41,6 +40,7 @@ type (
 		project                string
 		bucket                 string
 		client                 *storage.Client
+		codec                  *storage_utils.Codec
 		presignedUrlExpiration time.Duration
 		blobStorageMetrics     *blobStorageMetrics
 		instrumentUpload       instrument.InstrumentWithResult[string]
@@ -48,8 +48,18 @@ type (
 	}
 
 	blobStorageMetrics struct {
-		blobDownloadedSize tally.Timer
-		blobUploadedSize   tally.Timer
+		blobDownloadedSize     tally.Timer
+		blobUploadedSize       tally.Timer
+		blobUploadDeduplicated tally.Counter
+	}
+
+	countingReader struct {
+		reader io.Reader
+		size   int
+	}
+
+	countingWriter struct {
+		size int
 	}
 )
 
@@ -57,6 +67,10 @@ const (
 	blobUploaderScopeName   = "uploader"
 	blobDownloaderScopeName = "downloader"
 	blobSizeMetricName      = "blob_size"
+	blobDeduplicatedMetric  = "deduplicated"
+
+	// The checksum of the uncompressed payload is stored as custom object metadata.
+	checksumMetadataKey = "checksum"
 )
 
 var _ internal.BlobStorage = (*blobStorageImpl)(nil)
@@ -88,9 +102,14 @@ func New(params BlobStorageParams) (internal.BlobStorage, error) {
 	if err != nil {
 		return nil, xerrors.Errorf("failed to create GCS client: %w", err)
 	}
+	codec, err := storage_utils.NewCodec(params.Config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create codec: %w", err)
+	}
 	blobStorageMetrics := &blobStorageMetrics{
-		blobDownloadedSize: metrics.SubScope(blobDownloaderScopeName).Timer(blobSizeMetricName),
-		blobUploadedSize:   metrics.SubScope(blobUploaderScopeName).Timer(blobSizeMetricName),
+		blobDownloadedSize:     metrics.SubScope(blobDownloaderScopeName).Timer(blobSizeMetricName),
+		blobUploadedSize:       metrics.SubScope(blobUploaderScopeName).Timer(blobSizeMetricName),
+		blobUploadDeduplicated: metrics.SubScope(blobUploaderScopeName).Counter(blobDeduplicatedMetric),
 	}
 	return &blobStorageImpl{
 		logger:                 log.WithPackage(params.Logger),
@@ -98,6 +117,7 @@ func New(params BlobStorageParams) (internal.BlobStorage, error) {
 		project:                params.Config.GCP.Project,
 		bucket:                 params.Config.GCP.Bucket,
 		client:                 client,
+		codec:                  codec,
 		presignedUrlExpiration: params.Config.GCP.PresignedUrlExpiration,
 		blobStorageMetrics:     blobStorageMetrics,
 		instrumentUpload:       instrument.NewWithResult[string](metrics, "upload"),
@@ -105,7 +125,7 @@ func New(params BlobStorageParams) (internal.BlobStorage, error) {
 	}, nil
 }
 
-func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compression api.Compression, opts ...internal.UploadOption) (string, error) {
 	return s.instrumentUpload.Instrument(ctx, func(ctx context.Context) (string, error) {
 		var key string
 		defer s.logDuration("upload", time.Now())
@@ -115,10 +135,11 @@ func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compress
 			return "", nil
 		}
 
-		data, err := proto.Marshal(block)
+		data, err := storage_utils.MarshalBlock(block)
 		if err != nil {
 			return "", xerrors.Errorf("failed to marshal block: %w", err)
 		}
+		blockChecksum := storage_utils.ComputeChecksum(data)
 
 		blockchainNetwork := fmt.Sprintf("%s/%s", block.Blockchain, block.Network)
 		tagHeightHash := fmt.Sprintf("%d/%d/%s", block.Metadata.Tag, block.Metadata.Height, block.Metadata.Hash)
@@ -132,31 +153,40 @@ func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compress
 			)
 		}
 
-		data, err = storage_utils.Compress(data, compression)
-		if err != nil {
-			return "", xerrors.Errorf("failed to compress data with type %v: %w", compression.String(), err)
-		}
 		key, err = storage_utils.GetObjectKey(key, compression)
 		if err != nil {
 			return "", xerrors.Errorf("failed to get object key: %w", err)
 		}
 
-		// #nosec G401
-		h := md5.New()
-		size, err := h.Write(data)
-		if err != nil {
-			return "", xerrors.Errorf("failed to compute checksum: %w", err)
-		}
+		object := s.client.Bucket(s.bucket).Object(key)
 
-		checksum := h.Sum(nil)
+		// Skip the upload if an identical payload has been uploaded, e.g. when a block is backfilled again.
+		// The object is only checked if the caller expects a re-upload, which saves the request on the regular ingestion.
+		if internal.NewUploadOptions(opts...).Deduplicate && s.isUploaded(ctx, object, blockChecksum) {
+			s.blobStorageMetrics.blobUploadDeduplicated.Inc(1)
+			block.Metadata.Checksum = blockChecksum
+			return key, nil
+		}
 
-		object := s.client.Bucket(s.bucket).Object(key)
 		w := object.NewWriter(ctx)
+		w.Metadata = map[string]string{
+			checksumMetadataKey: blockChecksum,
+		}
 		finalizer := finalizer.WithCloser(w)
 		defer finalizer.Finalize()
 
-		_, err = w.Write(data)
+		// The data is compressed as it is being uploaded, while the md5 and the size are computed along the way.
+		// #nosec G401
+		h := md5.New()
+		counter := &countingWriter{}
+		cw, err := s.codec.NewCompressWriter(io.MultiWriter(w, h, counter), compression)
 		if err != nil {
+			return "", xerrors.Errorf("failed to compress data with type %v: %w", compression.String(), err)
+		}
+		if _, err := cw.Write(data); err != nil {
+			return "", xerrors.Errorf("failed to upload block data: %w", err)
+		}
+		if err := cw.Close(); err != nil {
 			return "", xerrors.Errorf("failed to upload block data: %w", err)
 		}
 		err = finalizer.Close()
@@ -164,10 +194,9 @@ func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compress
 			return "", xerrors.Errorf("failed to upload block data: %w", err)
 		}
 
+		checksum := h.Sum(nil)
+		size := counter.size
 		attrs := w.Attrs()
-		if err != nil {
-			return "", xerrors.Errorf("failed to load attributes for uploaded block data: %w", err)
-		}
 		if !bytes.Equal(checksum, attrs.MD5) {
 			return "", xerrors.Errorf("uploaded block md5 checksum %x is different from expected %x", attrs.MD5, checksum)
 		}
@@ -175,6 +204,7 @@ func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compress
 		// a workaround to use timer
 		s.blobStorageMetrics.blobUploadedSize.Record(time.Duration(size) * time.Millisecond)
 
+		block.Metadata.Checksum = blockChecksum
 		return key, nil
 	})
 }
@@ -203,34 +233,37 @@ func (s *blobStorageImpl) Download(ctx context.Context, metadata *api.BlockMetad
 		if err != nil {
 			return nil, xerrors.Errorf("failed to download from gcs (bucket=%s, key=%s): %w", s.bucket, key, err)
 		}
-		buf, err := io.ReadAll(reader)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to download from gcs (bucket=%s, key=%s): %w", s.bucket, key, err)
-		}
-
-		// a workaround to use timer
-		s.blobStorageMetrics.blobDownloadedSize.Record(time.Duration(len(buf)) * time.Millisecond)
 
+		// The object is decompressed as it is being downloaded, instead of being buffered as a whole.
+		body := &countingReader{reader: reader}
 		compression := storage_utils.GetCompressionType(key)
-		blockData, err := storage_utils.Decompress(buf, compression)
+		block, err := s.codec.ReadBlock(body, compression, metadata.Checksum)
 		if err != nil {
-			return nil, xerrors.Errorf("failed to decompress block data with type %v: %w", compression.String(), err)
+			return nil, xerrors.Errorf("failed to read data downloaded from gcs (bucket=%s, key=%s): %w", s.bucket, key, err)
 		}
 
-		var block api.Block
-		err = proto.Unmarshal(blockData, &block)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to unmarshal data downloaded from s3 bucket %s key %s: %w", s.bucket, key, err)
-		}
+		// a workaround to use timer
+		s.blobStorageMetrics.blobDownloadedSize.Record(time.Duration(body.size) * time.Millisecond)
 
 		// When metadata is loaded from meta storage,
 		// the new fields, e.g. ParentHeight, may be populated with default values.
 		// Overwrite metadata using the one loaded from meta storage.
 		block.Metadata = metadata
-		return &block, finalizer.Close()
+		return block, finalizer.Close()
 	})
 }
 
+// isUploaded returns true if the object exists and its payload has the same checksum.
+// Any error is ignored since the object is simply uploaded again.
+func (s *blobStorageImpl) isUploaded(ctx context.Context, object *storage.ObjectHandle, checksum string) bool {
+	attrs, err := object.Attrs(ctx)
+	if err != nil {
+		return false
+	}
+
+	return attrs.Metadata[checksumMetadataKey] == checksum
+}
+
 // PreSign implements internal.BlobStorage.
 func (s *blobStorageImpl) PreSign(ctx context.Context, objectKey string) (string, error) {
 	fileUrl, err := s.client.Bucket(s.bucket).SignedURL(objectKey, &storage.SignedURLOptions{
@@ -251,3 +284,14 @@ func (s *blobStorageImpl) logDuration(method string, start time.Time) {
 		zap.Duration("duration", time.Since(start)),
 	)
 }
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += n
+	return n, err
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.size += len(p)
+	return len(p), nil
+}
@@ -13,11 +13,21 @@ import (
 
 type (
 	BlobStorage interface {
-		Upload(ctx context.Context, block *api.Block, compression api.Compression) (string, error)
+		// Upload uploads the block and returns the object key.
+		// The checksum of the uploaded payload is populated in the block metadata.
+		Upload(ctx context.Context, block *api.Block, compression api.Compression, opts ...UploadOption) (string, error)
 		Download(ctx context.Context, metadata *api.BlockMetadata) (*api.Block, error)
 		PreSign(ctx context.Context, objectKey string) (string, error)
 	}
 
+	UploadOption func(options *UploadOptions)
+
+	UploadOptions struct {
+		// Deduplicate checks whether an identical payload has been uploaded before uploading the block.
+		// Since the check costs an extra request, it is only enabled when a re-upload is likely.
+		Deduplicate bool
+	}
+
 	BlobStorageFactory interface {
 		Create() (BlobStorage, error)
 	}
@@ -25,8 +35,9 @@ type (
 	BlobStorageFactoryParams struct {
 		fx.In
 		fxparams.Params
-		S3  BlobStorageFactory `name:"blobstorage/s3"`
-		GCS BlobStorageFactory `name:"blobstorage/gcs"`
+		S3         BlobStorageFactory `name:"blobstorage/s3"`
+		GCS        BlobStorageFactory `name:"blobstorage/gcs"`
+		Filesystem BlobStorageFactory `name:"blobstorage/filesystem"`
 	}
 )
 
@@ -38,6 +49,8 @@ func WithBlobStorageFactory(params BlobStorageFactoryParams) (BlobStorage, error
 		factory = params.S3
 	case config.BlobStorageType_GCS:
 		factory = params.GCS
+	case config.BlobStorageType_FILESYSTEM:
+		factory = params.Filesystem
 	}
 	if factory == nil {
 		return nil, xerrors.Errorf("blob storage type is not implemented: %v", storageType)
@@ -48,3 +61,21 @@ func WithBlobStorageFactory(params BlobStorageFactoryParams) (BlobStorage, error
 	}
 	return result, nil
 }
+
+// WithDeduplication skips the upload if the object already exists with the same checksum,
+// e.g. when a block is backfilled or reprocessed again.
+func WithDeduplication() UploadOption {
+	return func(options *UploadOptions) {
+		options.Deduplicate = true
+	}
+}
+
+// NewUploadOptions applies the options on top of the defaults.
+func NewUploadOptions(opts ...UploadOption) *UploadOptions {
+	options := &UploadOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
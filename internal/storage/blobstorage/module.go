@@ -3,6 +3,7 @@ package blobstorage
 import (
 	"go.uber.org/fx"
 
+	"github.com/coinbase/chainstorage/internal/storage/blobstorage/filesystem"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage/gcs"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage/internal"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage/s3"
@@ -12,10 +13,16 @@ type (
 	BlobStorage              = internal.BlobStorage
 	BlobStorageFactory       = internal.BlobStorageFactory
 	BlobStorageFactoryParams = internal.BlobStorageFactoryParams
+	UploadOption             = internal.UploadOption
 )
 
 var Module = fx.Options(
 	fx.Provide(internal.WithBlobStorageFactory),
 	s3.Module,
 	gcs.Module,
+	filesystem.Module,
 )
+
+func WithDeduplication() UploadOption {
+	return internal.WithDeduplication()
+}
@@ -6,6 +6,8 @@ import (
 	"crypto/md5" // #nosec G501
 	"encoding/base64"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +26,7 @@ import (
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage/internal"
 	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
 	storage_utils "github.com/coinbase/chainstorage/internal/storage/utils"
+	"github.com/coinbase/chainstorage/internal/utils/finalizer"
 	"github.com/coinbase/chainstorage/internal/utils/fxparams"
 	"github.com/coinbase/chainstorage/internal/utils/instrument"
 	"github.com/coinbase/chainstorage/internal/utils/log"
@@ -34,9 +37,8 @@ type (
 	BlobStorageParams struct {
 		fx.In
 		fxparams.Params
-		Client     s3.Client
-		Downloader s3.Downloader
-		Uploader   s3.Uploader
+		Client   s3.Client
+		Uploader s3.Uploader
 	}
 
 	blobStorageFactory struct {
@@ -44,20 +46,27 @@ type (
 	}
 
 	blobStorageImpl struct {
-		logger             *zap.Logger
-		config             *config.Config
-		bucket             string
-		client             s3.Client
-		downloader         s3.Downloader
-		uploader           s3.Uploader
-		blobStorageMetrics *blobStorageMetrics
-		instrumentUpload   instrument.InstrumentWithResult[string]
-		instrumentDownload instrument.InstrumentWithResult[*api.Block]
+		logger                   *zap.Logger
+		config                   *config.Config
+		bucket                   string
+		client                   s3.Client
+		uploader                 s3.Uploader
+		codec                    *storage_utils.Codec
+		blobStorageMetrics       *blobStorageMetrics
+		instrumentUpload         instrument.InstrumentWithResult[string]
+		instrumentDownload       instrument.InstrumentWithResult[*api.Block]
+		multipartUploadThreshold int
+	}
+
+	countingReader struct {
+		reader io.Reader
+		size   int
 	}
 
 	blobStorageMetrics struct {
-		blobDownloadedSize tally.Timer
-		blobUploadedSize   tally.Timer
+		blobDownloadedSize     tally.Timer
+		blobUploadedSize       tally.Timer
+		blobUploadDeduplicated tally.Counter
 	}
 )
 
@@ -65,9 +74,18 @@ const (
 	blobUploaderScopeName   = "uploader"
 	blobDownloaderScopeName = "downloader"
 	blobSizeMetricName      = "blob_size"
+	blobDeduplicatedMetric  = "deduplicated"
+
+	// The checksum of the uncompressed payload is stored as user-defined object metadata.
+	checksumMetadataKey = "Checksum"
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/acl-overview.html#CannedACL
 	bucketOwnerFullControl = "bucket-owner-full-control"
+
+	// Payloads larger than the threshold, e.g. solana blocks or ethereum blocks with traces,
+	// are compressed on the fly and uploaded in parts, instead of being compressed into another buffer.
+	multipartUploadThreshold = 32 * 1024 * 1024
+	multipartUploadPartSize  = 16 * 1024 * 1024
 )
 
 var _ internal.BlobStorage = (*blobStorageImpl)(nil)
@@ -85,27 +103,33 @@ func New(params BlobStorageParams) (internal.BlobStorage, error) {
 	metrics := params.Metrics.SubScope("blob_storage").Tagged(map[string]string{
 		"storage_type": "s3",
 	})
+	codec, err := storage_utils.NewCodec(params.Config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create codec: %w", err)
+	}
 	return &blobStorageImpl{
-		logger:             log.WithPackage(params.Logger),
-		config:             params.Config,
-		bucket:             params.Config.AWS.Bucket,
-		client:             params.Client,
-		downloader:         params.Downloader,
-		uploader:           params.Uploader,
-		blobStorageMetrics: newBlobStorageMetrics(metrics),
-		instrumentUpload:   instrument.NewWithResult[string](metrics, "upload"),
-		instrumentDownload: instrument.NewWithResult[*api.Block](metrics, "download"),
+		logger:                   log.WithPackage(params.Logger),
+		config:                   params.Config,
+		bucket:                   params.Config.AWS.Bucket,
+		client:                   params.Client,
+		uploader:                 params.Uploader,
+		codec:                    codec,
+		blobStorageMetrics:       newBlobStorageMetrics(metrics),
+		instrumentUpload:         instrument.NewWithResult[string](metrics, "upload"),
+		instrumentDownload:       instrument.NewWithResult[*api.Block](metrics, "download"),
+		multipartUploadThreshold: multipartUploadThreshold,
 	}, nil
 }
 
 func newBlobStorageMetrics(scope tally.Scope) *blobStorageMetrics {
 	return &blobStorageMetrics{
-		blobDownloadedSize: scope.SubScope(blobDownloaderScopeName).Timer(blobSizeMetricName),
-		blobUploadedSize:   scope.SubScope(blobUploaderScopeName).Timer(blobSizeMetricName),
+		blobDownloadedSize:     scope.SubScope(blobDownloaderScopeName).Timer(blobSizeMetricName),
+		blobUploadedSize:       scope.SubScope(blobUploaderScopeName).Timer(blobSizeMetricName),
+		blobUploadDeduplicated: scope.SubScope(blobUploaderScopeName).Counter(blobDeduplicatedMetric),
 	}
 }
 
-func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compression api.Compression, opts ...internal.UploadOption) (string, error) {
 	return s.instrumentUpload.Instrument(ctx, func(ctx context.Context) (string, error) {
 		var key string
 		defer s.logDuration("upload", time.Now())
@@ -115,9 +139,25 @@ func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compress
 			return "", nil
 		}
 
-		data, err := proto.Marshal(block)
-		if err != nil {
-			return "", xerrors.Errorf("failed to marshal block: %w", err)
+		// The large blocks are uploaded in parts. Their payload is hashed in a first pass and then marshaled straight
+		// into the compressor while it is being uploaded, so that it is never held in memory as a whole.
+		var (
+			data     []byte
+			checksum string
+			err      error
+		)
+		multipart := proto.Size(block) > s.multipartUploadThreshold
+		if multipart {
+			checksum, err = storage_utils.ComputeBlockChecksum(block)
+			if err != nil {
+				return "", xerrors.Errorf("failed to compute checksum: %w", err)
+			}
+		} else {
+			data, err = storage_utils.MarshalBlock(block)
+			if err != nil {
+				return "", xerrors.Errorf("failed to marshal block: %w", err)
+			}
+			checksum = storage_utils.ComputeChecksum(data)
 		}
 
 		blockchainNetwork := fmt.Sprintf("%s/%s", block.Blockchain, block.Network)
@@ -132,37 +172,33 @@ func (s *blobStorageImpl) Upload(ctx context.Context, block *api.Block, compress
 			)
 		}
 
-		data, err = storage_utils.Compress(data, compression)
-		if err != nil {
-			return "", xerrors.Errorf("failed to compress data with type %v: %w", compression.String(), err)
-		}
 		key, err = storage_utils.GetObjectKey(key, compression)
 		if err != nil {
 			return "", xerrors.Errorf("failed to get object key: %w", err)
 		}
 
-		// #nosec G401
-		h := md5.New()
-		size, err := h.Write(data)
-		if err != nil {
-			return "", xerrors.Errorf("failed to compute checksum: %w", err)
+		// Skip the upload if an identical payload has been uploaded, e.g. when a block is backfilled again.
+		// The object is only checked if the caller expects a re-upload, which saves the request on the regular ingestion.
+		if internal.NewUploadOptions(opts...).Deduplicate && s.isUploaded(ctx, key, checksum) {
+			s.blobStorageMetrics.blobUploadDeduplicated.Inc(1)
+			block.Metadata.Checksum = checksum
+			return key, nil
 		}
 
-		checksum := base64.StdEncoding.EncodeToString(h.Sum(nil))
-
-		if _, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
-			Bucket:     aws.String(s.bucket),
-			Key:        aws.String(key),
-			Body:       bytes.NewReader(data),
-			ContentMD5: aws.String(checksum),
-			ACL:        aws.String(bucketOwnerFullControl),
-		}); err != nil {
+		var size int
+		if multipart {
+			size, err = s.uploadMultipart(ctx, key, block, compression, checksum)
+		} else {
+			size, err = s.upload(ctx, key, data, compression, checksum)
+		}
+		if err != nil {
 			return "", xerrors.Errorf("failed to upload to s3: %w", err)
 		}
 
 		// a workaround to use timer
 		s.blobStorageMetrics.blobUploadedSize.Record(time.Duration(size) * time.Millisecond)
 
+		block.Metadata.Checksum = checksum
 		return key, nil
 	})
 }
@@ -183,9 +219,9 @@ func (s *blobStorageImpl) Download(ctx context.Context, metadata *api.BlockMetad
 		}
 
 		key := metadata.ObjectKeyMain
-		buf := aws.NewWriteAtBuffer([]byte{})
 
-		size, err := s.downloader.DownloadWithContext(ctx, buf, &awss3.GetObjectInput{
+		// The object is decompressed as it is being downloaded, instead of being buffered as a whole.
+		output, err := s.client.GetObjectWithContext(ctx, &awss3.GetObjectInput{
 			Bucket: aws.String(s.bucket),
 			Key:    aws.String(key),
 		})
@@ -196,29 +232,108 @@ func (s *blobStorageImpl) Download(ctx context.Context, metadata *api.BlockMetad
 			return nil, xerrors.Errorf("failed to download from s3 (bucket=%s, key=%s): %w", s.bucket, key, err)
 		}
 
-		// a workaround to use timer
-		s.blobStorageMetrics.blobDownloadedSize.Record(time.Duration(size) * time.Millisecond)
+		finalizer := finalizer.WithCloser(output.Body)
+		defer finalizer.Finalize()
 
+		body := &countingReader{reader: output.Body}
 		compression := storage_utils.GetCompressionType(key)
-		blockData, err := storage_utils.Decompress(buf.Bytes(), compression)
+		block, err := s.codec.ReadBlock(body, compression, metadata.Checksum)
 		if err != nil {
-			return nil, xerrors.Errorf("failed to decompress block data with type %v: %w", compression.String(), err)
+			return nil, xerrors.Errorf("failed to read data downloaded from s3 bucket %s key %s: %w", s.bucket, key, err)
 		}
 
-		var block api.Block
-		err = proto.Unmarshal(blockData, &block)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to unmarshal data downloaded from s3 bucket %s key %s: %w", s.bucket, key, err)
-		}
+		// a workaround to use timer
+		s.blobStorageMetrics.blobDownloadedSize.Record(time.Duration(body.size) * time.Millisecond)
 
 		// When metadata is loaded from meta storage,
 		// the new fields, e.g. ParentHeight, may be populated with default values.
 		// Overwrite metadata using the one loaded from meta storage.
 		block.Metadata = metadata
-		return &block, nil
+		return block, finalizer.Close()
 	})
 }
 
+func (s *blobStorageImpl) upload(ctx context.Context, key string, data []byte, compression api.Compression, checksum string) (int, error) {
+	data, err := s.codec.Compress(data, compression)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to compress data with type %v: %w", compression.String(), err)
+	}
+
+	// #nosec G401
+	h := md5.New()
+	size, err := h.Write(data)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to compute checksum: %w", err)
+	}
+
+	contentMD5 := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	if _, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:     aws.String(s.bucket),
+		Key:        aws.String(key),
+		Body:       bytes.NewReader(data),
+		ContentMD5: aws.String(contentMD5),
+		ACL:        aws.String(bucketOwnerFullControl),
+		Metadata: map[string]*string{
+			checksumMetadataKey: aws.String(checksum),
+		},
+	}); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// uploadMultipart marshals and compresses the block while it is being uploaded in parts,
+// so that neither the payload nor the compressed object is held in memory as a whole.
+// Since ContentMD5 is not applicable to the object as a whole, the integrity is verified by the checksum instead.
+func (s *blobStorageImpl) uploadMultipart(ctx context.Context, key string, block *api.Block, compression api.Compression, checksum string) (int, error) {
+	reader, err := s.codec.NewBlockReader(block, compression)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to compress data with type %v: %w", compression.String(), err)
+	}
+
+	finalizer := finalizer.WithCloser(reader)
+	defer finalizer.Finalize()
+
+	body := &countingReader{reader: reader}
+	if _, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   body,
+		ACL:    aws.String(bucketOwnerFullControl),
+		Metadata: map[string]*string{
+			checksumMetadataKey: aws.String(checksum),
+		},
+	}, func(uploader *s3manager.Uploader) {
+		uploader.PartSize = multipartUploadPartSize
+	}); err != nil {
+		return 0, err
+	}
+
+	return body.size, finalizer.Close()
+}
+
+// isUploaded returns true if the object exists and its payload has the same checksum.
+// Any error is ignored since the object is simply uploaded again.
+func (s *blobStorageImpl) isUploaded(ctx context.Context, key string, checksum string) bool {
+	output, err := s.client.HeadObjectWithContext(ctx, &awss3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return false
+	}
+
+	for k, v := range output.Metadata {
+		if strings.EqualFold(k, checksumMetadataKey) {
+			return aws.StringValue(v) == checksum
+		}
+	}
+
+	return false
+}
+
 func (s *blobStorageImpl) PreSign(ctx context.Context, objectKey string) (string, error) {
 	getObjectReq, _ := s.client.GetObjectRequest(&awss3.GetObjectInput{
 		Bucket: aws.String(s.config.AWS.Bucket),
@@ -239,3 +354,9 @@ func (s *blobStorageImpl) logDuration(method string, start time.Time) {
 		zap.Duration("duration", time.Since(start)),
 	)
 }
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += n
+	return n, err
+}
@@ -1,23 +1,30 @@
 package s3
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 	awss3 "github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"go.uber.org/fx"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/coinbase/chainstorage/internal/blockchain/jsonrpc"
 	"github.com/coinbase/chainstorage/internal/s3"
 	s3mocks "github.com/coinbase/chainstorage/internal/s3/mocks"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage/internal"
 	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	storage_utils "github.com/coinbase/chainstorage/internal/storage/utils"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
 	"github.com/coinbase/chainstorage/internal/utils/testapp"
 	"github.com/coinbase/chainstorage/internal/utils/testutil"
 	"github.com/coinbase/chainstorage/protos/coinbase/c3/common"
@@ -26,24 +33,12 @@ import (
 
 func TestBlobStorage_NoCompression(t *testing.T) {
 	const expectedObjectKey = "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde"
-	const expectedObjectSize = int64(12432)
 
 	require := testutil.Require(t)
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	downloader := s3mocks.NewMockDownloader(ctrl)
-	downloader.EXPECT().DownloadWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, writer io.WriterAt, input *awss3.GetObjectInput, opts ...jsonrpc.Option) (int64, error) {
-			require.NotNil(input.Bucket)
-			require.NotEmpty(*input.Bucket)
-			require.NotNil(input.Key)
-			require.Equal(expectedObjectKey, *input.Key)
-
-			return expectedObjectSize, nil
-		})
-
 	uploader := s3mocks.NewMockUploader(ctrl)
 	uploader.EXPECT().UploadWithContext(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, input *s3manager.UploadInput, opts ...jsonrpc.Option) (*s3manager.UploadOutput, error) {
@@ -53,16 +48,27 @@ func TestBlobStorage_NoCompression(t *testing.T) {
 			require.Equal(expectedObjectKey, *input.Key)
 			require.NotNil(input.ContentMD5)
 			require.NotEmpty(*input.ContentMD5)
+			require.NotEmpty(aws.StringValue(input.Metadata[checksumMetadataKey]))
 
 			return &s3manager.UploadOutput{}, nil
 		})
 	client := s3mocks.NewMockClient(ctrl)
+	client.EXPECT().GetObjectWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *awss3.GetObjectInput, opts ...request.Option) (*awss3.GetObjectOutput, error) {
+			require.NotNil(input.Bucket)
+			require.NotEmpty(*input.Bucket)
+			require.NotNil(input.Key)
+			require.Equal(expectedObjectKey, *input.Key)
+
+			return &awss3.GetObjectOutput{
+				Body: io.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		})
 
 	var storage internal.BlobStorage
 	app := testapp.New(
 		t,
 		fx.Provide(New),
-		fx.Provide(func() s3.Downloader { return downloader }),
 		fx.Provide(func() s3.Uploader { return uploader }),
 		fx.Provide(func() s3.Client { return client }),
 		fx.Populate(&storage),
@@ -93,6 +99,171 @@ func TestBlobStorage_NoCompression(t *testing.T) {
 	require.NotNil(block)
 }
 
+func TestBlobStorage_Deduplicated(t *testing.T) {
+	const expectedObjectKey = "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde"
+
+	require := testutil.Require(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:    1,
+			Height: 12345,
+			Hash:   "0xabcde",
+		},
+	}
+	data, err := storage_utils.MarshalBlock(block)
+	require.NoError(err)
+	checksum := storage_utils.ComputeChecksum(data)
+
+	uploader := s3mocks.NewMockUploader(ctrl)
+	client := s3mocks.NewMockClient(ctrl)
+	client.EXPECT().HeadObjectWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *awss3.HeadObjectInput, opts ...request.Option) (*awss3.HeadObjectOutput, error) {
+			require.Equal(expectedObjectKey, aws.StringValue(input.Key))
+
+			return &awss3.HeadObjectOutput{
+				Metadata: map[string]*string{
+					checksumMetadataKey: aws.String(checksum),
+				},
+			}, nil
+		})
+
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		fx.Provide(func() s3.Uploader { return uploader }),
+		fx.Provide(func() s3.Client { return client }),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	// The object is only checked when the deduplication is requested, e.g. by the backfiller.
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_NONE, internal.WithDeduplication())
+	require.NoError(err)
+	require.Equal(expectedObjectKey, objectKey)
+	require.Equal(checksum, block.Metadata.Checksum)
+}
+
+func TestBlobStorage_ChecksumMismatch(t *testing.T) {
+	require := testutil.Require(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploader := s3mocks.NewMockUploader(ctrl)
+	client := s3mocks.NewMockClient(ctrl)
+	client.EXPECT().GetObjectWithContext(gomock.Any(), gomock.Any()).
+		Return(&awss3.GetObjectOutput{
+			Body: io.NopCloser(bytes.NewReader([]byte("truncated"))),
+		}, nil)
+
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		fx.Provide(func() s3.Uploader { return uploader }),
+		fx.Provide(func() s3.Client { return client }),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	_, err := storage.Download(context.Background(), &api.BlockMetadata{
+		Tag:           1,
+		Height:        12345,
+		Hash:          "0xabcde",
+		ObjectKeyMain: "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde",
+		Checksum:      storage_utils.ComputeChecksum([]byte("expected")),
+	})
+	require.Error(err)
+	require.True(xerrors.Is(err, errors.ErrChecksumMismatch))
+}
+
+func TestBlobStorage_MultipartUpload(t *testing.T) {
+	const expectedObjectKey = "BLOCKCHAIN_ETHEREUM/NETWORK_ETHEREUM_MAINNET/1/12345/0xabcde.zstd"
+
+	require := testutil.Require(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	block := &api.Block{
+		Blockchain: common.Blockchain_BLOCKCHAIN_ETHEREUM,
+		Network:    common.Network_NETWORK_ETHEREUM_MAINNET,
+		Metadata: &api.BlockMetadata{
+			Tag:    1,
+			Height: 12345,
+			Hash:   "0xabcde",
+		},
+		Blobdata: &api.Block_Ethereum{
+			Ethereum: &api.EthereumBlobdata{
+				Header: fixtures.MustReadFile("client/polygon/polygon_getblockbynumber.json"),
+			},
+		},
+	}
+	data, err := storage_utils.MarshalBlock(block)
+	require.NoError(err)
+
+	var uploaded []byte
+	uploader := s3mocks.NewMockUploader(ctrl)
+	uploader.EXPECT().UploadWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+			require.Equal(expectedObjectKey, aws.StringValue(input.Key))
+			require.Nil(input.ContentMD5)
+			require.Equal(storage_utils.ComputeChecksum(data), aws.StringValue(input.Metadata[checksumMetadataKey]))
+
+			var options s3manager.Uploader
+			for _, opt := range opts {
+				opt(&options)
+			}
+			require.Equal(int64(multipartUploadPartSize), options.PartSize)
+
+			uploaded, err = io.ReadAll(input.Body)
+			require.NoError(err)
+			return &s3manager.UploadOutput{}, nil
+		})
+	client := s3mocks.NewMockClient(ctrl)
+	client.EXPECT().GetObjectWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *awss3.GetObjectInput, opts ...request.Option) (*awss3.GetObjectOutput, error) {
+			require.Equal(expectedObjectKey, aws.StringValue(input.Key))
+			return &awss3.GetObjectOutput{
+				Body: io.NopCloser(bytes.NewReader(uploaded)),
+			}, nil
+		})
+
+	var storage internal.BlobStorage
+	app := testapp.New(
+		t,
+		fx.Provide(New),
+		fx.Provide(func() s3.Uploader { return uploader }),
+		fx.Provide(func() s3.Client { return client }),
+		fx.Populate(&storage),
+	)
+	defer app.Close()
+
+	// Lower the threshold so that the block is uploaded in parts.
+	storage.(*blobStorageImpl).multipartUploadThreshold = len(data) - 1
+
+	objectKey, err := storage.Upload(context.Background(), block, api.Compression_ZSTD)
+	require.NoError(err)
+	require.Equal(expectedObjectKey, objectKey)
+
+	decompressed, err := storage.(*blobStorageImpl).codec.Decompress(uploaded, api.Compression_ZSTD)
+	require.NoError(err)
+	require.Equal(data, decompressed)
+
+	metadata := proto.Clone(block.Metadata).(*api.BlockMetadata)
+	metadata.ObjectKeyMain = objectKey
+	actual, err := storage.Download(context.Background(), metadata)
+	require.NoError(err)
+	require.Equal(block.GetEthereum().GetHeader(), actual.GetEthereum().GetHeader())
+}
+
 //TODO: add TestBlobStorage_NoCompression_WithSidechain
 
 func TestBlobStorage_NoCompression_SkippedBlock(t *testing.T) {
@@ -102,7 +273,6 @@ func TestBlobStorage_NoCompression_SkippedBlock(t *testing.T) {
 	app := testapp.New(
 		t,
 		fx.Provide(New),
-		fx.Provide(func() s3.Downloader { return nil }),
 		fx.Provide(func() s3.Uploader { return nil }),
 		fx.Provide(func() s3.Client { return nil }),
 		fx.Populate(&storage),
@@ -141,14 +311,12 @@ func TestBlobStorage_DownloadErrRequestCanceled(t *testing.T) {
 	defer ctrl.Finish()
 
 	uploader := s3mocks.NewMockUploader(ctrl)
-	downloader := s3manager.NewDownloader(unit.Session)
-	client := s3mocks.NewMockClient(ctrl)
+	client := awss3.New(unit.Session)
 
 	var blobStorage internal.BlobStorage
 	app := testapp.New(
 		t,
 		fx.Provide(New),
-		fx.Provide(func() s3.Downloader { return downloader }),
 		fx.Provide(func() s3.Uploader { return uploader }),
 		fx.Provide(func() s3.Client { return client }),
 		fx.Populate(&blobStorage),
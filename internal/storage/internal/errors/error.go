@@ -12,4 +12,5 @@ var (
 	ErrNoEventHistory    = xerrors.New("no event history")
 	ErrNoEventAvailable  = xerrors.New("no event available")
 	ErrNoMaxEventIdFound = xerrors.New("no max event id found")
+	ErrChecksumMismatch  = xerrors.New("checksum mismatch")
 )
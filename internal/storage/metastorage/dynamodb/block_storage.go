@@ -284,6 +284,7 @@ func makeBlockMetaDataDDBEntry(block *api.BlockMetadata) *model.BlockMetaDataDDB
 		ObjectKeyMain: block.ObjectKeyMain,
 		Skipped:       block.Skipped,
 		Timestamp:     block.GetTimestamp().GetSeconds(),
+		Checksum:      block.Checksum,
 	}
 	return &blockMetaDataDDBEntry
 }
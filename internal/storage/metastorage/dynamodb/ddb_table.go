@@ -69,6 +69,8 @@ type (
 		ExpressionAttributeValues map[string]*dynamodb.AttributeValue
 		IndexName                 string
 		ConsistentRead            bool
+		// Limit is the maximum number of items to return, or zero if all the items are returned.
+		Limit int64
 	}
 
 	StringMap map[string]interface{}
@@ -328,6 +330,10 @@ func (d *ddbTableImpl) QueryItems(ctx context.Context, req *QueryItemsRequest) (
 	outputItems := make([]any, 0)
 	iterations := 0
 	for true {
+		if req.Limit > 0 {
+			queryInput.Limit = aws.Int64(req.Limit - int64(len(outputItems)))
+		}
+
 		queryOutput, err := d.table.DBAPI.QueryWithContext(ctx, queryInput)
 		if err != nil {
 			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == request.CanceledErrorCode {
@@ -351,6 +357,9 @@ func (d *ddbTableImpl) QueryItems(ctx context.Context, req *QueryItemsRequest) (
 		if len(queryOutput.LastEvaluatedKey) == 0 {
 			break
 		}
+		if req.Limit > 0 && int64(len(outputItems)) >= req.Limit {
+			break
+		}
 		queryInput.ExclusiveStartKey = queryOutput.LastEvaluatedKey
 
 		iterations += 1
@@ -51,9 +51,10 @@ func NewMetaStorage(params Params) (internal.Result, error) {
 	}
 
 	return internal.Result{
-		BlockStorage: blockStorage,
-		EventStorage: eventStorage,
-		MetaStorage:  metaStorage,
+		BlockStorage:       blockStorage,
+		EventStorage:       eventStorage,
+		MetaStorage:        metaStorage,
+		TransactionStorage: transactionStorage,
 	}, nil
 }
 
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/model"
+)
+
+const (
+	AddressTransactionPidKeyName  = "address_pid"
+	AddressTransactionSortKeyName = "address_rid"
+
+	// The block number is zero-padded so that the sort keys are ordered by block number.
+	addressTransactionSortKeyFormat = "%020d#%v#%v"
+	addressTransactionHeightFormat  = "%020d"
+)
+
+type (
+	AddressTransactionDDBEntry struct {
+		AddressPid      string `dynamodbav:"address_pid"`
+		AddressRid      string `dynamodbav:"address_rid"`
+		Address         string `dynamodbav:"address"`
+		TransactionHash string `dynamodbav:"transaction_hash"`
+		BlockHash       string `dynamodbav:"block_hash"`
+		BlockNumber     uint64 `dynamodbav:"block_number"`
+		BlockTag        uint32 `dynamodbav:"block_tag"`
+	}
+)
+
+func NewAddressTransactionDDBEntry(addressTransaction *model.AddressTransaction) *AddressTransactionDDBEntry {
+	return &AddressTransactionDDBEntry{
+		AddressPid:      MakeAddressTransactionPartitionKey(addressTransaction.BlockTag, addressTransaction.Address),
+		AddressRid:      MakeAddressTransactionSortKey(addressTransaction),
+		Address:         addressTransaction.Address,
+		TransactionHash: addressTransaction.TransactionHash,
+		BlockHash:       addressTransaction.BlockHash,
+		BlockNumber:     addressTransaction.BlockNumber,
+		BlockTag:        addressTransaction.BlockTag,
+	}
+}
+
+func MakeAddressTransactionPartitionKey(tag uint32, address string) string {
+	return fmt.Sprintf(PartitionKeyFormat, tag, address)
+}
+
+func MakeAddressTransactionSortKey(addressTransaction *model.AddressTransaction) string {
+	return fmt.Sprintf(
+		addressTransactionSortKeyFormat,
+		addressTransaction.BlockNumber,
+		addressTransaction.TransactionHash,
+		addressTransaction.BlockHash,
+	)
+}
+
+// MakeAddressTransactionSortKeyRange returns the inclusive range of sort keys covering the heights [startHeight, endHeight).
+// The caller must ensure that startHeight < endHeight.
+func MakeAddressTransactionSortKeyRange(startHeight uint64, endHeight uint64) (string, string) {
+	// '$' is ordered right after '#', hence the upper bound covers every sort key of the last height.
+	return fmt.Sprintf(addressTransactionHeightFormat, startHeight),
+		fmt.Sprintf(addressTransactionHeightFormat+"$", endHeight-1)
+}
+
+func TransformToAddressTransaction(entry *AddressTransactionDDBEntry) (*model.AddressTransaction, error) {
+	if entry.Address == "" {
+		return nil, xerrors.Errorf("address is empty for returned ddb item(%+v)", entry)
+	}
+	if entry.TransactionHash == "" {
+		return nil, xerrors.Errorf("transaction hash is empty for returned ddb item(%+v)", entry)
+	}
+	if entry.BlockHash == "" {
+		return nil, xerrors.Errorf("block hash is empty for returned ddb item(%+v)", entry)
+	}
+
+	return &model.AddressTransaction{
+		Address:         entry.Address,
+		TransactionHash: entry.TransactionHash,
+		BlockNumber:     entry.BlockNumber,
+		BlockHash:       entry.BlockHash,
+		BlockTag:        entry.BlockTag,
+	}, nil
+}
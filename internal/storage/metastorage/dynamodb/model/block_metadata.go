@@ -16,6 +16,7 @@ type BlockMetaDataDDBEntry struct {
 	ObjectKeyMain string `dynamodbav:"object_key_main"`
 	Skipped       bool   `dynamodbav:"skipped"`
 	Timestamp     int64  `dynamodbav:"timestamp"`
+	Checksum      string `dynamodbav:"checksum"`
 }
 
 func BlockMetadataToProto(bm *BlockMetaDataDDBEntry) *api.BlockMetadata {
@@ -28,6 +29,7 @@ func BlockMetadataToProto(bm *BlockMetaDataDDBEntry) *api.BlockMetadata {
 		ObjectKeyMain: bm.ObjectKeyMain,
 		Skipped:       bm.Skipped,
 		Timestamp:     utils.ToTimestamp(bm.Timestamp),
+		Checksum:      bm.Checksum,
 	}
 
 	// Set parent height if it is not present,
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 	"golang.org/x/xerrors"
 
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
 	ddbmodel "github.com/coinbase/chainstorage/internal/storage/metastorage/dynamodb/model"
 	"github.com/coinbase/chainstorage/internal/storage/metastorage/internal"
 	"github.com/coinbase/chainstorage/internal/storage/metastorage/model"
@@ -17,9 +18,12 @@ import (
 
 type (
 	transactionStorageImpl struct {
-		transactionTable                 ddbTable
-		instrumentAddOrUpdateTransaction instrument.Instrument
-		instrumentGetTransaction         instrument.InstrumentWithResult[[]*model.Transaction]
+		transactionTable                   ddbTable
+		addressTable                       ddbTable
+		instrumentAddOrUpdateTransaction   instrument.Instrument
+		instrumentGetTransaction           instrument.InstrumentWithResult[[]*model.Transaction]
+		instrumentAddAddressTransactions   instrument.Instrument
+		instrumentGetTransactionsByAddress instrument.InstrumentWithResult[[]*model.AddressTransaction]
 	}
 )
 
@@ -57,14 +61,48 @@ func newTransactionStorage(params Params) (internal.TransactionStorage, error) {
 		return nil, xerrors.Errorf("failed to create transaction table accessor: %w", err)
 	}
 
+	addressAttrDefs := []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String(ddbmodel.AddressTransactionPidKeyName),
+			AttributeType: awsStringType,
+		},
+		{
+			AttributeName: aws.String(ddbmodel.AddressTransactionSortKeyName),
+			AttributeType: awsStringType,
+		},
+	}
+	addressKeySchema := []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String(ddbmodel.AddressTransactionPidKeyName),
+			KeyType:       hashKeyType,
+		},
+		{
+			AttributeName: aws.String(ddbmodel.AddressTransactionSortKeyName),
+			KeyType:       rangeKeyType,
+		},
+	}
+
+	addressTable, err := newDDBTable(
+		params.Config.AWS.DynamoDB.AddressTable,
+		reflect.TypeOf(ddbmodel.AddressTransactionDDBEntry{}),
+		addressKeySchema, addressAttrDefs, nil,
+		params,
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create address table accessor: %w", err)
+	}
+
 	metrics := params.Metrics.SubScope("transaction_storage").Tagged(map[string]string{
 		"storage_type": "dynamodb",
 	})
 
 	return &transactionStorageImpl{
-		transactionTable:                 transactionTable,
-		instrumentAddOrUpdateTransaction: instrument.New(metrics, "add_transactions"),
-		instrumentGetTransaction:         instrument.NewWithResult[[]*model.Transaction](metrics, "get_transaction"),
+		transactionTable:                   transactionTable,
+		addressTable:                       addressTable,
+		instrumentAddOrUpdateTransaction:   instrument.New(metrics, "add_transactions"),
+		instrumentGetTransaction:           instrument.NewWithResult[[]*model.Transaction](metrics, "get_transaction"),
+		instrumentAddAddressTransactions:   instrument.New(metrics, "add_address_transactions"),
+		instrumentGetTransactionsByAddress: instrument.NewWithResult[[]*model.AddressTransaction](metrics, "get_transactions_by_address"),
 	}, nil
 }
 
@@ -126,3 +164,82 @@ func (t *transactionStorageImpl) GetTransaction(ctx context.Context, tag uint32,
 		return transactionToBlocks, nil
 	})
 }
+
+func (t *transactionStorageImpl) AddAddressTransactions(ctx context.Context, addressTransactions []*model.AddressTransaction, parallelism int) error {
+	if len(addressTransactions) == 0 {
+		return nil
+	}
+
+	return t.instrumentAddAddressTransactions.Instrument(ctx, func(ctx context.Context) error {
+		entries := make([]any, len(addressTransactions))
+		for i, addressTransaction := range addressTransactions {
+			entries[i] = ddbmodel.NewAddressTransactionDDBEntry(addressTransaction)
+		}
+
+		if err := t.addressTable.BatchWriteItems(ctx, entries, parallelism); err != nil {
+			return xerrors.Errorf("failed to add address transactions: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (t *transactionStorageImpl) GetTransactionsByAddress(ctx context.Context, tag uint32, address string, startHeight uint64, endHeight uint64, cursor *model.AddressTransaction, limit int) ([]*model.AddressTransaction, error) {
+	return t.instrumentGetTransactionsByAddress.Instrument(ctx, func(ctx context.Context) ([]*model.AddressTransaction, error) {
+		if startHeight >= endHeight {
+			return nil, nil
+		}
+
+		partitionKey := ddbmodel.MakeAddressTransactionPartitionKey(tag, address)
+		lowerSortKey, upperSortKey := ddbmodel.MakeAddressTransactionSortKeyRange(startHeight, endHeight)
+		keyCondition := expression.Key(ddbmodel.AddressTransactionPidKeyName).
+			Equal(expression.Value(partitionKey)).
+			And(expression.Key(ddbmodel.AddressTransactionSortKeyName).
+				Between(expression.Value(lowerSortKey), expression.Value(upperSortKey)))
+		builder := expression.NewBuilder().WithKeyCondition(keyCondition)
+		expr, err := builder.Build()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to build expression for GetTransactionsByAddress - QueryItems: %w", err)
+		}
+
+		// The query resumes right after the cursor, which does not need to exist in the table.
+		var exclusiveStartKey map[string]*dynamodb.AttributeValue
+		if cursor != nil {
+			exclusiveStartKey = map[string]*dynamodb.AttributeValue{
+				ddbmodel.AddressTransactionPidKeyName:  {S: aws.String(partitionKey)},
+				ddbmodel.AddressTransactionSortKeyName: {S: aws.String(ddbmodel.MakeAddressTransactionSortKey(cursor))},
+			}
+		}
+
+		outputItems, err := t.addressTable.QueryItems(ctx, &QueryItemsRequest{
+			ExclusiveStartKey:         exclusiveStartKey,
+			KeyConditionExpression:    expr.KeyCondition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			ConsistentRead:            true,
+			Limit:                     int64(limit),
+		})
+		if err != nil {
+			if xerrors.Is(err, errors.ErrItemNotFound) {
+				return nil, nil
+			}
+			return nil, xerrors.Errorf("failed to get transactions by address: %w", err)
+		}
+
+		addressTransactions := make([]*model.AddressTransaction, len(outputItems))
+		for i, item := range outputItems {
+			entry, ok := item.(*ddbmodel.AddressTransactionDDBEntry)
+			if !ok {
+				return nil, xerrors.Errorf("failed to convert output (%+v) to AddressTransactionDDBEntry", item)
+			}
+
+			addressTransaction, err := ddbmodel.TransformToAddressTransaction(entry)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to transform ddb entry (%+v) to address transaction: %w", entry, err)
+			}
+			addressTransactions[i] = addressTransaction
+		}
+
+		return addressTransactions, nil
+	})
+}
@@ -192,6 +192,41 @@ func (s *transactionStorageTestSuite) TestGetTransaction_NotExists() {
 	require.Nil(persisted)
 }
 
+func (s *transactionStorageTestSuite) TestAddAndGetTransactionsByAddress() {
+	require := testutil.Require(s.T())
+	ctx := context.Background()
+
+	addressTransactions := []*model.AddressTransaction{
+		{Address: "address", TransactionHash: "transactionHash2", BlockNumber: 124, BlockHash: "blockHash2", BlockTag: 1},
+		{Address: "address", TransactionHash: "transactionHash1", BlockNumber: 123, BlockHash: "blockHash1", BlockTag: 1},
+		{Address: "address", TransactionHash: "transactionHash3", BlockNumber: 125, BlockHash: "blockHash3", BlockTag: 1},
+		{Address: "address2", TransactionHash: "transactionHash1", BlockNumber: 123, BlockHash: "blockHash1", BlockTag: 1},
+	}
+	err := s.storage.AddAddressTransactions(ctx, addressTransactions, 2)
+	require.NoError(err)
+
+	persisted, err := s.storage.GetTransactionsByAddress(ctx, 1, "address", 123, 125, nil, 10)
+	require.NoError(err)
+	require.Equal([]*model.AddressTransaction{addressTransactions[1], addressTransactions[0]}, persisted)
+
+	persisted, err = s.storage.GetTransactionsByAddress(ctx, 1, "address2", 0, 1000, nil, 10)
+	require.NoError(err)
+	require.Equal([]*model.AddressTransaction{addressTransactions[3]}, persisted)
+
+	persisted, err = s.storage.GetTransactionsByAddress(ctx, 1, "address", 126, 1000, nil, 10)
+	require.NoError(err)
+	require.Empty(persisted)
+
+	// Page through the transactions with the last transaction of each page as the cursor.
+	persisted, err = s.storage.GetTransactionsByAddress(ctx, 1, "address", 0, 1000, nil, 2)
+	require.NoError(err)
+	require.Equal([]*model.AddressTransaction{addressTransactions[1], addressTransactions[0]}, persisted)
+
+	persisted, err = s.storage.GetTransactionsByAddress(ctx, 1, "address", 0, 1000, persisted[1], 2)
+	require.NoError(err)
+	require.Equal([]*model.AddressTransaction{addressTransactions[2]}, persisted)
+}
+
 func (s *transactionStorageTestSuite) validateExpectedAndActualTxn(expected *model.Transaction, actual *model.Transaction) {
 	require := testutil.Require(s.T())
 
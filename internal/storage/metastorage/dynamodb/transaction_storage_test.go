@@ -66,3 +66,56 @@ func TestTransformToTransactions_Err_EmptyBlockHash(t *testing.T) {
 	require.Error(err)
 	require.ErrorContains(err, "block hash is empty")
 }
+
+func TestTransformToAddressTransaction_Success(t *testing.T) {
+	require := testutil.Require(t)
+
+	expected := &model.AddressTransaction{
+		Address:         "address",
+		TransactionHash: "transactionHash",
+		BlockNumber:     123,
+		BlockHash:       "blockHash",
+		BlockTag:        1,
+	}
+
+	ddbEntry := ddbmodel.NewAddressTransactionDDBEntry(expected)
+	require.Equal("1#address", ddbEntry.AddressPid)
+	require.Equal("00000000000000000123#transactionHash#blockHash", ddbEntry.AddressRid)
+
+	addressTransaction, err := ddbmodel.TransformToAddressTransaction(ddbEntry)
+	require.NoError(err)
+	require.Equal(expected, addressTransaction)
+}
+
+func TestTransformToAddressTransaction_Err_EmptyTransactionHash(t *testing.T) {
+	require := testutil.Require(t)
+
+	ddbEntry := &ddbmodel.AddressTransactionDDBEntry{
+		AddressPid:  "1#address",
+		Address:     "address",
+		BlockHash:   "blockHash",
+		BlockNumber: 123,
+		BlockTag:    1,
+	}
+
+	_, err := ddbmodel.TransformToAddressTransaction(ddbEntry)
+	require.Error(err)
+	require.ErrorContains(err, "transaction hash is empty")
+}
+
+func TestMakeAddressTransactionSortKeyRange(t *testing.T) {
+	require := testutil.Require(t)
+
+	lower, upper := ddbmodel.MakeAddressTransactionSortKeyRange(123, 125)
+	require.Equal("00000000000000000123", lower)
+	require.Equal("00000000000000000124$", upper)
+
+	entry := ddbmodel.NewAddressTransactionDDBEntry(&model.AddressTransaction{
+		Address:         "address",
+		TransactionHash: "transactionHash",
+		BlockNumber:     124,
+		BlockHash:       "blockHash",
+	})
+	require.Less(lower, entry.AddressRid)
+	require.Less(entry.AddressRid, upper)
+}
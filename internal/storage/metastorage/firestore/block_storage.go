@@ -282,6 +282,7 @@ type firestoreBlockMetadata struct {
 	Skipped       bool
 	Timestamp     *timestamppb.Timestamp
 	Tag           uint32
+	Checksum      string
 }
 
 func (*blockStorageImpl) fromBlockMetadata(block *chainstorage.BlockMetadata) *firestoreBlockMetadata {
@@ -294,6 +295,7 @@ func (*blockStorageImpl) fromBlockMetadata(block *chainstorage.BlockMetadata) *f
 		Skipped:       block.Skipped,
 		Tag:           block.Tag,
 		Timestamp:     block.Timestamp,
+		Checksum:      block.Checksum,
 	}
 }
 
@@ -318,5 +320,6 @@ func (*blockStorageImpl) intoBlockMetadata(doc *firestore.DocumentSnapshot) (*ch
 		Skipped:       s.Skipped,
 		Timestamp:     s.Timestamp,
 		Tag:           s.Tag,
+		Checksum:      s.Checksum,
 	}, nil
 }
@@ -151,6 +151,27 @@ func (s *blockStorageTestSuite) TestPersistBlockMetasWithSkippedBlocks() {
 	require.Equal(blocks, fetchedBlocks)
 }
 
+func (s *blockStorageTestSuite) TestPersistBlockMetasWithChecksum() {
+	require := testutil.Require(s.T())
+
+	ctx := context.Background()
+	startHeight := s.config.Chain.BlockStartHeight
+	blocks := testutil.MakeBlockMetadatasFromStartHeight(startHeight, 10, tag)
+	for i, block := range blocks {
+		block.Checksum = fmt.Sprintf("%064x", i)
+	}
+	err := s.accessor.PersistBlockMetas(ctx, true, blocks, nil)
+	require.NoError(err)
+
+	fetchedBlocks, err := s.accessor.GetBlocksByHeightRange(ctx, tag, startHeight, startHeight+10)
+	require.NoError(err)
+	require.Equal(blocks, fetchedBlocks)
+
+	fetchedBlock, err := s.accessor.GetBlockByHash(ctx, tag, blocks[3].Height, blocks[3].Hash)
+	require.NoError(err)
+	require.Equal(blocks[3].Checksum, fetchedBlock.Checksum)
+}
+
 func (s *blockStorageTestSuite) runTestPersistBlockMetas(totalBlocks int) {
 	require := testutil.Require(s.T())
 	startHeight := s.config.Chain.BlockStartHeight
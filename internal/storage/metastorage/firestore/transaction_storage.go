@@ -40,3 +40,13 @@ func (*transactionStorageImpl) AddTransactions(ctx context.Context, transaction
 func (*transactionStorageImpl) GetTransaction(ctx context.Context, tag uint32, transactionHash string) ([]*model.Transaction, error) {
 	panic("unimplemented")
 }
+
+// AddAddressTransactions implements internal.TransactionStorage.
+func (*transactionStorageImpl) AddAddressTransactions(ctx context.Context, addressTransactions []*model.AddressTransaction, parallelism int) error {
+	panic("unimplemented")
+}
+
+// GetTransactionsByAddress implements internal.TransactionStorage.
+func (*transactionStorageImpl) GetTransactionsByAddress(ctx context.Context, tag uint32, address string, startHeight uint64, endHeight uint64, cursor *model.AddressTransaction, limit int) ([]*model.AddressTransaction, error) {
+	panic("unimplemented")
+}
@@ -47,6 +47,16 @@ type (
 		// 1. blockchain reorgs
 		// 2. protocol by design, e.g. NEAR
 		GetTransaction(ctx context.Context, tag uint32, transactionHash string) ([]*model.Transaction, error)
+
+		// AddAddressTransactions adds or updates an address to transaction mapping to the storage.
+		AddAddressTransactions(ctx context.Context, addressTransactions []*model.AddressTransaction, parallelism int) error
+
+		// GetTransactionsByAddress returns up to limit transactions which touch the address within [startHeight, endHeight),
+		// ordered by block height, transaction hash and block hash.
+		// If cursor is not nil, only the transactions ordered after the cursor are returned,
+		// so that the last transaction of a page can be used as the cursor of the next page.
+		// Like GetTransaction, the results may include transactions from orphaned blocks.
+		GetTransactionsByAddress(ctx context.Context, tag uint32, address string, startHeight uint64, endHeight uint64, cursor *model.AddressTransaction, limit int) ([]*model.AddressTransaction, error)
 	}
 
 	MetaStorage interface {
@@ -72,6 +82,7 @@ type (
 		fxparams.Params
 		DynamoDB  MetaStorageFactory `name:"metastorage/dynamodb"`
 		Firestore MetaStorageFactory `name:"metastorage/firestore"`
+		Postgres  MetaStorageFactory `name:"metastorage/postgres"`
 	}
 )
 
@@ -83,6 +94,8 @@ func WithMetaStorageFactory(params MetaStorageFactoryParams) (Result, error) {
 		factory = params.DynamoDB
 	case config.MetaStorageType_FIRESTORE:
 		factory = params.Firestore
+	case config.MetaStorageType_POSTGRES:
+		factory = params.Postgres
 	}
 	if factory == nil {
 		return Result{}, xerrors.Errorf("meta storage type is not implemented: %v", storageType)
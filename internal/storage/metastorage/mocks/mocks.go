@@ -41,6 +41,20 @@ func (m *MockMetaStorage) EXPECT() *MockMetaStorageMockRecorder {
 	return m.recorder
 }
 
+// AddAddressTransactions mocks base method.
+func (m *MockMetaStorage) AddAddressTransactions(arg0 context.Context, arg1 []*model.AddressTransaction, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAddressTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAddressTransactions indicates an expected call of AddAddressTransactions.
+func (mr *MockMetaStorageMockRecorder) AddAddressTransactions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressTransactions", reflect.TypeOf((*MockMetaStorage)(nil).AddAddressTransactions), arg0, arg1, arg2)
+}
+
 // AddEventEntries mocks base method.
 func (m *MockMetaStorage) AddEventEntries(arg0 context.Context, arg1 uint32, arg2 []*model.EventEntry) error {
 	m.ctrl.T.Helper()
@@ -263,6 +277,21 @@ func (mr *MockMetaStorageMockRecorder) GetTransaction(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockMetaStorage)(nil).GetTransaction), arg0, arg1, arg2)
 }
 
+// GetTransactionsByAddress mocks base method.
+func (m *MockMetaStorage) GetTransactionsByAddress(arg0 context.Context, arg1 uint32, arg2 string, arg3, arg4 uint64, arg5 *model.AddressTransaction, arg6 int) ([]*model.AddressTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsByAddress", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]*model.AddressTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsByAddress indicates an expected call of GetTransactionsByAddress.
+func (mr *MockMetaStorageMockRecorder) GetTransactionsByAddress(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByAddress", reflect.TypeOf((*MockMetaStorage)(nil).GetTransactionsByAddress), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // PersistBlockMetas mocks base method.
 func (m *MockMetaStorage) PersistBlockMetas(arg0 context.Context, arg1 bool, arg2 []*chainstorage.BlockMetadata, arg3 *chainstorage.BlockMetadata) error {
 	m.ctrl.T.Helper()
@@ -581,6 +610,20 @@ func (m *MockTransactionStorage) EXPECT() *MockTransactionStorageMockRecorder {
 	return m.recorder
 }
 
+// AddAddressTransactions mocks base method.
+func (m *MockTransactionStorage) AddAddressTransactions(arg0 context.Context, arg1 []*model.AddressTransaction, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAddressTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAddressTransactions indicates an expected call of AddAddressTransactions.
+func (mr *MockTransactionStorageMockRecorder) AddAddressTransactions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressTransactions", reflect.TypeOf((*MockTransactionStorage)(nil).AddAddressTransactions), arg0, arg1, arg2)
+}
+
 // AddTransactions mocks base method.
 func (m *MockTransactionStorage) AddTransactions(arg0 context.Context, arg1 []*model.Transaction, arg2 int) error {
 	m.ctrl.T.Helper()
@@ -609,3 +652,18 @@ func (mr *MockTransactionStorageMockRecorder) GetTransaction(arg0, arg1, arg2 an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).GetTransaction), arg0, arg1, arg2)
 }
+
+// GetTransactionsByAddress mocks base method.
+func (m *MockTransactionStorage) GetTransactionsByAddress(arg0 context.Context, arg1 uint32, arg2 string, arg3, arg4 uint64, arg5 *model.AddressTransaction, arg6 int) ([]*model.AddressTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsByAddress", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]*model.AddressTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsByAddress indicates an expected call of GetTransactionsByAddress.
+func (mr *MockTransactionStorageMockRecorder) GetTransactionsByAddress(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByAddress", reflect.TypeOf((*MockTransactionStorage)(nil).GetTransactionsByAddress), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
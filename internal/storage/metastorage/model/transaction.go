@@ -6,3 +6,12 @@ type Transaction struct {
 	BlockHash   string
 	BlockTag    uint32
 }
+
+// AddressTransaction maps an address to a transaction which touches the address.
+type AddressTransaction struct {
+	Address         string
+	TransactionHash string
+	BlockNumber     uint64
+	BlockHash       string
+	BlockTag        uint32
+}
@@ -0,0 +1,334 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/internal"
+	"github.com/coinbase/chainstorage/internal/utils/instrument"
+	"github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	blockStorageImpl struct {
+		db                               *sql.DB
+		blockStartHeight                 uint64
+		instrumentPersistBlockMetas      instrument.Instrument
+		instrumentGetLatestBlock         instrument.InstrumentWithResult[*chainstorage.BlockMetadata]
+		instrumentGetBlockByHash         instrument.InstrumentWithResult[*chainstorage.BlockMetadata]
+		instrumentGetBlockByHeight       instrument.InstrumentWithResult[*chainstorage.BlockMetadata]
+		instrumentGetBlocksByHeightRange instrument.InstrumentWithResult[[]*chainstorage.BlockMetadata]
+		instrumentGetBlocksByHeights     instrument.InstrumentWithResult[[]*chainstorage.BlockMetadata]
+	}
+
+	rowScanner interface {
+		Scan(dest ...any) error
+	}
+)
+
+const (
+	blockMetadataColumns = "m.tag, m.height, m.hash, m.parent_hash, m.parent_height, m.object_key_main, m.skipped, m.timestamp, m.checksum"
+
+	getBlockByHashQuery = `
+		SELECT ` + blockMetadataColumns + `
+		FROM block_metadata m
+		WHERE m.tag = $1 AND m.height = $2 AND m.hash = $3`
+
+	getCanonicalBlocksQuery = `
+		SELECT ` + blockMetadataColumns + `
+		FROM canonical_blocks c
+		JOIN block_metadata m ON m.tag = c.tag AND m.height = c.height AND m.hash = c.hash
+		WHERE c.tag = $1 AND c.height = ANY($2)`
+
+	getCanonicalBlocksByRangeQuery = `
+		SELECT ` + blockMetadataColumns + `
+		FROM canonical_blocks c
+		JOIN block_metadata m ON m.tag = c.tag AND m.height = c.height AND m.hash = c.hash
+		WHERE c.tag = $1 AND c.height >= $2 AND c.height < $3
+		ORDER BY c.height`
+
+	getLatestBlockQuery = `
+		SELECT ` + blockMetadataColumns + `
+		FROM block_watermarks w
+		JOIN block_metadata m ON m.tag = w.tag AND m.height = w.height AND m.hash = w.hash
+		WHERE w.tag = $1`
+
+	insertBlockMetadataStatement = `
+		INSERT INTO block_metadata (tag, height, hash, parent_hash, parent_height, object_key_main, skipped, timestamp, checksum)`
+
+	upsertBlockMetadataClause = `
+		ON CONFLICT (tag, height, hash) DO UPDATE SET
+			parent_hash = EXCLUDED.parent_hash,
+			parent_height = EXCLUDED.parent_height,
+			object_key_main = EXCLUDED.object_key_main,
+			skipped = EXCLUDED.skipped,
+			timestamp = EXCLUDED.timestamp,
+			checksum = EXCLUDED.checksum`
+
+	insertCanonicalBlockStatement = `
+		INSERT INTO canonical_blocks (tag, height, hash)`
+
+	upsertCanonicalBlockClause = `
+		ON CONFLICT (tag, height) DO UPDATE SET hash = EXCLUDED.hash`
+
+	upsertBlockWatermarkStatement = `
+		INSERT INTO block_watermarks (tag, height, hash) VALUES ($1, $2, $3)
+		ON CONFLICT (tag) DO UPDATE SET height = EXCLUDED.height, hash = EXCLUDED.hash`
+)
+
+func newBlockStorage(params Params, db *sql.DB) (internal.BlockStorage, error) {
+	metrics := params.Metrics.SubScope("block_storage").Tagged(map[string]string{
+		"storage_type": "postgres",
+	})
+	accessor := blockStorageImpl{
+		db:                               db,
+		blockStartHeight:                 params.Config.Chain.BlockStartHeight,
+		instrumentPersistBlockMetas:      instrument.New(metrics, "persist_block_metas"),
+		instrumentGetLatestBlock:         instrument.NewWithResult[*chainstorage.BlockMetadata](metrics, "get_latest_block"),
+		instrumentGetBlockByHash:         instrument.NewWithResult[*chainstorage.BlockMetadata](metrics, "get_block_by_hash"),
+		instrumentGetBlockByHeight:       instrument.NewWithResult[*chainstorage.BlockMetadata](metrics, "get_block_by_height"),
+		instrumentGetBlocksByHeightRange: instrument.NewWithResult[[]*chainstorage.BlockMetadata](metrics, "get_blocks_by_height_range"),
+		instrumentGetBlocksByHeights:     instrument.NewWithResult[[]*chainstorage.BlockMetadata](metrics, "get_blocks_by_heights"),
+	}
+	return &accessor, nil
+}
+
+// GetBlockByHash implements internal.BlockStorage.
+func (b *blockStorageImpl) GetBlockByHash(ctx context.Context, tag uint32, height uint64, blockHash string) (*chainstorage.BlockMetadata, error) {
+	if err := b.validateHeight(height); err != nil {
+		return nil, err
+	}
+	return b.instrumentGetBlockByHash.Instrument(ctx, func(ctx context.Context) (*chainstorage.BlockMetadata, error) {
+		if blockHash == "" {
+			return b.GetBlockByHeight(ctx, tag, height)
+		}
+		return b.getBlock(ctx, getBlockByHashQuery, tag, height, blockHash)
+	})
+}
+
+// GetBlockByHeight implements internal.BlockStorage.
+func (b *blockStorageImpl) GetBlockByHeight(ctx context.Context, tag uint32, height uint64) (*chainstorage.BlockMetadata, error) {
+	if err := b.validateHeight(height); err != nil {
+		return nil, err
+	}
+	return b.instrumentGetBlockByHeight.Instrument(ctx, func(ctx context.Context) (*chainstorage.BlockMetadata, error) {
+		return b.getBlock(ctx, getCanonicalBlocksQuery, tag, pq.Array([]int64{int64(height)}))
+	})
+}
+
+// GetBlocksByHeightRange implements internal.BlockStorage.
+func (b *blockStorageImpl) GetBlocksByHeightRange(ctx context.Context, tag uint32, startHeight uint64, endHeight uint64) ([]*chainstorage.BlockMetadata, error) {
+	if startHeight >= endHeight {
+		return nil, xerrors.Errorf(
+			"startHeight(%d) should be less than endHeight(%d): %w",
+			startHeight, endHeight, errors.ErrOutOfRange)
+	}
+	if err := b.validateHeight(startHeight); err != nil {
+		return nil, err
+	}
+	return b.instrumentGetBlocksByHeightRange.Instrument(ctx, func(ctx context.Context) ([]*chainstorage.BlockMetadata, error) {
+		blocks, err := b.getBlocks(ctx, getCanonicalBlocksByRangeQuery, tag, startHeight, endHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range blocks {
+			expecting := startHeight + uint64(i)
+			if blocks[i].Height != expecting {
+				return nil, xerrors.Errorf(
+					"block metadata with height %d not found: %w",
+					expecting, errors.ErrItemNotFound)
+			}
+		}
+		if uint64(len(blocks)) < endHeight-startHeight {
+			return nil, xerrors.Errorf(
+				"block metadata with height %d not found: %w",
+				startHeight+uint64(len(blocks)), errors.ErrItemNotFound)
+		}
+
+		if err := parser.ValidateChain(blocks, nil); err != nil {
+			return nil, xerrors.Errorf("failed to validate chain: %w", err)
+		}
+		return blocks, nil
+	})
+}
+
+// GetBlocksByHeights implements internal.BlockStorage.
+func (b *blockStorageImpl) GetBlocksByHeights(ctx context.Context, tag uint32, heights []uint64) ([]*chainstorage.BlockMetadata, error) {
+	for _, height := range heights {
+		if err := b.validateHeight(height); err != nil {
+			return nil, err
+		}
+	}
+	return b.instrumentGetBlocksByHeights.Instrument(ctx, func(ctx context.Context) ([]*chainstorage.BlockMetadata, error) {
+		values := make([]int64, len(heights))
+		for i, height := range heights {
+			values[i] = int64(height)
+		}
+		items, err := b.getBlocks(ctx, getCanonicalBlocksQuery, tag, pq.Array(values))
+		if err != nil {
+			return nil, err
+		}
+
+		blocksByHeight := make(map[uint64]*chainstorage.BlockMetadata, len(items))
+		for _, item := range items {
+			blocksByHeight[item.Height] = item
+		}
+
+		// Return the blocks in the same order as the input heights.
+		blocks := make([]*chainstorage.BlockMetadata, len(heights))
+		for i, height := range heights {
+			block, ok := blocksByHeight[height]
+			if !ok {
+				return nil, xerrors.Errorf(
+					"block metadata with height %d not found: %w",
+					height, errors.ErrItemNotFound)
+			}
+			blocks[i] = block
+		}
+		return blocks, nil
+	})
+}
+
+// GetLatestBlock implements internal.BlockStorage.
+func (b *blockStorageImpl) GetLatestBlock(ctx context.Context, tag uint32) (*chainstorage.BlockMetadata, error) {
+	return b.instrumentGetLatestBlock.Instrument(ctx, func(ctx context.Context) (*chainstorage.BlockMetadata, error) {
+		return b.getBlock(ctx, getLatestBlockQuery, tag)
+	})
+}
+
+// PersistBlockMetas implements internal.BlockStorage.
+func (b *blockStorageImpl) PersistBlockMetas(ctx context.Context, updateWatermark bool, blocks []*chainstorage.BlockMetadata, lastBlock *chainstorage.BlockMetadata) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	return b.instrumentPersistBlockMetas.Instrument(ctx, func(ctx context.Context) error {
+		sort.Slice(blocks, func(i, j int) bool {
+			return blocks[i].Height < blocks[j].Height
+		})
+		if err := parser.ValidateChain(blocks, lastBlock); err != nil {
+			return xerrors.Errorf("failed to validate chain: %w", err)
+		}
+
+		blockRows := make([][]any, len(blocks))
+		canonicalRows := make([][]any, len(blocks))
+		for i, block := range blocks {
+			var timestamp *time.Time
+			if block.Timestamp != nil {
+				t := block.Timestamp.AsTime()
+				timestamp = &t
+			}
+			blockRows[i] = []any{
+				int64(block.Tag),
+				int64(block.Height),
+				block.Hash,
+				block.ParentHash,
+				int64(block.ParentHeight),
+				block.ObjectKeyMain,
+				block.Skipped,
+				timestamp,
+				block.Checksum,
+			}
+			canonicalRows[i] = []any{
+				int64(block.Tag),
+				int64(block.Height),
+				block.Hash,
+			}
+		}
+
+		// Unlike the other meta storages, the blocks, the canonical chain and the watermark are updated atomically.
+		return runInTransaction(ctx, b.db, nil, func(tx *sql.Tx) error {
+			if err := bulkInsert(ctx, tx, insertBlockMetadataStatement, upsertBlockMetadataClause, blockRows); err != nil {
+				return xerrors.Errorf("failed to add blocks: %w", err)
+			}
+
+			if err := bulkInsert(ctx, tx, insertCanonicalBlockStatement, upsertCanonicalBlockClause, canonicalRows); err != nil {
+				return xerrors.Errorf("failed to add canonical blocks: %w", err)
+			}
+
+			if updateWatermark {
+				latestBlock := blocks[len(blocks)-1]
+				if _, err := tx.ExecContext(ctx, upsertBlockWatermarkStatement, int64(latestBlock.Tag), int64(latestBlock.Height), latestBlock.Hash); err != nil {
+					return xerrors.Errorf("failed to update watermark: %w", err)
+				}
+			}
+
+			return nil
+		})
+	})
+}
+
+func (b *blockStorageImpl) validateHeight(height uint64) error {
+	if height < b.blockStartHeight {
+		return xerrors.Errorf(
+			"height(%d) should be no less than blockStartHeight(%d): %w",
+			height, b.blockStartHeight, errors.ErrInvalidHeight)
+	}
+	return nil
+}
+
+func (b *blockStorageImpl) getBlock(ctx context.Context, query string, args ...any) (*chainstorage.BlockMetadata, error) {
+	block, err := b.intoBlockMetadata(b.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if xerrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrItemNotFound
+		}
+		return nil, xerrors.Errorf("failed to get block: %w", err)
+	}
+	return block, nil
+}
+
+func (b *blockStorageImpl) getBlocks(ctx context.Context, query string, args ...any) ([]*chainstorage.BlockMetadata, error) {
+	rows, err := b.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var blocks []*chainstorage.BlockMetadata
+	for rows.Next() {
+		block, err := b.intoBlockMetadata(rows)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to parse block data: %w", err)
+		}
+		blocks = append(blocks, block)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to get blocks: %w", err)
+	}
+	return blocks, nil
+}
+
+func (*blockStorageImpl) intoBlockMetadata(row rowScanner) (*chainstorage.BlockMetadata, error) {
+	var (
+		tag          int64
+		height       int64
+		parentHeight int64
+		timestamp    sql.NullTime
+		block        chainstorage.BlockMetadata
+	)
+	if err := row.Scan(&tag, &height, &block.Hash, &block.ParentHash, &parentHeight, &block.ObjectKeyMain, &block.Skipped, &timestamp, &block.Checksum); err != nil {
+		return nil, err
+	}
+	if height < 0 {
+		return nil, xerrors.Errorf("expecting block Height to be uint64, but got %d", height)
+	}
+	if parentHeight < 0 {
+		return nil, xerrors.Errorf("expecting block ParentHeight to be uint64, but got %d", parentHeight)
+	}
+	block.Tag = uint32(tag)
+	block.Height = uint64(height)
+	block.ParentHeight = uint64(parentHeight)
+	if timestamp.Valid {
+		block.Timestamp = timestamppb.New(timestamp.Time)
+	}
+	return &block, nil
+}
@@ -0,0 +1,455 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/internal"
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/model"
+	"github.com/coinbase/chainstorage/internal/utils/instrument"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+const (
+	addEventsSafePadding = int64(20)
+)
+
+type (
+	eventStorageImpl struct {
+		db                                     *sql.DB
+		latestEventTag                         uint32
+		instrumentAddEvents                    instrument.Instrument
+		instrumentGetEventByEventId            instrument.InstrumentWithResult[*model.EventEntry]
+		instrumentGetEventsAfterEventId        instrument.InstrumentWithResult[[]*model.EventEntry]
+		instrumentGetEventsByEventIdRange      instrument.InstrumentWithResult[[]*model.EventEntry]
+		instrumentGetMaxEventId                instrument.InstrumentWithResult[int64]
+		instrumentSetMaxEventId                instrument.Instrument
+		instrumentGetFirstEventIdByBlockHeight instrument.InstrumentWithResult[int64]
+		instrumentGetEventsByBlockHeight       instrument.InstrumentWithResult[[]*model.EventEntry]
+	}
+
+	// queryer is implemented by both sql.DB and sql.Tx.
+	queryer interface {
+		QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+		QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	}
+)
+
+const (
+	eventEntryColumns = "event_tag, event_id, event_type, block_height, block_hash, tag, parent_hash, block_skipped, block_timestamp"
+
+	getEventsByEventIdRangeQuery = `
+		SELECT ` + eventEntryColumns + `
+		FROM block_events
+		WHERE event_tag = $1 AND event_id >= $2 AND event_id < $3
+		ORDER BY event_id`
+
+	getEventsByBlockHeightQuery = `
+		SELECT ` + eventEntryColumns + `
+		FROM block_events
+		WHERE event_tag = $1 AND block_height = $2
+		ORDER BY event_id`
+
+	getFirstEventIdByBlockHeightQuery = `
+		SELECT MIN(event_id)
+		FROM block_events
+		WHERE event_tag = $1 AND block_height = $2`
+
+	getEventExistsQuery = `
+		SELECT EXISTS (SELECT 1 FROM block_events WHERE event_tag = $1 AND event_id = $2)`
+
+	insertEventStatement = `
+		INSERT INTO block_events (` + eventEntryColumns + `)`
+
+	upsertEventClause = `
+		ON CONFLICT (event_tag, event_id) DO UPDATE SET
+			event_type = EXCLUDED.event_type,
+			block_height = EXCLUDED.block_height,
+			block_hash = EXCLUDED.block_hash,
+			tag = EXCLUDED.tag,
+			parent_hash = EXCLUDED.parent_hash,
+			block_skipped = EXCLUDED.block_skipped,
+			block_timestamp = EXCLUDED.block_timestamp`
+
+	getMaxEventIdQuery = `
+		SELECT max_event_id FROM event_watermarks WHERE event_tag = $1`
+
+	// The watermark row is created on demand so that it can always be locked,
+	// which serializes the writers of the same event tag.
+	initMaxEventIdStatement = `
+		INSERT INTO event_watermarks (event_tag, max_event_id) VALUES ($1, 0)
+		ON CONFLICT (event_tag) DO NOTHING`
+
+	lockMaxEventIdQuery = `
+		SELECT max_event_id FROM event_watermarks WHERE event_tag = $1 FOR UPDATE`
+
+	setMaxEventIdStatement = `
+		UPDATE event_watermarks SET max_event_id = $2 WHERE event_tag = $1`
+)
+
+func newEventStorage(params Params, db *sql.DB) (internal.EventStorage, error) {
+	metrics := params.Metrics.SubScope("event_storage").Tagged(map[string]string{
+		"storage_type": "postgres",
+	})
+	storage := eventStorageImpl{
+		db:                                     db,
+		latestEventTag:                         params.Config.GetLatestEventTag(),
+		instrumentAddEvents:                    instrument.New(metrics, "add_events"),
+		instrumentGetEventByEventId:            instrument.NewWithResult[*model.EventEntry](metrics, "get_event_by_event_id"),
+		instrumentGetEventsAfterEventId:        instrument.NewWithResult[[]*model.EventEntry](metrics, "get_events_after_event_id"),
+		instrumentGetEventsByEventIdRange:      instrument.NewWithResult[[]*model.EventEntry](metrics, "get_events_by_event_id_range"),
+		instrumentGetMaxEventId:                instrument.NewWithResult[int64](metrics, "get_max_event_id"),
+		instrumentSetMaxEventId:                instrument.New(metrics, "set_max_event_id"),
+		instrumentGetFirstEventIdByBlockHeight: instrument.NewWithResult[int64](metrics, "get_first_event_id_by_block_height"),
+		instrumentGetEventsByBlockHeight:       instrument.NewWithResult[[]*model.EventEntry](metrics, "get_events_by_block_height"),
+	}
+	return &storage, nil
+}
+
+func (e *eventStorageImpl) validateEventTag(eventTag uint32) error {
+	if eventTag > e.latestEventTag {
+		return xerrors.Errorf("do not support eventTag=%d, latestEventTag=%d", eventTag, e.latestEventTag)
+	}
+	return nil
+}
+
+// AddEventEntries implements internal.EventStorage.
+func (e *eventStorageImpl) AddEventEntries(ctx context.Context, eventTag uint32, eventEntries []*model.EventEntry) error {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return err
+	}
+	if len(eventEntries) == 0 {
+		return nil
+	}
+
+	return e.instrumentAddEvents.Instrument(ctx, func(ctx context.Context) error {
+		return runInTransaction(ctx, e.db, nil, func(tx *sql.Tx) error {
+			if _, err := e.lockMaxEventId(ctx, tx, eventTag); err != nil {
+				return xerrors.Errorf("failed to lock max event id: %w", err)
+			}
+			return e.addEventEntries(ctx, tx, eventTag, eventEntries)
+		})
+	})
+}
+
+// AddEvents implements internal.EventStorage.
+// The event ids are allocated within the same transaction as the one writing the events,
+// so that concurrent writers never allocate the same event ids.
+func (e *eventStorageImpl) AddEvents(ctx context.Context, eventTag uint32, events []*model.BlockEvent) error {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	return e.instrumentAddEvents.Instrument(ctx, func(ctx context.Context) error {
+		return runInTransaction(ctx, e.db, nil, func(tx *sql.Tx) error {
+			maxEventId, err := e.lockMaxEventId(ctx, tx, eventTag)
+			if err != nil {
+				return xerrors.Errorf("failed to lock max event id: %w", err)
+			}
+
+			startEventId := model.EventIdStartValue
+			if maxEventId != model.EventIdDeleted {
+				startEventId = maxEventId + 1
+			}
+			eventsToAdd := model.ConvertBlockEventsToEventEntries(events, eventTag, startEventId)
+			return e.addEventEntries(ctx, tx, eventTag, eventsToAdd)
+		})
+	})
+}
+
+// addEventEntries validates the new events against the preceding ones and writes them along with the watermark.
+// The watermark row must have been locked by the caller.
+func (e *eventStorageImpl) addEventEntries(ctx context.Context, tx *sql.Tx, eventTag uint32, eventEntries []*model.EventEntry) error {
+	startEventId := eventEntries[0].EventId
+
+	var eventsToValidate []*model.EventEntry
+	// fetch some events before startEventId
+	startFetchId := startEventId - addEventsSafePadding
+	if startFetchId < model.EventIdStartValue {
+		startFetchId = model.EventIdStartValue
+	}
+	if startFetchId < startEventId {
+		beforeEvents, err := e.getEventsByEventIdRange(ctx, tx, eventTag, startFetchId, startEventId)
+		if err != nil {
+			return xerrors.Errorf("failed to fetch events: %w", err)
+		}
+		eventsToValidate = append(beforeEvents, eventEntries...)
+	} else {
+		eventsToValidate = eventEntries
+	}
+
+	if err := internal.ValidateEvents(eventsToValidate); err != nil {
+		return xerrors.Errorf("events failed validation: %w", err)
+	}
+
+	rows := make([][]any, len(eventEntries))
+	for i, eventEntry := range eventEntries {
+		rows[i] = e.fromEventEntry(eventEntry)
+	}
+	if err := bulkInsert(ctx, tx, insertEventStatement, upsertEventClause, rows); err != nil {
+		return xerrors.Errorf("failed to write events: %w", err)
+	}
+
+	maxEventId := eventEntries[len(eventEntries)-1].EventId
+	if _, err := tx.ExecContext(ctx, setMaxEventIdStatement, int64(eventTag), maxEventId); err != nil {
+		return xerrors.Errorf("failed to update watermark: %w", err)
+	}
+
+	return nil
+}
+
+// GetEventByEventId implements internal.EventStorage.
+func (e *eventStorageImpl) GetEventByEventId(ctx context.Context, eventTag uint32, eventId int64) (*model.EventEntry, error) {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return nil, err
+	}
+
+	return e.instrumentGetEventByEventId.Instrument(ctx, func(ctx context.Context) (*model.EventEntry, error) {
+		maxEventId, err := e.GetMaxEventId(ctx, eventTag)
+		if err != nil {
+			if xerrors.Is(err, errors.ErrNoEventHistory) {
+				return nil, errors.ErrNoMaxEventIdFound
+			}
+			return nil, xerrors.Errorf("failed to get max event id for eventTag=%d: %w", eventTag, err)
+		}
+		if eventId > maxEventId {
+			return nil, xerrors.Errorf("invalid eventId %d (event ends at %d) for eventTag=%d: %w", eventId, maxEventId, eventTag, errors.ErrInvalidEventId)
+		}
+		events, err := e.GetEventsByEventIdRange(ctx, eventTag, eventId, eventId+1)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get events for eventTag=%d, eventId=%d: %w", eventTag, eventId, err)
+		}
+		return events[0], nil
+	})
+}
+
+// GetEventsAfterEventId implements internal.EventStorage.
+func (e *eventStorageImpl) GetEventsAfterEventId(ctx context.Context, eventTag uint32, eventId int64, maxEvents uint64) ([]*model.EventEntry, error) {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return nil, err
+	}
+
+	events, err := e.instrumentGetEventsAfterEventId.Instrument(ctx, func(ctx context.Context) ([]*model.EventEntry, error) {
+		maxEventId, err := e.GetMaxEventId(ctx, eventTag)
+		if err != nil {
+			if xerrors.Is(err, errors.ErrNoEventHistory) {
+				return nil, errors.ErrNoMaxEventIdFound
+			}
+			return nil, xerrors.Errorf("failed to get max event id for eventTag=%d: %w", eventTag, err)
+		}
+		if maxEventId == eventId {
+			return nil, nil
+		}
+		if eventId > maxEventId {
+			return nil, xerrors.Errorf("invalid eventId %d (event ends at %d) for eventTag=%d: %w", eventId, maxEventId, eventTag, errors.ErrInvalidEventId)
+		}
+		if eventId+int64(maxEvents) < maxEventId {
+			maxEventId = eventId + int64(maxEvents)
+		}
+		return e.GetEventsByEventIdRange(ctx, eventTag, eventId+1, maxEventId+1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := internal.ValidateEvents(events); err != nil {
+		return nil, xerrors.Errorf("events failed validation for eventTag=%d: %w", eventTag, err)
+	}
+	return events, nil
+}
+
+// GetEventsByBlockHeight implements internal.EventStorage.
+func (e *eventStorageImpl) GetEventsByBlockHeight(ctx context.Context, eventTag uint32, blockHeight uint64) ([]*model.EventEntry, error) {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return nil, err
+	}
+	return e.instrumentGetEventsByBlockHeight.Instrument(ctx, func(ctx context.Context) ([]*model.EventEntry, error) {
+		events, err := e.getEvents(ctx, e.db, getEventsByBlockHeightQuery, int64(eventTag), int64(blockHeight))
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			return nil, errors.ErrItemNotFound
+		}
+		return events, nil
+	})
+}
+
+// GetEventsByEventIdRange implements internal.EventStorage.
+func (e *eventStorageImpl) GetEventsByEventIdRange(ctx context.Context, eventTag uint32, minEventId int64, maxEventId int64) ([]*model.EventEntry, error) {
+	if minEventId < model.EventIdStartValue {
+		return nil, xerrors.Errorf("invalid minEventId %d (event starts at %d): %w", minEventId, model.EventIdStartValue, errors.ErrInvalidEventId)
+	}
+	if err := e.validateEventTag(eventTag); err != nil {
+		return nil, err
+	}
+	return e.instrumentGetEventsByEventIdRange.Instrument(ctx, func(ctx context.Context) ([]*model.EventEntry, error) {
+		return e.getEventsByEventIdRange(ctx, e.db, eventTag, minEventId, maxEventId)
+	})
+}
+
+// GetFirstEventIdByBlockHeight implements internal.EventStorage.
+func (e *eventStorageImpl) GetFirstEventIdByBlockHeight(ctx context.Context, eventTag uint32, blockHeight uint64) (int64, error) {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return 0, err
+	}
+	return e.instrumentGetFirstEventIdByBlockHeight.Instrument(ctx, func(ctx context.Context) (int64, error) {
+		var eventId sql.NullInt64
+		if err := e.db.QueryRowContext(ctx, getFirstEventIdByBlockHeightQuery, int64(eventTag), int64(blockHeight)).Scan(&eventId); err != nil {
+			return 0, xerrors.Errorf("failed to get events by block height: %w", err)
+		}
+		if !eventId.Valid {
+			return 0, errors.ErrItemNotFound
+		}
+		return eventId.Int64, nil
+	})
+}
+
+// GetMaxEventId implements internal.EventStorage.
+func (e *eventStorageImpl) GetMaxEventId(ctx context.Context, eventTag uint32) (int64, error) {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return 0, err
+	}
+	return e.instrumentGetMaxEventId.Instrument(ctx, func(ctx context.Context) (int64, error) {
+		var maxEventId int64
+		err := e.db.QueryRowContext(ctx, getMaxEventIdQuery, int64(eventTag)).Scan(&maxEventId)
+		if err != nil {
+			if xerrors.Is(err, sql.ErrNoRows) {
+				return 0, errors.ErrNoEventHistory
+			}
+			return 0, xerrors.Errorf("failed to get max event id: %w", err)
+		}
+		if maxEventId == model.EventIdDeleted {
+			return 0, errors.ErrNoEventHistory
+		}
+		return maxEventId, nil
+	})
+}
+
+// SetMaxEventId implements internal.EventStorage.
+func (e *eventStorageImpl) SetMaxEventId(ctx context.Context, eventTag uint32, maxEventId int64) error {
+	if err := e.validateEventTag(eventTag); err != nil {
+		return err
+	}
+	if maxEventId < model.EventIdStartValue && maxEventId != model.EventIdDeleted {
+		return xerrors.Errorf("invalid max event id: %d", maxEventId)
+	}
+	return e.instrumentSetMaxEventId.Instrument(ctx, func(ctx context.Context) error {
+		return runInTransaction(ctx, e.db, nil, func(tx *sql.Tx) error {
+			currentMaxEventId, err := e.lockMaxEventId(ctx, tx, eventTag)
+			if err != nil {
+				return xerrors.Errorf("failed to lock max event id: %w", err)
+			}
+			if currentMaxEventId == model.EventIdDeleted {
+				return xerrors.Errorf("failed to get current max event id for eventTag=%d: %w", eventTag, errors.ErrNoEventHistory)
+			}
+			if maxEventId > currentMaxEventId {
+				return xerrors.Errorf("can not set max event id to be %d, which is bigger than current max event id: %d", maxEventId, currentMaxEventId)
+			}
+			if maxEventId != model.EventIdDeleted {
+				var exists bool
+				if err := tx.QueryRowContext(ctx, getEventExistsQuery, int64(eventTag), maxEventId).Scan(&exists); err != nil {
+					return xerrors.Errorf("failed to get event entry with new max event id %d: %w", maxEventId, err)
+				}
+				if !exists {
+					return xerrors.Errorf("event entry with new max event id %d does not exist", maxEventId)
+				}
+			}
+			if _, err := tx.ExecContext(ctx, setMaxEventIdStatement, int64(eventTag), maxEventId); err != nil {
+				return xerrors.Errorf("failed to update watermark for eventTag=%d: %w", eventTag, err)
+			}
+			return nil
+		})
+	})
+}
+
+// lockMaxEventId returns the current max event id, or EventIdDeleted if there is no event history,
+// and locks the watermark row until the end of the transaction.
+func (e *eventStorageImpl) lockMaxEventId(ctx context.Context, tx *sql.Tx, eventTag uint32) (int64, error) {
+	if _, err := tx.ExecContext(ctx, initMaxEventIdStatement, int64(eventTag)); err != nil {
+		return 0, xerrors.Errorf("failed to init max event id: %w", err)
+	}
+
+	var maxEventId int64
+	if err := tx.QueryRowContext(ctx, lockMaxEventIdQuery, int64(eventTag)).Scan(&maxEventId); err != nil {
+		return 0, xerrors.Errorf("failed to get max event id: %w", err)
+	}
+	return maxEventId, nil
+}
+
+func (e *eventStorageImpl) getEventsByEventIdRange(ctx context.Context, q queryer, eventTag uint32, minEventId int64, maxEventId int64) ([]*model.EventEntry, error) {
+	events, err := e.getEvents(ctx, q, getEventsByEventIdRangeQuery, int64(eventTag), minEventId, maxEventId)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(events)) != maxEventId-minEventId {
+		return nil, xerrors.Errorf("miss events for range [%d, %d): %w", minEventId, maxEventId, errors.ErrItemNotFound)
+	}
+	return events, nil
+}
+
+func (e *eventStorageImpl) getEvents(ctx context.Context, q queryer, query string, args ...any) ([]*model.EventEntry, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get event entries: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*model.EventEntry
+	for rows.Next() {
+		event, err := e.intoEventEntry(rows)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to parse event entry: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to get event entries: %w", err)
+	}
+	return events, nil
+}
+
+func (*eventStorageImpl) fromEventEntry(eventEntry *model.EventEntry) []any {
+	tag := eventEntry.Tag
+	if tag == 0 {
+		tag = model.DefaultBlockTag
+	}
+	return []any{
+		int64(eventEntry.EventTag),
+		eventEntry.EventId,
+		int32(eventEntry.EventType),
+		int64(eventEntry.BlockHeight),
+		eventEntry.BlockHash,
+		int64(tag),
+		eventEntry.ParentHash,
+		eventEntry.BlockSkipped,
+		eventEntry.BlockTimestamp,
+	}
+}
+
+func (*eventStorageImpl) intoEventEntry(row rowScanner) (*model.EventEntry, error) {
+	var (
+		eventTag    int64
+		eventType   int32
+		blockHeight int64
+		tag         int64
+		event       model.EventEntry
+	)
+	if err := row.Scan(&eventTag, &event.EventId, &eventType, &blockHeight, &event.BlockHash, &tag, &event.ParentHash, &event.BlockSkipped, &event.BlockTimestamp); err != nil {
+		return nil, err
+	}
+	if blockHeight < 0 {
+		return nil, xerrors.Errorf("expecting block Height to be uint64, but got %d", blockHeight)
+	}
+	event.EventTag = uint32(eventTag)
+	event.EventType = api.BlockchainEvent_Type(eventType)
+	event.BlockHeight = uint64(blockHeight)
+	event.Tag = uint32(tag)
+	return &event, nil
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/lib/pq"
+	"go.uber.org/fx"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/internal"
+	"github.com/coinbase/chainstorage/internal/utils/fxparams"
+)
+
+type (
+	metaStorageImpl struct {
+		internal.BlockStorage
+		internal.EventStorage
+		internal.TransactionStorage
+	}
+
+	Params struct {
+		fx.In
+		fxparams.Params
+	}
+
+	metaStorageFactory struct {
+		params Params
+	}
+)
+
+func NewMetaStorage(params Params) (internal.Result, error) {
+	ctx := context.Background()
+	cfg := params.Config.Postgres
+	if cfg == nil {
+		return internal.Result{}, xerrors.Errorf("failed to create postgres meta storage: missing postgres config")
+	}
+
+	db, err := newDB(ctx, cfg)
+	if err != nil {
+		return internal.Result{}, xerrors.Errorf("failed to create postgres client: %w", err)
+	}
+
+	if err := migrate(ctx, db); err != nil {
+		return internal.Result{}, xerrors.Errorf("failed to migrate postgres schema: %w", err)
+	}
+
+	blockStorage, err := newBlockStorage(params, db)
+	if err != nil {
+		return internal.Result{}, xerrors.Errorf("failed create new BlockStorage: %w", err)
+	}
+
+	eventStorage, err := newEventStorage(params, db)
+	if err != nil {
+		return internal.Result{}, xerrors.Errorf("failed create new EventStorage: %w", err)
+	}
+
+	transactionStorage, err := newTransactionStorage(params, db)
+	if err != nil {
+		return internal.Result{}, xerrors.Errorf("failed create new TransactionStorage: %w", err)
+	}
+
+	metaStorage := &metaStorageImpl{
+		BlockStorage:       blockStorage,
+		EventStorage:       eventStorage,
+		TransactionStorage: transactionStorage,
+	}
+
+	return internal.Result{
+		BlockStorage:       blockStorage,
+		EventStorage:       eventStorage,
+		TransactionStorage: transactionStorage,
+		MetaStorage:        metaStorage,
+	}, nil
+}
+
+// Create implements internal.MetaStorageFactory.
+func (f *metaStorageFactory) Create() (internal.Result, error) {
+	return NewMetaStorage(f.params)
+}
+
+func NewFactory(params Params) internal.MetaStorageFactory {
+	return &metaStorageFactory{params}
+}
+
+func newDB(ctx context.Context, cfg *config.PostgresConfig) (*sql.DB, error) {
+	if cfg.Schema != "" {
+		// The schema has to exist before it can be used as the search path of the connections.
+		db, err := sql.Open("postgres", getDataSourceName(cfg, ""))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to open database: %w", err)
+		}
+		defer db.Close()
+
+		if _, err := db.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+pq.QuoteIdentifier(cfg.Schema)); err != nil {
+			return nil, xerrors.Errorf("failed to create schema %v: %w", cfg.Schema, err)
+		}
+	}
+
+	db, err := sql.Open("postgres", getDataSourceName(cfg, cfg.Schema))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open database: %w", err)
+	}
+
+	if cfg.MaxConnections > 0 {
+		db.SetMaxOpenConns(cfg.MaxConnections)
+		db.SetMaxIdleConns(cfg.MaxConnections)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, xerrors.Errorf("failed to connect to database: %w", err)
+	}
+
+	return db, nil
+}
+
+func getDataSourceName(cfg *config.PostgresConfig, schema string) string {
+	query := url.Values{}
+	if cfg.SSLMode != "" {
+		query.Set("sslmode", cfg.SSLMode)
+	}
+	if cfg.ConnectTimeout > 0 {
+		query.Set("connect_timeout", strconv.Itoa(int(cfg.ConnectTimeout.Seconds())))
+	}
+	if schema != "" {
+		query.Set("search_path", schema)
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     fmt.Sprintf("%v:%v", cfg.Host, cfg.Port),
+		Path:     cfg.Database,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+type (
+	migration struct {
+		version int
+		name    string
+		script  string
+	}
+)
+
+// Schema migrations are applied in the order of their versions, which are encoded as the prefix of the file names,
+// e.g. "0001_init.sql". A migration must never be modified once released; add a new one instead.
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+const (
+	createMigrationsTableQuery = `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER     NOT NULL PRIMARY KEY,
+			name       TEXT        NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`
+
+	// migrationsLockId is an arbitrary key used to serialize the migrations across concurrent processes.
+	migrationsLockId = 0x63686169
+)
+
+func loadMigrations() ([]*migration, error) {
+	entries, err := migrationFS.ReadDir("migrations")
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read migrations: %w", err)
+	}
+
+	migrations := make([]*migration, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return nil, xerrors.Errorf("invalid migration name: %v", name)
+		}
+
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid migration version (name=%v): %w", name, err)
+		}
+
+		script, err := migrationFS.ReadFile(path.Join("migrations", name))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read migration %v: %w", name, err)
+		}
+
+		migrations = append(migrations, &migration{
+			version: version,
+			name:    name,
+			script:  string(script),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].version == migrations[i-1].version {
+			return nil, xerrors.Errorf("duplicate migration version: %v", migrations[i].version)
+		}
+	}
+
+	return migrations, nil
+}
+
+// migrate applies the pending schema migrations within a single transaction.
+func migrate(ctx context.Context, db *sql.DB) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return xerrors.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationsLockId); err != nil {
+		return xerrors.Errorf("failed to acquire migrations lock: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, createMigrationsTableQuery); err != nil {
+		return xerrors.Errorf("failed to create migrations table: %w", err)
+	}
+
+	var currentVersion int
+	if err := tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&currentVersion); err != nil {
+		return xerrors.Errorf("failed to get current schema version: %w", err)
+	}
+
+	for _, m := range migrations {
+		if m.version <= currentVersion {
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, m.script); err != nil {
+			return xerrors.Errorf("failed to apply migration %v: %w", m.name, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", m.version, m.name); err != nil {
+			return xerrors.Errorf("failed to record migration %v: %w", m.name, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return xerrors.Errorf("failed to commit migrations: %w", err)
+	}
+
+	return nil
+}
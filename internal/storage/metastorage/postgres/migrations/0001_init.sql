@@ -0,0 +1,52 @@
+CREATE TABLE block_metadata (
+    tag             BIGINT      NOT NULL,
+    height          BIGINT      NOT NULL,
+    hash            TEXT        NOT NULL,
+    parent_hash     TEXT        NOT NULL,
+    parent_height   BIGINT      NOT NULL,
+    object_key_main TEXT        NOT NULL,
+    skipped         BOOLEAN     NOT NULL,
+    timestamp       TIMESTAMPTZ,
+    PRIMARY KEY (tag, height, hash)
+);
+
+CREATE TABLE canonical_blocks (
+    tag    BIGINT NOT NULL,
+    height BIGINT NOT NULL,
+    hash   TEXT   NOT NULL,
+    PRIMARY KEY (tag, height)
+);
+
+CREATE TABLE block_watermarks (
+    tag    BIGINT NOT NULL PRIMARY KEY,
+    height BIGINT NOT NULL,
+    hash   TEXT   NOT NULL
+);
+
+CREATE TABLE block_events (
+    event_tag       BIGINT  NOT NULL,
+    event_id        BIGINT  NOT NULL,
+    event_type      INTEGER NOT NULL,
+    block_height    BIGINT  NOT NULL,
+    block_hash      TEXT    NOT NULL,
+    tag             BIGINT  NOT NULL,
+    parent_hash     TEXT    NOT NULL,
+    block_skipped   BOOLEAN NOT NULL,
+    block_timestamp BIGINT  NOT NULL,
+    PRIMARY KEY (event_tag, event_id)
+);
+
+CREATE INDEX block_events_block_height_idx ON block_events (event_tag, block_height);
+
+CREATE TABLE event_watermarks (
+    event_tag    BIGINT NOT NULL PRIMARY KEY,
+    max_event_id BIGINT NOT NULL
+);
+
+CREATE TABLE transactions (
+    tag              BIGINT NOT NULL,
+    transaction_hash TEXT   NOT NULL,
+    block_hash       TEXT   NOT NULL,
+    block_number     BIGINT NOT NULL,
+    PRIMARY KEY (tag, transaction_hash, block_hash)
+);
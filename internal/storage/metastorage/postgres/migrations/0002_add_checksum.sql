@@ -0,0 +1 @@
+ALTER TABLE block_metadata ADD COLUMN checksum TEXT NOT NULL DEFAULT '';
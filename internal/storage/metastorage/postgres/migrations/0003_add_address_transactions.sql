@@ -0,0 +1,8 @@
+CREATE TABLE address_transactions (
+    tag              BIGINT NOT NULL,
+    address          TEXT   NOT NULL,
+    block_number     BIGINT NOT NULL,
+    transaction_hash TEXT   NOT NULL,
+    block_hash       TEXT   NOT NULL,
+    PRIMARY KEY (tag, address, block_number, transaction_hash, block_hash)
+);
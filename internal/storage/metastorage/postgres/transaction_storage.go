@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/internal"
+	"github.com/coinbase/chainstorage/internal/storage/metastorage/model"
+	"github.com/coinbase/chainstorage/internal/utils/instrument"
+)
+
+type (
+	transactionStorageImpl struct {
+		db                                 *sql.DB
+		instrumentAddOrUpdateTransaction   instrument.Instrument
+		instrumentGetTransaction           instrument.InstrumentWithResult[[]*model.Transaction]
+		instrumentAddAddressTransactions   instrument.Instrument
+		instrumentGetTransactionsByAddress instrument.InstrumentWithResult[[]*model.AddressTransaction]
+	}
+)
+
+const (
+	insertTransactionStatement = `
+		INSERT INTO transactions (tag, transaction_hash, block_hash, block_number)`
+
+	upsertTransactionClause = `
+		ON CONFLICT (tag, transaction_hash, block_hash) DO UPDATE SET block_number = EXCLUDED.block_number`
+
+	getTransactionQuery = `
+		SELECT tag, transaction_hash, block_hash, block_number
+		FROM transactions
+		WHERE tag = $1 AND transaction_hash = $2`
+
+	insertAddressTransactionStatement = `
+		INSERT INTO address_transactions (tag, address, block_number, transaction_hash, block_hash)`
+
+	upsertAddressTransactionClause = `
+		ON CONFLICT (tag, address, block_number, transaction_hash, block_hash) DO NOTHING`
+
+	getTransactionsByAddressQuery = `
+		SELECT tag, address, block_number, transaction_hash, block_hash
+		FROM address_transactions
+		WHERE tag = $1 AND address = $2 AND block_number >= $3 AND block_number < $4
+		ORDER BY block_number, transaction_hash, block_hash
+		LIMIT $5`
+
+	getTransactionsByAddressAfterCursorQuery = `
+		SELECT tag, address, block_number, transaction_hash, block_hash
+		FROM address_transactions
+		WHERE tag = $1 AND address = $2 AND block_number >= $3 AND block_number < $4
+			AND (block_number, transaction_hash, block_hash) > ($6, $7, $8)
+		ORDER BY block_number, transaction_hash, block_hash
+		LIMIT $5`
+)
+
+var _ internal.TransactionStorage = (*transactionStorageImpl)(nil)
+
+func newTransactionStorage(params Params, db *sql.DB) (internal.TransactionStorage, error) {
+	metrics := params.Metrics.SubScope("transaction_storage").Tagged(map[string]string{
+		"storage_type": "postgres",
+	})
+	return &transactionStorageImpl{
+		db:                                 db,
+		instrumentAddOrUpdateTransaction:   instrument.New(metrics, "add_transactions"),
+		instrumentGetTransaction:           instrument.NewWithResult[[]*model.Transaction](metrics, "get_transaction"),
+		instrumentAddAddressTransactions:   instrument.New(metrics, "add_address_transactions"),
+		instrumentGetTransactionsByAddress: instrument.NewWithResult[[]*model.AddressTransaction](metrics, "get_transactions_by_address"),
+	}, nil
+}
+
+// AddTransactions implements internal.TransactionStorage.
+// The transactions are written in batches within a single database transaction, hence parallelism is not used.
+func (t *transactionStorageImpl) AddTransactions(ctx context.Context, transactions []*model.Transaction, parallelism int) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	return t.instrumentAddOrUpdateTransaction.Instrument(ctx, func(ctx context.Context) error {
+		// Duplicates are removed as a single statement cannot update the same row twice.
+		type key struct {
+			tag       uint32
+			hash      string
+			blockHash string
+		}
+		seen := make(map[key]bool, len(transactions))
+		rows := make([][]any, 0, len(transactions))
+		for _, transaction := range transactions {
+			k := key{transaction.BlockTag, transaction.Hash, transaction.BlockHash}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			rows = append(rows, []any{
+				int64(transaction.BlockTag),
+				transaction.Hash,
+				transaction.BlockHash,
+				int64(transaction.BlockNumber),
+			})
+		}
+
+		return runInTransaction(ctx, t.db, nil, func(tx *sql.Tx) error {
+			if err := bulkInsert(ctx, tx, insertTransactionStatement, upsertTransactionClause, rows); err != nil {
+				return xerrors.Errorf("failed to add transactions: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// GetTransaction implements internal.TransactionStorage.
+func (t *transactionStorageImpl) GetTransaction(ctx context.Context, tag uint32, transactionHash string) ([]*model.Transaction, error) {
+	return t.instrumentGetTransaction.Instrument(ctx, func(ctx context.Context) ([]*model.Transaction, error) {
+		rows, err := t.db.QueryContext(ctx, getTransactionQuery, int64(tag), transactionHash)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get transaction: %w", err)
+		}
+		defer rows.Close()
+
+		var transactions []*model.Transaction
+		for rows.Next() {
+			var (
+				blockTag    int64
+				blockNumber int64
+				transaction model.Transaction
+			)
+			if err := rows.Scan(&blockTag, &transaction.Hash, &transaction.BlockHash, &blockNumber); err != nil {
+				return nil, xerrors.Errorf("failed to parse transaction: %w", err)
+			}
+			transaction.BlockTag = uint32(blockTag)
+			transaction.BlockNumber = uint64(blockNumber)
+			transactions = append(transactions, &transaction)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, xerrors.Errorf("failed to get transaction: %w", err)
+		}
+
+		if len(transactions) == 0 {
+			return nil, xerrors.Errorf("transaction %v not found: %w", transactionHash, errors.ErrItemNotFound)
+		}
+		return transactions, nil
+	})
+}
+
+// AddAddressTransactions implements internal.TransactionStorage.
+// Like AddTransactions, the rows are written within a single database transaction, hence parallelism is not used.
+func (t *transactionStorageImpl) AddAddressTransactions(ctx context.Context, addressTransactions []*model.AddressTransaction, parallelism int) error {
+	if len(addressTransactions) == 0 {
+		return nil
+	}
+
+	return t.instrumentAddAddressTransactions.Instrument(ctx, func(ctx context.Context) error {
+		rows := make([][]any, len(addressTransactions))
+		for i, addressTransaction := range addressTransactions {
+			rows[i] = []any{
+				int64(addressTransaction.BlockTag),
+				addressTransaction.Address,
+				int64(addressTransaction.BlockNumber),
+				addressTransaction.TransactionHash,
+				addressTransaction.BlockHash,
+			}
+		}
+
+		return runInTransaction(ctx, t.db, nil, func(tx *sql.Tx) error {
+			if err := bulkInsert(ctx, tx, insertAddressTransactionStatement, upsertAddressTransactionClause, rows); err != nil {
+				return xerrors.Errorf("failed to add address transactions: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// GetTransactionsByAddress implements internal.TransactionStorage.
+func (t *transactionStorageImpl) GetTransactionsByAddress(ctx context.Context, tag uint32, address string, startHeight uint64, endHeight uint64, cursor *model.AddressTransaction, limit int) ([]*model.AddressTransaction, error) {
+	return t.instrumentGetTransactionsByAddress.Instrument(ctx, func(ctx context.Context) ([]*model.AddressTransaction, error) {
+		query := getTransactionsByAddressQuery
+		args := []any{int64(tag), address, int64(startHeight), int64(endHeight), limit}
+		if cursor != nil {
+			query = getTransactionsByAddressAfterCursorQuery
+			args = append(args, int64(cursor.BlockNumber), cursor.TransactionHash, cursor.BlockHash)
+		}
+
+		rows, err := t.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get transactions by address: %w", err)
+		}
+		defer rows.Close()
+
+		var addressTransactions []*model.AddressTransaction
+		for rows.Next() {
+			var (
+				blockTag           int64
+				blockNumber        int64
+				addressTransaction model.AddressTransaction
+			)
+			if err := rows.Scan(&blockTag, &addressTransaction.Address, &blockNumber, &addressTransaction.TransactionHash, &addressTransaction.BlockHash); err != nil {
+				return nil, xerrors.Errorf("failed to parse address transaction: %w", err)
+			}
+			addressTransaction.BlockTag = uint32(blockTag)
+			addressTransaction.BlockNumber = uint64(blockNumber)
+			addressTransactions = append(addressTransactions, &addressTransaction)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, xerrors.Errorf("failed to get transactions by address: %w", err)
+		}
+
+		return addressTransactions, nil
+	})
+}
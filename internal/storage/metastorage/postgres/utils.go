@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	// Postgres limits the number of parameters in a statement to 65535.
+	maxBulkWriteSize = 1000
+)
+
+// bulkInsert inserts the rows in batches of maxBulkWriteSize with multi-row INSERT statements.
+// The statement is built as "<insert> VALUES (...), (...) <onConflict>".
+func bulkInsert(ctx context.Context, tx *sql.Tx, insert string, onConflict string, rows [][]any) error {
+	for start := 0; start < len(rows); start += maxBulkWriteSize {
+		end := start + maxBulkWriteSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		var sb strings.Builder
+		sb.WriteString(insert)
+		sb.WriteString(" VALUES ")
+		args := make([]any, 0, (end-start)*len(rows[start]))
+		for i, row := range rows[start:end] {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("(")
+			for j, value := range row {
+				if j > 0 {
+					sb.WriteString(", ")
+				}
+				args = append(args, value)
+				sb.WriteString(fmt.Sprintf("$%d", len(args)))
+			}
+			sb.WriteString(")")
+		}
+		sb.WriteString(" ")
+		sb.WriteString(onConflict)
+
+		if _, err := tx.ExecContext(ctx, sb.String(), args...); err != nil {
+			return xerrors.Errorf("failed to insert rows [%d, %d): %w", start, end, err)
+		}
+	}
+
+	return nil
+}
+
+// runInTransaction runs fn within a transaction, which is committed if fn succeeds and rolled back otherwise.
+func runInTransaction(ctx context.Context, db *sql.DB, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return xerrors.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return xerrors.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -30,6 +30,7 @@ var (
 	ErrNoEventHistory    = errors.ErrNoEventHistory
 	ErrNoEventAvailable  = errors.ErrNoEventAvailable
 	ErrNoMaxEventIdFound = errors.ErrNoMaxEventIdFound
+	ErrChecksumMismatch  = errors.ErrChecksumMismatch
 )
 
 var (
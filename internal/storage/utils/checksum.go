@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+// MarshalBlock marshals the block into the uncompressed payload persisted in the blob storage.
+// The checksum in the metadata is excluded from the payload,
+// so that re-uploading an identical block always produces an identical payload.
+func MarshalBlock(block *api.Block) ([]byte, error) {
+	if block.Metadata != nil && block.Metadata.Checksum != "" {
+		checksum := block.Metadata.Checksum
+		block.Metadata.Checksum = ""
+		defer func() {
+			block.Metadata.Checksum = checksum
+		}()
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(block)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to marshal block: %w", err)
+	}
+
+	return data, nil
+}
+
+// WriteBlock writes the same payload as MarshalBlock to w, without marshaling it into memory as a whole.
+// Unlike MarshalBlock, the block is not modified, hence it is safe to read concurrently.
+func WriteBlock(w io.Writer, block *api.Block) error {
+	m := block.ProtoReflect()
+	if block.Metadata != nil && block.Metadata.Checksum != "" {
+		// Shallow copy the block so that only the metadata is replaced.
+		metadata := proto.Clone(block.Metadata).(*api.BlockMetadata)
+		metadata.Checksum = ""
+		shallow := m.Type().New()
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			shallow.Set(fd, v)
+			return true
+		})
+		shallow.SetUnknown(m.GetUnknown())
+		shallow.Set(shallow.Descriptor().Fields().ByName("metadata"), protoreflect.ValueOfMessage(metadata.ProtoReflect()))
+		m = shallow
+	}
+
+	if err := writeMessage(w, m); err != nil {
+		return xerrors.Errorf("failed to write block: %w", err)
+	}
+
+	return nil
+}
+
+// ComputeBlockChecksum returns the checksum of the payload of the block,
+// which is hashed as it is being marshaled instead of being buffered.
+func ComputeBlockChecksum(block *api.Block) (string, error) {
+	h := sha256.New()
+	if err := WriteBlock(h, block); err != nil {
+		return "", xerrors.Errorf("failed to compute checksum: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ComputeChecksum returns the hex-encoded SHA-256 checksum of the uncompressed payload.
+func ComputeChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyChecksum verifies the uncompressed payload against the expected checksum.
+// The verification is skipped if the expected checksum is empty, e.g. the block was uploaded before checksums were introduced.
+func VerifyChecksum(data []byte, expected string) error {
+	if expected == "" {
+		return nil
+	}
+
+	h := sha256.New()
+	_, _ = h.Write(data)
+	return verifyChecksum(h, expected)
+}
+
+func verifyChecksum(h hash.Hash, expected string) error {
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return xerrors.Errorf("expected checksum %v but got %v: %w", expected, actual, errors.ErrChecksumMismatch)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"io"
+
+	"github.com/golang/snappy"
+	"golang.org/x/xerrors"
+
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	nopWriteCloser struct {
+		io.Writer
+	}
+)
+
+// NewCompressWriter returns a writer which compresses the data written to it and writes the result to w.
+// The returned writer must be closed to flush the compressed data.
+// The output is compatible with Decompress and NewDecompressReader.
+func (c *Codec) NewCompressWriter(w io.Writer, compression api.Compression) (io.WriteCloser, error) {
+	switch compression {
+	case api.Compression_NONE:
+		return nopWriteCloser{w}, nil
+	case api.Compression_GZIP:
+		return gzip.NewWriter(w), nil
+	case api.Compression_ZSTD:
+		encoder, err := c.zstd.newWriter(w)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to create zstd writer: %w", err)
+		}
+		return encoder, nil
+	case api.Compression_SNAPPY:
+		return snappy.NewBufferedWriter(w), nil
+	default:
+		return nil, xerrors.Errorf("failed to create writer with unsupported type %v", compression.String())
+	}
+}
+
+// NewDecompressReader returns a reader which decompresses the data read from r.
+// Unlike Decompress, the compressed data does not need to be buffered in memory as a whole.
+func (c *Codec) NewDecompressReader(r io.Reader, compression api.Compression) (io.ReadCloser, error) {
+	switch compression {
+	case api.Compression_NONE:
+		return io.NopCloser(r), nil
+	case api.Compression_GZIP:
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to initiate reader: %w", err)
+		}
+		return zr, nil
+	case api.Compression_ZSTD:
+		decoder, err := c.zstd.newReader(r)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to create zstd reader: %w", err)
+		}
+		return decoder, nil
+	case api.Compression_SNAPPY:
+		return io.NopCloser(snappy.NewReader(r)), nil
+	default:
+		return nil, xerrors.Errorf("failed to create reader with unsupported type %v", compression.String())
+	}
+}
+
+// NewCompressReader returns a reader which yields the compressed data read from r.
+// The compression runs in a separate goroutine, which is stopped once the returned reader is closed.
+func (c *Codec) NewCompressReader(r io.Reader, compression api.Compression) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+	writer, err := c.NewCompressWriter(pw, compression)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create compress writer: %w", err)
+	}
+
+	go func() {
+		if _, err := io.Copy(writer, r); err != nil {
+			pw.CloseWithError(xerrors.Errorf("failed to write compressed data: %w", err))
+			return
+		}
+		if err := writer.Close(); err != nil {
+			pw.CloseWithError(xerrors.Errorf("failed to close writer: %w", err))
+			return
+		}
+		_ = pw.Close()
+	}()
+
+	return pr, nil
+}
+
+// NewBlockReader returns a reader which yields the compressed payload of the block.
+// The block is marshaled by WriteBlock straight into the compressor in a separate goroutine,
+// so neither the payload nor the compressed data is held in memory as a whole.
+// The goroutine is stopped once the returned reader is closed.
+func (c *Codec) NewBlockReader(block *api.Block, compression api.Compression) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+	writer, err := c.NewCompressWriter(pw, compression)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create compress writer: %w", err)
+	}
+
+	go func() {
+		if err := WriteBlock(writer, block); err != nil {
+			pw.CloseWithError(xerrors.Errorf("failed to write compressed block: %w", err))
+			return
+		}
+		if err := writer.Close(); err != nil {
+			pw.CloseWithError(xerrors.Errorf("failed to close writer: %w", err))
+			return
+		}
+		_ = pw.Close()
+	}()
+
+	return pr, nil
+}
+
+// ReadBlock decompresses the payload as it is being read from r and unmarshals the block field by field,
+// while its checksum is computed along the way.
+// Neither the compressed data nor the decompressed payload is buffered as a whole;
+// only the bytes fields of the block and its small nested messages are, since they make up the block itself.
+func (c *Codec) ReadBlock(r io.Reader, compression api.Compression, checksum string) (*api.Block, error) {
+	reader, err := c.NewDecompressReader(r, compression)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decompress block data with type %v: %w", compression.String(), err)
+	}
+	defer reader.Close()
+
+	h := sha256.New()
+	payload := io.TeeReader(reader, h)
+	var block api.Block
+	if err := readMessage(bufio.NewReader(payload), block.ProtoReflect()); err != nil {
+		// A corrupted payload is reported as a checksum mismatch, which is more accurate than the decoding error.
+		if checksum != "" {
+			if _, err := io.Copy(io.Discard, payload); err == nil {
+				if err := verifyChecksum(h, checksum); err != nil {
+					return nil, xerrors.Errorf("failed to verify block data: %w", err)
+				}
+			}
+		}
+		return nil, xerrors.Errorf("failed to unmarshal block data: %w", err)
+	}
+
+	if checksum != "" {
+		if err := verifyChecksum(h, checksum); err != nil {
+			return nil, xerrors.Errorf("failed to verify block data: %w", err)
+		}
+	}
+
+	return &block, nil
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
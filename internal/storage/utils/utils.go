@@ -7,23 +7,59 @@ import (
 	"io/ioutil"
 	"strings"
 
+	"github.com/golang/snappy"
 	"golang.org/x/xerrors"
 
+	"github.com/coinbase/chainstorage/internal/config"
 	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
 )
 
+type (
+	// Codec compresses and decompresses the block data of a chain.
+	// The ZSTD compression uses the dictionary configured for the chain, if any,
+	// while the decompression selects the dictionary by the id recorded in the frame header.
+	Codec struct {
+		zstd *zstdCodec
+	}
+)
+
 const (
-	GzipFileSuffix = ".gzip"
+	GzipFileSuffix   = ".gzip"
+	ZstdFileSuffix   = ".zstd"
+	SnappyFileSuffix = ".snappy"
 )
 
 func GetCompressionType(fileURL string) api.Compression {
 	if strings.HasSuffix(fileURL, GzipFileSuffix) {
 		return api.Compression_GZIP
 	}
+	if strings.HasSuffix(fileURL, ZstdFileSuffix) {
+		return api.Compression_ZSTD
+	}
+	if strings.HasSuffix(fileURL, SnappyFileSuffix) {
+		return api.Compression_SNAPPY
+	}
 	return api.Compression_NONE
 }
 
-func Compress(data []byte, compression api.Compression) ([]byte, error) {
+// NewCodec creates the codec of the chain.
+func NewCodec(cfg *config.Config) (*Codec, error) {
+	dictionaries, err := loadZstdDictionaries()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load zstd dictionaries: %w", err)
+	}
+
+	zstd, err := newZstdCodec(cfg.AWS.Storage.ZstdDictionaryId, dictionaries)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create zstd codec: %w", err)
+	}
+
+	return &Codec{
+		zstd: zstd,
+	}, nil
+}
+
+func (c *Codec) Compress(data []byte, compression api.Compression) ([]byte, error) {
 	if compression == api.Compression_NONE {
 		return data, nil
 	}
@@ -41,10 +77,27 @@ func Compress(data []byte, compression api.Compression) ([]byte, error) {
 		return buf.Bytes(), nil
 	}
 
+	if compression == api.Compression_ZSTD {
+		return c.zstd.compress(data), nil
+	}
+
+	if compression == api.Compression_SNAPPY {
+		var buf bytes.Buffer
+		sw := snappy.NewBufferedWriter(&buf)
+		if _, err := sw.Write(data); err != nil {
+			return nil, xerrors.Errorf("failed to write compressed data: %w", err)
+		}
+		if err := sw.Close(); err != nil {
+			return nil, xerrors.Errorf("failed to close writer: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	}
+
 	return nil, xerrors.Errorf("failed to compress with unsupported type %v", compression.String())
 }
 
-func Decompress(data []byte, compression api.Compression) ([]byte, error) {
+func (c *Codec) Decompress(data []byte, compression api.Compression) ([]byte, error) {
 	if compression == api.Compression_NONE {
 		return data, nil
 	}
@@ -64,6 +117,18 @@ func Decompress(data []byte, compression api.Compression) ([]byte, error) {
 		return decoded, nil
 	}
 
+	if compression == api.Compression_ZSTD {
+		return c.zstd.decompress(data)
+	}
+
+	if compression == api.Compression_SNAPPY {
+		decoded, err := ioutil.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to decode snappy data: %w", err)
+		}
+		return decoded, nil
+	}
+
 	return nil, xerrors.Errorf("failed to decompress with unsupported type %v", compression.String())
 }
 
@@ -77,5 +142,15 @@ func GetObjectKey(key string, compression api.Compression) (string, error) {
 		return key, nil
 	}
 
+	if compression == api.Compression_ZSTD {
+		key = fmt.Sprintf("%s%s", key, ZstdFileSuffix)
+		return key, nil
+	}
+
+	if compression == api.Compression_SNAPPY {
+		key = fmt.Sprintf("%s%s", key, SnappyFileSuffix)
+		return key, nil
+	}
+
 	return "", xerrors.Errorf("failed to get object key with unsupported type %v", compression.String())
 }
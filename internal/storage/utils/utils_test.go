@@ -1,8 +1,17 @@
 package utils
 
 import (
+	"bytes"
+	"io"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/coinbase/chainstorage/internal/config"
+	"github.com/coinbase/chainstorage/internal/storage/internal/errors"
+	"github.com/coinbase/chainstorage/internal/utils/fixtures"
 	"github.com/coinbase/chainstorage/internal/utils/testutil"
 	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
 )
@@ -24,6 +33,14 @@ func TestGetCompressionType(t *testing.T) {
 			fileURL:     "a.gzip",
 			compression: api.Compression_GZIP,
 		},
+		{
+			fileURL:     "a.zstd",
+			compression: api.Compression_ZSTD,
+		},
+		{
+			fileURL:     "a.snappy",
+			compression: api.Compression_SNAPPY,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.fileURL, func(t *testing.T) {
@@ -54,6 +71,24 @@ func TestCompress(t *testing.T) {
 			}`),
 			api.Compression_GZIP,
 		},
+		{
+			"blockDataZstd",
+			[]byte(`
+			{
+				"hash": "0xbaa42c",
+				"number": "0xacc290",
+			}`),
+			api.Compression_ZSTD,
+		},
+		{
+			"blockDataSnappy",
+			[]byte(`
+			{
+				"hash": "0xbaa42c",
+				"number": "0xacc290",
+			}`),
+			api.Compression_SNAPPY,
+		},
 		{
 			"blockData",
 			[]byte(`
@@ -64,14 +99,15 @@ func TestCompress(t *testing.T) {
 			api.Compression_NONE,
 		},
 	}
+	codec := newTestCodec(t, 0, nil)
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			require := testutil.Require(t)
 
-			compressed, err := Compress(test.data, test.compression)
+			compressed, err := codec.Compress(test.data, test.compression)
 			require.NoError(err)
 
-			decompressed, err := Decompress(compressed, test.compression)
+			decompressed, err := codec.Decompress(compressed, test.compression)
 			require.NoError(err)
 			require.Equal(decompressed, test.data)
 		})
@@ -94,6 +130,16 @@ func TestGetObjectKey(t *testing.T) {
 			api.Compression_NONE,
 			"key2",
 		},
+		{
+			"key3",
+			api.Compression_ZSTD,
+			"key3.zstd",
+		},
+		{
+			"key4",
+			api.Compression_SNAPPY,
+			"key4.snappy",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.key, func(t *testing.T) {
@@ -105,3 +151,295 @@ func TestGetObjectKey(t *testing.T) {
 		})
 	}
 }
+
+func TestZstdDictionary(t *testing.T) {
+	require := testutil.Require(t)
+
+	dictionaries := map[uint32][]byte{
+		1: fixtures.MustReadFile("storage/zstd_dictionary.bin"),
+	}
+	data := fixtures.MustReadFile("client/polygon/polygon_getblockbynumber.json")
+	compressed, err := newTestCodec(t, 1, dictionaries).Compress(data, api.Compression_ZSTD)
+	require.NoError(err)
+
+	// The dictionary id is recorded in the frame header.
+	var header zstd.Header
+	require.NoError(header.Decode(compressed))
+	require.Equal(uint32(1), header.DictionaryID)
+
+	// The data cannot be decoded without the dictionary.
+	decoder, err := zstd.NewReader(nil)
+	require.NoError(err)
+	defer decoder.Close()
+	_, err = decoder.DecodeAll(compressed, nil)
+	require.Error(err)
+	_, err = newTestCodec(t, 0, nil).Decompress(compressed, api.Compression_ZSTD)
+	require.Error(err)
+	require.Contains(err.Error(), "zstd dictionary 1 not found")
+
+	// The dictionary is selected by the frame header, regardless of the one used by the compression.
+	codec := newTestCodec(t, 0, dictionaries)
+	decompressed, err := codec.Decompress(compressed, api.Compression_ZSTD)
+	require.NoError(err)
+	require.Equal(data, decompressed)
+
+	compressed, err = codec.Compress(data, api.Compression_ZSTD)
+	require.NoError(err)
+	require.NoError(header.Decode(compressed))
+	require.Equal(uint32(0), header.DictionaryID)
+	decompressed, err = codec.Decompress(compressed, api.Compression_ZSTD)
+	require.NoError(err)
+	require.Equal(data, decompressed)
+}
+
+func TestZstdDictionary_NotFound(t *testing.T) {
+	require := testutil.Require(t)
+
+	cfg := new(config.Config)
+	cfg.AWS.Storage.ZstdDictionaryId = 0xffffffff
+	_, err := NewCodec(cfg)
+	require.Error(err)
+	require.Contains(err.Error(), "zstd dictionary 4294967295 not found")
+}
+
+func TestZstdDictionary_Embedded(t *testing.T) {
+	require := testutil.Require(t)
+
+	dictionaries, err := loadZstdDictionaries()
+	require.NoError(err)
+	for id, dictionary := range dictionaries {
+		actual, err := getZstdDictionaryID(dictionary)
+		require.NoError(err)
+		require.Equal(id, actual)
+	}
+}
+
+func TestGetZstdDictionaryID(t *testing.T) {
+	require := testutil.Require(t)
+
+	id, err := getZstdDictionaryID(fixtures.MustReadFile("storage/zstd_dictionary.bin"))
+	require.NoError(err)
+	require.Equal(uint32(1), id)
+
+	_, err = getZstdDictionaryID([]byte("not a dictionary"))
+	require.Error(err)
+
+	_, err = getZstdDictionaryID([]byte{0x37, 0xa4, 0x30, 0xec, 0, 0, 0, 0})
+	require.Error(err)
+}
+
+func TestStreamCompression(t *testing.T) {
+	data := fixtures.MustReadFile("client/polygon/polygon_getblockbynumber.json")
+	tests := []api.Compression{
+		api.Compression_NONE,
+		api.Compression_GZIP,
+		api.Compression_ZSTD,
+		api.Compression_SNAPPY,
+	}
+	codec := newTestCodec(t, 0, nil)
+	for _, compression := range tests {
+		t.Run(compression.String(), func(t *testing.T) {
+			require := testutil.Require(t)
+
+			// The streaming compression is compatible with Decompress.
+			reader, err := codec.NewCompressReader(bytes.NewReader(data), compression)
+			require.NoError(err)
+			compressed, err := io.ReadAll(reader)
+			require.NoError(err)
+			require.NoError(reader.Close())
+			decompressed, err := codec.Decompress(compressed, compression)
+			require.NoError(err)
+			require.Equal(data, decompressed)
+
+			// The streaming decompression is compatible with Compress.
+			compressed, err = codec.Compress(data, compression)
+			require.NoError(err)
+			reader, err = codec.NewDecompressReader(bytes.NewReader(compressed), compression)
+			require.NoError(err)
+			decompressed, err = io.ReadAll(reader)
+			require.NoError(err)
+			require.NoError(reader.Close())
+			require.Equal(data, decompressed)
+		})
+	}
+}
+
+func TestStreamCompression_ZstdDictionary(t *testing.T) {
+	require := testutil.Require(t)
+
+	dictionaries := map[uint32][]byte{
+		1: fixtures.MustReadFile("storage/zstd_dictionary.bin"),
+	}
+	data := fixtures.MustReadFile("client/polygon/polygon_getblockbynumber.json")
+	reader, err := newTestCodec(t, 1, dictionaries).NewCompressReader(bytes.NewReader(data), api.Compression_ZSTD)
+	require.NoError(err)
+	compressed, err := io.ReadAll(reader)
+	require.NoError(err)
+	require.NoError(reader.Close())
+
+	_, err = newTestCodec(t, 0, nil).NewDecompressReader(bytes.NewReader(compressed), api.Compression_ZSTD)
+	require.Error(err)
+	require.Contains(err.Error(), "zstd dictionary 1 not found")
+
+	reader, err = newTestCodec(t, 0, dictionaries).NewDecompressReader(bytes.NewReader(compressed), api.Compression_ZSTD)
+	require.NoError(err)
+	decompressed, err := io.ReadAll(reader)
+	require.NoError(err)
+	require.NoError(reader.Close())
+	require.Equal(data, decompressed)
+}
+
+func TestStreamCompression_Closed(t *testing.T) {
+	require := testutil.Require(t)
+
+	data := fixtures.MustReadFile("client/polygon/polygon_getblockbynumber.json")
+	reader, err := newTestCodec(t, 0, nil).NewCompressReader(bytes.NewReader(data), api.Compression_GZIP)
+	require.NoError(err)
+
+	// Closing the reader early stops the compression.
+	_, err = reader.Read(make([]byte, 16))
+	require.NoError(err)
+	require.NoError(reader.Close())
+	_, err = reader.Read(make([]byte, 16))
+	require.ErrorIs(err, io.ErrClosedPipe)
+}
+
+func newTestCodec(t *testing.T, dictionaryID uint32, dictionaries map[uint32][]byte) *Codec {
+	require := testutil.Require(t)
+
+	zstd, err := newZstdCodec(dictionaryID, dictionaries)
+	require.NoError(err)
+	return &Codec{zstd: zstd}
+}
+
+func TestWriteBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamed bool
+	}{
+		{name: "marshaled", streamed: false},
+		{name: "streamed", streamed: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			if test.streamed {
+				setStreamedMessageSize(t, 0)
+			}
+
+			block := newTestBlock(t)
+			block.Metadata.Checksum = "checksum"
+			expected, err := MarshalBlock(block)
+			require.NoError(err)
+
+			// The output is identical to MarshalBlock, which excludes the checksum without modifying the block.
+			var buf bytes.Buffer
+			require.NoError(WriteBlock(&buf, block))
+			require.Equal(expected, buf.Bytes())
+			require.Equal("checksum", block.Metadata.Checksum)
+
+			checksum, err := ComputeBlockChecksum(block)
+			require.NoError(err)
+			require.Equal(ComputeChecksum(expected), checksum)
+		})
+	}
+}
+
+func TestReadBlock(t *testing.T) {
+	tests := []api.Compression{
+		api.Compression_NONE,
+		api.Compression_GZIP,
+		api.Compression_ZSTD,
+		api.Compression_SNAPPY,
+	}
+	codec := newTestCodec(t, 0, nil)
+	for _, compression := range tests {
+		t.Run(compression.String(), func(t *testing.T) {
+			require := testutil.Require(t)
+
+			// Decode every nested message field by field.
+			setStreamedMessageSize(t, 0)
+
+			block := newTestBlock(t)
+			data, err := MarshalBlock(block)
+			require.NoError(err)
+			checksum := ComputeChecksum(data)
+
+			reader, err := codec.NewBlockReader(block, compression)
+			require.NoError(err)
+			compressed, err := io.ReadAll(reader)
+			require.NoError(err)
+			require.NoError(reader.Close())
+			decompressed, err := codec.Decompress(compressed, compression)
+			require.NoError(err)
+			require.Equal(data, decompressed)
+
+			actual, err := codec.ReadBlock(bytes.NewReader(compressed), compression, checksum)
+			require.NoError(err)
+			require.True(proto.Equal(block, actual))
+		})
+	}
+}
+
+func TestReadBlock_Failures(t *testing.T) {
+	setStreamedMessageSize(t, 0)
+
+	block := newTestBlock(t)
+	data, err := MarshalBlock(block)
+	require := testutil.Require(t)
+	require.NoError(err)
+	checksum := ComputeChecksum(data)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		checksum string
+		expected error
+	}{
+		{
+			name:     "checksum",
+			data:     data,
+			checksum: ComputeChecksum([]byte("expected")),
+			expected: errors.ErrChecksumMismatch,
+		},
+		{
+			name:     "truncated",
+			data:     data[:len(data)-1],
+			checksum: checksum,
+			expected: errors.ErrChecksumMismatch,
+		},
+		{
+			name:     "truncatedWithoutChecksum",
+			data:     data[:len(data)-1],
+			expected: io.ErrUnexpectedEOF,
+		},
+	}
+	codec := newTestCodec(t, 0, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := testutil.Require(t)
+
+			_, err := codec.ReadBlock(bytes.NewReader(test.data), api.Compression_NONE, test.checksum)
+			require.Error(err)
+			require.True(xerrors.Is(err, test.expected), err.Error())
+		})
+	}
+}
+
+func newTestBlock(t *testing.T) *api.Block {
+	require := testutil.Require(t)
+
+	block, err := testutil.LoadRawBlock("parser/ethereum/raw_block_17053184.json")
+	require.NoError(err)
+	return block
+}
+
+// setStreamedMessageSize overrides the size above which the nested messages are encoded and decoded field by field.
+func setStreamedMessageSize(t *testing.T, size int) {
+	original := streamedMessageSize
+	streamedMessageSize = size
+	t.Cleanup(func() {
+		streamedMessageSize = original
+	})
+}
@@ -0,0 +1,315 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type (
+	byteReader interface {
+		io.Reader
+		io.ByteReader
+	}
+
+	// wireReader reads at most the remaining bytes of a length-delimited field.
+	wireReader struct {
+		reader    byteReader
+		remaining int64
+	}
+)
+
+var (
+	// streamedMessageSize is the size above which a nested message is encoded or decoded field by field.
+	// The smaller messages are simply marshaled or unmarshaled as a whole.
+	streamedMessageSize = 1 << 20
+
+	deterministicMarshal = proto.MarshalOptions{Deterministic: true}
+)
+
+const (
+	// maxPreallocatedSize limits the buffer allocated upfront for a length-delimited field,
+	// so that a corrupted length fails on EOF instead of allocating an arbitrary amount of memory.
+	maxPreallocatedSize = 64 << 20
+)
+
+// writeMessage writes the deterministic wire encoding of m to w.
+// The output is identical to proto.MarshalOptions{Deterministic: true}, which encodes the fields in the order of their
+// numbers followed by the unknown fields. However, the bytes fields and the large nested messages are written as they
+// are, so the encoding of m is never held in memory as a whole.
+func writeMessage(w io.Writer, m protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	ordered := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := range ordered {
+		ordered[i] = fields.Get(i)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Number() < ordered[j].Number()
+	})
+
+	for _, fd := range ordered {
+		if !m.Has(fd) {
+			continue
+		}
+
+		var err error
+		switch {
+		case fd.IsMap() || (fd.Kind() != protoreflect.BytesKind && fd.Kind() != protoreflect.MessageKind):
+			err = writeField(w, m, fd)
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = writeValue(w, fd, list.Get(i))
+			}
+		default:
+			err = writeValue(w, fd, m.Get(fd))
+		}
+		if err != nil {
+			return xerrors.Errorf("failed to write field %v: %w", fd.FullName(), err)
+		}
+	}
+
+	if _, err := w.Write(m.GetUnknown()); err != nil {
+		return xerrors.Errorf("failed to write unknown fields: %w", err)
+	}
+
+	return nil
+}
+
+// writeField writes a field which is neither a bytes field nor a message field.
+// Such fields are small, hence they are marshaled on their own.
+func writeField(w io.Writer, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	field := m.Type().New()
+	field.Set(fd, m.Get(fd))
+	data, err := deterministicMarshal.Marshal(field.Interface())
+	if err != nil {
+		return xerrors.Errorf("failed to marshal field: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// writeValue writes a single bytes value or message value, including its tag and length.
+func writeValue(w io.Writer, fd protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	prefix := protowire.AppendTag(nil, fd.Number(), protowire.BytesType)
+	if fd.Kind() == protoreflect.BytesKind {
+		data := value.Bytes()
+		prefix = protowire.AppendVarint(prefix, uint64(len(data)))
+		if _, err := w.Write(prefix); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	message := value.Message()
+	size := deterministicMarshal.Size(message.Interface())
+	prefix = protowire.AppendVarint(prefix, uint64(size))
+	if _, err := w.Write(prefix); err != nil {
+		return err
+	}
+
+	if size > streamedMessageSize {
+		return writeMessage(w, message)
+	}
+
+	data, err := deterministicMarshal.Marshal(message.Interface())
+	if err != nil {
+		return xerrors.Errorf("failed to marshal message: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// readMessage merges the wire encoding read from r into m, until r is exhausted.
+// This is the counterpart of writeMessage: the bytes fields and the large nested messages are decoded as they are
+// being read, while the other fields are merged into m one at a time.
+func readMessage(r byteReader, m protoreflect.Message) error {
+	for {
+		tag, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return xerrors.Errorf("failed to read tag: %w", err)
+		}
+
+		num, typ := protowire.DecodeTag(tag)
+		if num < protowire.MinValidNumber {
+			return xerrors.Errorf("invalid field number %v", num)
+		}
+
+		fd := m.Descriptor().Fields().ByNumber(num)
+		var value []byte
+		switch typ {
+		case protowire.VarintType:
+			v, err := readVarint(r)
+			if err != nil {
+				return xerrors.Errorf("failed to read varint field %v: %w", num, err)
+			}
+			value = protowire.AppendVarint(nil, v)
+		case protowire.Fixed32Type:
+			value, err = readBytes(r, 4)
+			if err != nil {
+				return xerrors.Errorf("failed to read fixed32 field %v: %w", num, err)
+			}
+		case protowire.Fixed64Type:
+			value, err = readBytes(r, 8)
+			if err != nil {
+				return xerrors.Errorf("failed to read fixed64 field %v: %w", num, err)
+			}
+		case protowire.BytesType:
+			size, err := readVarint(r)
+			if err != nil {
+				return xerrors.Errorf("failed to read length of field %v: %w", num, err)
+			}
+
+			ok, err := readValue(r, m, fd, size)
+			if err != nil {
+				return xerrors.Errorf("failed to read field %v: %w", fd.FullName(), err)
+			}
+			if ok {
+				continue
+			}
+
+			data, err := readBytes(r, size)
+			if err != nil {
+				return xerrors.Errorf("failed to read field %v: %w", num, err)
+			}
+			value = protowire.AppendBytes(nil, data)
+		default:
+			return xerrors.Errorf("unsupported wire type %v of field %v", typ, num)
+		}
+
+		field := protowire.AppendTag(nil, num, typ)
+		field = append(field, value...)
+		if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(field, m.Interface()); err != nil {
+			return xerrors.Errorf("failed to unmarshal field %v: %w", num, err)
+		}
+	}
+}
+
+// readValue reads a bytes value or a large message value into m.
+// It returns false if the field should be merged as a whole instead, i.e. nothing has been read from r.
+func readValue(r byteReader, m protoreflect.Message, fd protoreflect.FieldDescriptor, size uint64) (bool, error) {
+	if fd == nil || fd.IsMap() {
+		return false, nil
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		data, err := readBytes(r, size)
+		if err != nil {
+			return false, err
+		}
+		if fd.IsList() {
+			m.Mutable(fd).List().Append(protoreflect.ValueOfBytes(data))
+		} else {
+			m.Set(fd, protoreflect.ValueOfBytes(data))
+		}
+		return true, nil
+
+	case protoreflect.MessageKind:
+		if size <= uint64(streamedMessageSize) || size > math.MaxInt64 {
+			return false, nil
+		}
+
+		reader := &wireReader{reader: r, remaining: int64(size)}
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			element := list.NewElement()
+			if err := readMessage(reader, element.Message()); err != nil {
+				return false, err
+			}
+			list.Append(element)
+		} else {
+			if err := readMessage(reader, m.Mutable(fd).Message()); err != nil {
+				return false, err
+			}
+		}
+		if reader.remaining != 0 {
+			return false, io.ErrUnexpectedEOF
+		}
+		return true, nil
+
+	default:
+		return false, nil
+	}
+}
+
+func readVarint(r io.ByteReader) (uint64, error) {
+	v, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return v, err
+}
+
+func readBytes(r io.Reader, size uint64) ([]byte, error) {
+	if size > math.MaxInt64 {
+		return nil, xerrors.Errorf("invalid length %v", size)
+	}
+
+	if size <= maxPreallocatedSize {
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(maxPreallocatedSize)
+	if _, err := io.CopyN(&buf, r, int64(size)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *wireReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	return n, err
+}
+
+func (r *wireReader) ReadByte() (byte, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+
+	b, err := r.reader.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	r.remaining--
+	return b, nil
+}
@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"bufio"
+	"embed"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/xerrors"
+)
+
+type (
+	zstdCodec struct {
+		// dictionaryID is the id of the dictionary used by the encoder, or zero if no dictionary is used.
+		dictionaryID uint32
+		encoder      *zstd.Encoder
+		// The decoders and the dictionaries are keyed by the dictionary id, where zero stands for no dictionary.
+		decoders     map[uint32]*zstd.Decoder
+		dictionaries map[uint32][]byte
+	}
+
+	zstdReadCloser struct {
+		*zstd.Decoder
+	}
+)
+
+const (
+	// The dictionaries are stored as <name>.dict files, e.g. trained by `zstd --train --dictID <id>`.
+	zstdDictionaryDirectory = "zstd_dictionaries"
+	zstdDictionarySuffix    = ".dict"
+
+	// A dictionary in the zstd format starts with the magic number followed by the dictionary id.
+	// https://github.com/facebook/zstd/blob/dev/doc/zstd_compression_format.md#dictionary-format
+	zstdDictionaryMagic      = uint32(0xEC30A437)
+	zstdDictionaryHeaderSize = 8
+)
+
+// The dictionaries are embedded so that every binary, including the SDK, can decompress the blocks compressed with them.
+//
+//go:embed zstd_dictionaries
+var zstdDictionaryFS embed.FS
+
+// loadZstdDictionaries returns the embedded dictionaries keyed by their ids.
+func loadZstdDictionaries() (map[uint32][]byte, error) {
+	entries, err := fs.ReadDir(zstdDictionaryFS, zstdDictionaryDirectory)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read zstd dictionaries: %w", err)
+	}
+
+	dictionaries := make(map[uint32][]byte)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), zstdDictionarySuffix) {
+			continue
+		}
+
+		dictionary, err := zstdDictionaryFS.ReadFile(path.Join(zstdDictionaryDirectory, entry.Name()))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read zstd dictionary %v: %w", entry.Name(), err)
+		}
+
+		id, err := getZstdDictionaryID(dictionary)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid zstd dictionary %v: %w", entry.Name(), err)
+		}
+
+		if _, ok := dictionaries[id]; ok {
+			return nil, xerrors.Errorf("duplicate zstd dictionary id %v in %v", id, entry.Name())
+		}
+
+		dictionaries[id] = dictionary
+	}
+
+	return dictionaries, nil
+}
+
+func getZstdDictionaryID(dictionary []byte) (uint32, error) {
+	if len(dictionary) < zstdDictionaryHeaderSize || binary.LittleEndian.Uint32(dictionary) != zstdDictionaryMagic {
+		return 0, xerrors.New("unexpected magic number")
+	}
+
+	id := binary.LittleEndian.Uint32(dictionary[4:])
+	if id == 0 {
+		return 0, xerrors.New("dictionary id must not be zero")
+	}
+
+	return id, nil
+}
+
+// newZstdCodec creates the codec which compresses with the given dictionary, or without a dictionary if the id is zero.
+// Any of the dictionaries can be used by the decompression.
+func newZstdCodec(dictionaryID uint32, dictionaries map[uint32][]byte) (*zstdCodec, error) {
+	var encoderOptions []zstd.EOption
+	if dictionaryID != 0 {
+		dictionary, ok := dictionaries[dictionaryID]
+		if !ok {
+			return nil, xerrors.Errorf("zstd dictionary %v not found", dictionaryID)
+		}
+
+		encoderOptions = append(encoderOptions, zstd.WithEncoderDict(dictionary))
+	}
+
+	encoder, err := zstd.NewWriter(nil, encoderOptions...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create zstd encoder: %w", err)
+	}
+
+	decoders := make(map[uint32]*zstd.Decoder, len(dictionaries)+1)
+	decoders[0], err = zstd.NewReader(nil)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create zstd decoder: %w", err)
+	}
+
+	for id, dictionary := range dictionaries {
+		decoders[id], err = zstd.NewReader(nil, zstd.WithDecoderDicts(dictionary))
+		if err != nil {
+			return nil, xerrors.Errorf("failed to create zstd decoder with dictionary %v: %w", id, err)
+		}
+	}
+
+	return &zstdCodec{
+		dictionaryID: dictionaryID,
+		encoder:      encoder,
+		decoders:     decoders,
+		dictionaries: dictionaries,
+	}, nil
+}
+
+func (c *zstdCodec) compress(data []byte) []byte {
+	return c.encoder.EncodeAll(data, nil)
+}
+
+// decompress decodes the data with the dictionary referenced by its frame header.
+func (c *zstdCodec) decompress(data []byte) ([]byte, error) {
+	var header zstd.Header
+	if err := header.Decode(data); err != nil {
+		return nil, xerrors.Errorf("failed to decode zstd frame header: %w", err)
+	}
+
+	decoder, ok := c.decoders[header.DictionaryID]
+	if !ok {
+		return nil, xerrors.Errorf("zstd dictionary %v not found", header.DictionaryID)
+	}
+
+	decoded, err := decoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode zstd data: %w", err)
+	}
+
+	return decoded, nil
+}
+
+// newWriter creates a streaming encoder, which is stateful and thus cannot be shared.
+func (c *zstdCodec) newWriter(w io.Writer) (io.WriteCloser, error) {
+	var options []zstd.EOption
+	if c.dictionaryID != 0 {
+		options = append(options, zstd.WithEncoderDict(c.dictionaries[c.dictionaryID]))
+	}
+
+	return zstd.NewWriter(w, options...)
+}
+
+// newReader creates a streaming decoder with the dictionary referenced by the frame header,
+// which is peeked from the reader without consuming it.
+func (c *zstdCodec) newReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	peeked, err := br.Peek(zstd.HeaderMaxSize)
+	if err != nil && err != io.EOF {
+		return nil, xerrors.Errorf("failed to read zstd frame header: %w", err)
+	}
+
+	var header zstd.Header
+	if err := header.Decode(peeked); err != nil {
+		return nil, xerrors.Errorf("failed to decode zstd frame header: %w", err)
+	}
+
+	var options []zstd.DOption
+	if header.DictionaryID != 0 {
+		dictionary, ok := c.dictionaries[header.DictionaryID]
+		if !ok {
+			return nil, xerrors.Errorf("zstd dictionary %v not found", header.DictionaryID)
+		}
+
+		options = append(options, zstd.WithDecoderDicts(dictionary))
+	}
+
+	decoder, err := zstd.NewReader(br, options...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create zstd decoder: %w", err)
+	}
+
+	return &zstdReadCloser{decoder}, nil
+}
+
+// Close implements io.Closer.
+// zstd.Decoder.Close does not return an error, hence it does not implement io.Closer on its own.
+func (r *zstdReadCloser) Close() error {
+	r.Decoder.Close()
+	return nil
+}
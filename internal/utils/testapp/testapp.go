@@ -60,16 +60,27 @@ var (
 				"base-goerli",
 				"base-mainnet",
 				"bitcoin-mainnet",
+				"blast-mainnet",
 				"bsc-mainnet",
+				"cardano-mainnet",
+				"cosmos-mainnet",
 				"dogecoin-mainnet",
+				"dogecoin-testnet",
 				"ethereum-goerli",
 				"ethereum-holesky",
+				"ethereum-holesky-beacon",
 				"ethereum-mainnet",
+				"ethereum-mainnet-beacon",
 				"fantom-mainnet",
+				"linea-mainnet",
+				"litecoin-mainnet",
 				"optimism-mainnet",
 				"polygon-mainnet",
 				"polygon-testnet",
+				"scroll-mainnet",
 				"solana-mainnet",
+				"sui-mainnet",
+				"zksync-mainnet",
 			},
 		},
 	}
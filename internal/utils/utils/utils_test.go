@@ -23,6 +23,14 @@ func TestParseCompression(t *testing.T) {
 			name:     "GZIP",
 			expected: api.Compression_GZIP,
 		},
+		{
+			name:     "zstd",
+			expected: api.Compression_ZSTD,
+		},
+		{
+			name:     "snappy",
+			expected: api.Compression_SNAPPY,
+		},
 		{
 			name:     "none",
 			expected: api.Compression_NONE,
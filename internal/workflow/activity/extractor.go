@@ -43,6 +43,7 @@ type (
 		RehydrateFromTag *uint32
 		UpgradeFromTag   *uint32
 		DataCompression  api.Compression
+		Reencode         bool
 		Failover         bool
 	}
 
@@ -81,6 +82,10 @@ func (a *Extractor) execute(ctx context.Context, request *ExtractorRequest) (*Ex
 		return nil, xerrors.Errorf("RehydrateFromTag and UpgradeFromTag cannot exist simultaneously")
 	}
 
+	if request.Reencode && (request.RehydrateFromTag != nil || request.UpgradeFromTag != nil) {
+		return nil, xerrors.Errorf("Reencode cannot be combined with RehydrateFromTag or UpgradeFromTag")
+	}
+
 	if request.Failover {
 		failoverCtx, err := a.failoverManager.WithFailoverContext(ctx, endpoints.MasterSlaveClusters)
 		if err != nil {
@@ -109,6 +114,12 @@ func (a *Extractor) execute(ctx context.Context, request *ExtractorRequest) (*Ex
 					logger.Error("failed to rehydrate block", zap.Error(err))
 					return xerrors.Errorf("failed to rehydrate block: %w", err)
 				}
+			} else if request.Reencode {
+				block, err = a.reencodeBlock(ctx, height, request, logger)
+				if err != nil {
+					logger.Error("failed to reencode block", zap.Error(err))
+					return xerrors.Errorf("failed to reencode block: %w", err)
+				}
 			}
 
 			if block == nil {
@@ -126,7 +137,8 @@ func (a *Extractor) execute(ctx context.Context, request *ExtractorRequest) (*Ex
 				}
 			}
 
-			objectKey, err := a.blobStorage.Upload(ctx, block, request.DataCompression)
+			// The extractor is run by the backfiller, which may extract the same blocks again.
+			objectKey, err := a.blobStorage.Upload(ctx, block, request.DataCompression, blobstorage.WithDeduplication())
 			if err != nil {
 				logger.Error("failed to upload to blob store", zap.Error(err))
 				return xerrors.Errorf("failed to upload to blob store: %w", err)
@@ -215,6 +227,26 @@ func (a *Extractor) rehydrateBlock(ctx context.Context, height uint64, request *
 	return block, nil
 }
 
+// reencodeBlock loads the existing block so that it is uploaded again with the requested compression.
+// If the block does not exist yet, nil is returned and the block is extracted from the node instead.
+func (a *Extractor) reencodeBlock(ctx context.Context, height uint64, request *ExtractorRequest, logger *zap.Logger) (*api.Block, error) {
+	block, err := a.downloadBlock(ctx, request.Tag, height)
+	if err != nil {
+		if xerrors.Is(err, storage.ErrItemNotFound) {
+			return nil, nil
+		}
+		return nil, xerrors.Errorf("failed to get original block: %w", err)
+	}
+
+	logger.Info(
+		"reencoding block",
+		zap.Uint64("height", block.Metadata.Height),
+		zap.String("object_key", block.Metadata.ObjectKeyMain),
+		zap.String("compression", request.DataCompression.String()),
+	)
+	return block, nil
+}
+
 func (a *Extractor) downloadBlock(ctx context.Context, tag uint32, height uint64) (*api.Block, error) {
 	metadata, err := a.metaStorage.GetBlockByHeight(ctx, tag, height)
 	if err != nil {
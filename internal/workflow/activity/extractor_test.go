@@ -13,6 +13,7 @@ import (
 	clientmocks "github.com/coinbase/chainstorage/internal/blockchain/client/mocks"
 	"github.com/coinbase/chainstorage/internal/cadence"
 	"github.com/coinbase/chainstorage/internal/dlq"
+	"github.com/coinbase/chainstorage/internal/storage"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage"
 	blobstoragemocks "github.com/coinbase/chainstorage/internal/storage/blobstorage/mocks"
 	"github.com/coinbase/chainstorage/internal/storage/metastorage"
@@ -90,7 +91,7 @@ func (s *ExtractorTestSuite) TestSuccess() {
 		},
 	}
 	s.blockchainClient.EXPECT().GetBlockByHeight(gomock.Any(), tag, height).Return(block, nil)
-	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_NONE).Return(objectKey, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_NONE, gomock.Any()).Return(objectKey, nil)
 
 	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
 		Tag:     tag,
@@ -126,7 +127,7 @@ func (s *ExtractorTestSuite) TestMiniBatch() {
 			},
 		}
 		s.blockchainClient.EXPECT().GetBlockByHeight(gomock.Any(), tag, height+i).Return(block, nil)
-		s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_NONE).Return(objectKey+strconv.Itoa(int(i)), nil)
+		s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_NONE, gomock.Any()).Return(objectKey+strconv.Itoa(int(i)), nil)
 		heights[i] = height + i
 	}
 
@@ -163,7 +164,7 @@ func (s *ExtractorTestSuite) TestWithBestEffort() {
 		},
 	}
 	s.blockchainClient.EXPECT().GetBlockByHeight(gomock.Any(), tag, height, gomock.Any()).Return(block, nil)
-	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_NONE).Return(objectKey, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_NONE, gomock.Any()).Return(objectKey, nil)
 	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
 		Tag:            tag,
 		Heights:        []uint64{height},
@@ -229,7 +230,7 @@ func (s *ExtractorTestSuite) TestUpgradeSuccess() {
 	s.metaStorage.EXPECT().GetBlockByHeight(gomock.Any(), oldTag, height).Return(metadata, nil)
 	s.blobStorage.EXPECT().Download(gomock.Any(), block.Metadata).Return(block, nil)
 	s.blockchainClient.EXPECT().UpgradeBlock(gomock.Any(), block, newTag).Return(newBlock, nil)
-	s.blobStorage.EXPECT().Upload(gomock.Any(), newBlock, api.Compression_NONE).Return(objectKey, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), newBlock, api.Compression_NONE, gomock.Any()).Return(objectKey, nil)
 	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
 		Tag:            newTag,
 		Heights:        []uint64{height},
@@ -275,7 +276,7 @@ func (s *ExtractorTestSuite) TestRehydrateSuccess() {
 	}
 	s.metaStorage.EXPECT().GetBlockByHeight(gomock.Any(), oldTag, height).Return(metadata, nil)
 	s.blobStorage.EXPECT().Download(gomock.Any(), block.Metadata).Return(block, nil)
-	s.blobStorage.EXPECT().Upload(gomock.Any(), newBlock, api.Compression_NONE).Return(objectKey, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), newBlock, api.Compression_NONE, gomock.Any()).Return(objectKey, nil)
 	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
 		Tag:              newTag,
 		Heights:          []uint64{height},
@@ -309,7 +310,7 @@ func (s *ExtractorTestSuite) TestWithDataCompression() {
 		},
 	}
 	s.blockchainClient.EXPECT().GetBlockByHeight(gomock.Any(), tag, height, gomock.Any()).Return(block, nil)
-	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_GZIP).Return(objectKey, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_GZIP, gomock.Any()).Return(objectKey, nil)
 	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
 		Tag:             tag,
 		Heights:         []uint64{height},
@@ -324,3 +325,86 @@ func (s *ExtractorTestSuite) TestWithDataCompression() {
 	require.Equal(hash, metadata.Hash)
 	require.Equal(objectKey, metadata.ObjectKeyMain)
 }
+
+func (s *ExtractorTestSuite) TestReencodeSuccess() {
+	const (
+		tag          uint32 = 1
+		height       uint64 = 123456
+		hash                = "0xabcd"
+		oldObjectKey        = "foo/bar.gzip"
+		newObjectKey        = "foo/bar.zstd"
+	)
+
+	require := testutil.Require(s.T())
+
+	metadata := &api.BlockMetadata{
+		Tag:           tag,
+		Hash:          hash,
+		Height:        height,
+		ObjectKeyMain: oldObjectKey,
+	}
+	block := &api.Block{
+		Metadata: metadata,
+	}
+	s.metaStorage.EXPECT().GetBlockByHeight(gomock.Any(), tag, height).Return(metadata, nil)
+	s.blobStorage.EXPECT().Download(gomock.Any(), metadata).Return(block, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_ZSTD, gomock.Any()).Return(newObjectKey, nil)
+	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
+		Tag:             tag,
+		Heights:         []uint64{height},
+		DataCompression: api.Compression_ZSTD,
+		Reencode:        true,
+	})
+
+	require.NoError(err)
+	require.Equal(1, len(response.Metadatas))
+	metadata = response.Metadatas[0]
+	require.Equal(tag, metadata.Tag)
+	require.Equal(height, metadata.Height)
+	require.Equal(hash, metadata.Hash)
+	require.Equal(newObjectKey, metadata.ObjectKeyMain)
+}
+
+func (s *ExtractorTestSuite) TestReencodeNotFound() {
+	const (
+		tag       uint32 = 1
+		height    uint64 = 123456
+		hash             = "0xabcd"
+		objectKey        = "foo/bar.snappy"
+	)
+
+	require := testutil.Require(s.T())
+
+	block := &api.Block{
+		Metadata: &api.BlockMetadata{
+			Tag:    tag,
+			Hash:   hash,
+			Height: height,
+		},
+	}
+	s.metaStorage.EXPECT().GetBlockByHeight(gomock.Any(), tag, height).Return(nil, storage.ErrItemNotFound)
+	s.blockchainClient.EXPECT().GetBlockByHeight(gomock.Any(), tag, height).Return(block, nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), block, api.Compression_SNAPPY, gomock.Any()).Return(objectKey, nil)
+	response, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
+		Tag:             tag,
+		Heights:         []uint64{height},
+		DataCompression: api.Compression_SNAPPY,
+		Reencode:        true,
+	})
+
+	require.NoError(err)
+	require.Equal(1, len(response.Metadatas))
+	require.Equal(objectKey, response.Metadatas[0].ObjectKeyMain)
+}
+
+func (s *ExtractorTestSuite) TestReencodeWithRehydrate() {
+	require := testutil.Require(s.T())
+
+	_, err := s.extractor.Execute(s.env.BackgroundContext(), &ExtractorRequest{
+		Tag:              1,
+		Heights:          []uint64{123456},
+		RehydrateFromTag: pointer.Ref(uint32(0)),
+		Reencode:         true,
+	})
+	require.Error(err)
+}
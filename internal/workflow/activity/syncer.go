@@ -15,6 +15,7 @@ import (
 
 	"github.com/coinbase/chainstorage/internal/blockchain/client"
 	"github.com/coinbase/chainstorage/internal/blockchain/endpoints"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
 	"github.com/coinbase/chainstorage/internal/cadence"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage"
 	"github.com/coinbase/chainstorage/internal/storage/metastorage"
@@ -54,7 +55,9 @@ type (
 		slaveBlockchainClient     client.Client // Used to ingest data concurrently. It may be connected to multiple clusters for load balancing purposes.
 		consensusBlockchainClient client.Client // Used to run consensus validation. It may be connected to multiple clusters for load balancing purposes.
 		failoverManager           endpoints.FailoverManager
+		parser                    parser.Parser
 		blockStartHeight          uint64
+		addressIndexing           bool // Populate the address index along with the transaction index.
 		metrics                   *syncerMetrics
 	}
 
@@ -67,6 +70,7 @@ type (
 		BlobStorage      blobstorage.BlobStorage
 		BlockchainClient client.ClientParams
 		FailoverManager  endpoints.FailoverManager
+		Parser           parser.Parser
 	}
 
 	SyncerRequest struct {
@@ -103,6 +107,7 @@ type (
 		instrumentSearchForkBlock           instrument.InstrumentWithResult[*api.BlockMetadata]
 		instrumentSafeGetBlock              instrument.InstrumentWithResult[*api.BlockMetadata]
 		instrumentAddTransactionsInParallel instrument.Instrument
+		instrumentAddAddressTransactions    instrument.Instrument
 		instrumentConsensusValidation       instrument.Instrument
 		reorgCounter                        tally.Counter
 		reorgDistanceGauge                  tally.Gauge
@@ -124,7 +129,9 @@ func NewSyncer(params SyncerParams) *Syncer {
 		slaveBlockchainClient:     params.BlockchainClient.Slave,
 		consensusBlockchainClient: params.BlockchainClient.Consensus,
 		failoverManager:           params.FailoverManager,
+		parser:                    params.Parser,
 		blockStartHeight:          params.Config.Chain.BlockStartHeight,
+		addressIndexing:           params.Config.Chain.Feature.AddressIndexing,
 		metrics:                   newSyncerMetrics(params.Metrics),
 	}
 	a.register(a.execute)
@@ -139,6 +146,7 @@ func newSyncerMetrics(scope tally.Scope) *syncerMetrics {
 		instrumentSearchForkBlock:           instrument.NewWithResult[*api.BlockMetadata](scope, "search_fork_block"),
 		instrumentSafeGetBlock:              instrument.NewWithResult[*api.BlockMetadata](scope, "safe_get_block"),
 		instrumentAddTransactionsInParallel: instrument.New(scope, "add_transactions_in_parallel"),
+		instrumentAddAddressTransactions:    instrument.New(scope, "add_address_transactions"),
 		instrumentConsensusValidation:       instrument.New(scope, "consensus_validation"),
 		reorgCounter:                        scope.Counter("reorg"),
 		reorgDistanceGauge:                  scope.Gauge("reorg_distance"),
@@ -706,6 +714,16 @@ func (a *Syncer) safeGetBlock(
 
 				return nil
 			})
+			if a.addressIndexing {
+				group.Go(func() error {
+					err := a.addAddressTransactions(ctx, logger, outBlock, transactionIndexingParallelism)
+					if err != nil {
+						return xerrors.Errorf("failed to add address transactions: %w", err)
+					}
+
+					return nil
+				})
+			}
 
 			if err := group.Wait(); err != nil {
 				return nil, err
@@ -777,6 +795,49 @@ func (a *Syncer) addTransactionsInParallel(
 	return nil
 }
 
+// addAddressTransactions parses the block and indexes the transactions by the addresses they touch.
+func (a *Syncer) addAddressTransactions(
+	ctx context.Context,
+	logger *zap.Logger,
+	block *api.Block,
+	parallelism int) error {
+	if block.Metadata.Skipped {
+		return nil
+	}
+
+	return a.metrics.instrumentAddAddressTransactions.Instrument(ctx, func(ctx context.Context) error {
+		nativeBlock, err := a.parser.ParseNativeBlock(ctx, block)
+		if err != nil {
+			return xerrors.Errorf("failed to parse block: %w", err)
+		}
+
+		transactionAddresses, err := parser.GetTransactionAddresses(nativeBlock)
+		if err != nil {
+			return xerrors.Errorf("failed to get transaction addresses: %w", err)
+		}
+
+		var addressTransactions []*model.AddressTransaction
+		for _, transaction := range transactionAddresses {
+			for _, address := range transaction.Addresses {
+				addressTransactions = append(addressTransactions, &model.AddressTransaction{
+					Address:         address,
+					TransactionHash: transaction.TransactionHash,
+					BlockNumber:     block.Metadata.Height,
+					BlockHash:       block.Metadata.Hash,
+					BlockTag:        block.Metadata.Tag,
+				})
+			}
+		}
+
+		if err := a.metaStorage.AddAddressTransactions(ctx, addressTransactions, parallelism); err != nil {
+			logger.Error("failed to add address transactions", zap.Error(err))
+			return xerrors.Errorf("failed to add address transactions: %w", err)
+		}
+
+		return nil
+	})
+}
+
 func (a *Syncer) getBlockFromClient(
 	ctx context.Context,
 	inBlock *api.BlockMetadata,
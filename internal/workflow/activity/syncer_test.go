@@ -15,6 +15,7 @@ import (
 	clientmocks "github.com/coinbase/chainstorage/internal/blockchain/client/mocks"
 	"github.com/coinbase/chainstorage/internal/blockchain/endpoints"
 	"github.com/coinbase/chainstorage/internal/blockchain/parser"
+	parsermocks "github.com/coinbase/chainstorage/internal/blockchain/parser/mocks"
 	"github.com/coinbase/chainstorage/internal/cadence"
 	"github.com/coinbase/chainstorage/internal/config"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage"
@@ -45,6 +46,7 @@ type SyncerTestSuite struct {
 	slaveBlockchainClient     *clientmocks.MockClient
 	validatorBlockchainClient *clientmocks.MockClient
 	consensusBlockchainClient *clientmocks.MockClient
+	parser                    *parsermocks.MockParser
 	masterEndpointProvider    endpoints.EndpointProvider
 	slaveEndpointProvider     endpoints.EndpointProvider
 	cfg                       *config.Config
@@ -943,6 +945,74 @@ func (s *SyncerTestSuite) TestAddOrUpdateTransactionsInParallel_Err() {
 	require.Error(err)
 }
 
+func (s *SyncerTestSuite) TestAddAddressTransactions() {
+	require := testutil.Require(s.T())
+
+	s.syncer.addressIndexing = true
+	stableTag := uint32(2)
+	s.masterBlockchainClient.EXPECT().GetLatestHeight(gomock.Any()).Return(uint64(102), nil)
+	s.metaStorage.EXPECT().GetLatestBlock(gomock.Any(), stableTag).Return(&api.BlockMetadata{Height: 100}, nil)
+
+	beforeFork := testutil.MakeBlockMetadatasFromStartHeight(98, 3, stableTag)
+	s.masterBlockchainClient.EXPECT().
+		BatchGetBlockMetadata(gomock.Any(), stableTag, gomock.Any(), gomock.Any()).
+		Return(beforeFork, nil)
+	s.metaStorage.EXPECT().
+		GetBlocksByHeightRange(gomock.Any(), stableTag, gomock.Any(), gomock.Any()).
+		Return(beforeFork, nil)
+
+	s.masterBlockchainClient.EXPECT().
+		BatchGetBlockMetadata(gomock.Any(), stableTag, gomock.Any(), gomock.Any()).
+		Return(testutil.MakeBlockMetadatasFromStartHeight(101, 1, stableTag), nil)
+	block := testutil.MakeBlocksWithTransactionsFromStartHeight(101, 1, stableTag, 2)[0]
+	s.slaveBlockchainClient.EXPECT().
+		GetBlockByHash(gomock.Any(), stableTag, gomock.Any(), gomock.Any()).
+		Return(block, nil)
+
+	s.parser.EXPECT().ParseNativeBlock(gomock.Any(), block).Return(&api.NativeBlock{
+		Block: &api.NativeBlock_Ethereum{
+			Ethereum: &api.EthereumBlock{
+				Transactions: []*api.EthereumTransaction{
+					{Hash: "transactionHash0", From: "0xaaa", To: "0xBBB"},
+					{Hash: "transactionHash1", From: "0xaaa"},
+				},
+			},
+		},
+	}, nil)
+	s.metaStorage.EXPECT().
+		AddAddressTransactions(gomock.Any(), gomock.Any(), 2).
+		DoAndReturn(func(ctx context.Context, addressTransactions []*model.AddressTransaction, parallelism int) error {
+			newAddressTransaction := func(address string, transactionHash string) *model.AddressTransaction {
+				return &model.AddressTransaction{
+					Address:         address,
+					TransactionHash: transactionHash,
+					BlockNumber:     block.Metadata.Height,
+					BlockHash:       block.Metadata.Hash,
+					BlockTag:        stableTag,
+				}
+			}
+			require.Equal([]*model.AddressTransaction{
+				newAddressTransaction("0xaaa", "transactionHash0"),
+				newAddressTransaction("0xbbb", "transactionHash0"),
+				newAddressTransaction("0xaaa", "transactionHash1"),
+			}, addressTransactions)
+			return nil
+		})
+	s.metaStorage.EXPECT().AddTransactions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
+	s.metaStorage.EXPECT().PersistBlockMetas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	request := &SyncerRequest{
+		Tag:                          stableTag,
+		MaxBlocksToSync:              100,
+		Parallelism:                  4,
+		TransactionsWriteParallelism: 2,
+	}
+	response, err := s.syncer.Execute(s.env.BackgroundContext(), request)
+	require.NoError(err)
+	require.Equal(uint64(101), response.LatestSyncedHeight)
+}
+
 func (s *SyncerTestSuite) TestBlockValidationFailure() {
 	require := testutil.Require(s.T())
 
@@ -1459,6 +1529,7 @@ func (s *SyncerTestSuite) SetupTest() {
 	s.slaveBlockchainClient = clientmocks.NewMockClient(s.ctrl)
 	s.validatorBlockchainClient = clientmocks.NewMockClient(s.ctrl)
 	s.consensusBlockchainClient = clientmocks.NewMockClient(s.ctrl)
+	s.parser = parsermocks.NewMockParser(s.ctrl)
 	cfg, err := config.New()
 	require.NoError(err)
 	cfg.Chain.Client.Master.EndpointGroup = *endpointGroup
@@ -1477,6 +1548,9 @@ func (s *SyncerTestSuite) SetupTest() {
 		fx.Provide(func() metastorage.MetaStorage {
 			return s.metaStorage
 		}),
+		fx.Provide(func() parser.Parser {
+			return s.parser
+		}),
 		fx.Provide(fx.Annotated{
 			Name: "master",
 			Target: func() client.Client {
@@ -255,7 +255,7 @@ func (v *Validator) validateLatestBlock(ctx context.Context, logger *zap.Logger,
 		return xerrors.Errorf("failed to parse native block: %w", err)
 	}
 
-	err = v.parser.ValidateBlock(ctx, nativeBlock)
+	err = v.parser.ValidateRawBlock(ctx, rawBlock, nativeBlock)
 	if err != nil && !xerrors.Is(err, parser.ErrNotImplemented) {
 		return xerrors.Errorf("failed to validate native block: %w", err)
 	}
@@ -451,7 +451,7 @@ func (v *Validator) validateHeight(ctx context.Context,
 			return nil
 		}
 
-		err = v.parser.ValidateBlock(ctx, nativeBlock)
+		err = v.parser.ValidateRawBlock(ctx, actualRawBlock, nativeBlock)
 		if err != nil && !xerrors.Is(err, parser.ErrNotImplemented) {
 			logger.Error("failed to validate native block",
 				zap.Error(err),
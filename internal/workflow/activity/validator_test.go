@@ -197,7 +197,7 @@ func (s *ValidatorTestSuite) TestValidator() {
 		Return(&api.NativeBlock{}, nil).
 		AnyTimes()
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.parser.EXPECT().
@@ -280,7 +280,7 @@ func (s *ValidatorTestSuite) TestValidator_WithFailover() {
 		Return(&api.NativeBlock{}, nil).
 		AnyTimes()
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.parser.EXPECT().
@@ -347,7 +347,7 @@ func (s *ValidatorTestSuite) TestValidator_Reorg() {
 		Return(&api.NativeBlock{}, nil).
 		AnyTimes()
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.parser.EXPECT().
@@ -405,7 +405,7 @@ func (s *ValidatorTestSuite) TestValidator_BlockValidation_Failure() {
 		ParseNativeBlock(gomock.Any(), gomock.Any()).
 		Return(&api.NativeBlock{}, nil)
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil)
 	s.parser.EXPECT().
 		ParseRosettaBlock(gomock.Any(), gomock.Any()).
@@ -431,7 +431,7 @@ func (s *ValidatorTestSuite) TestValidator_BlockValidation_Failure() {
 			Return(&api.NativeBlock{}, nil).
 			AnyTimes()
 		s.parser.EXPECT().
-			ValidateBlock(gomock.Any(), gomock.Any()).
+			ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(xerrors.Errorf("mock error"))
 	}
 	s.slaveClient.EXPECT().
@@ -489,7 +489,7 @@ func (s *ValidatorTestSuite) TestValidator_BlockValidation_NotImplemented() {
 		ParseNativeBlock(gomock.Any(), gomock.Any()).
 		Return(&api.NativeBlock{}, nil)
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(parser.ErrNotImplemented)
 	s.parser.EXPECT().
 		ParseRosettaBlock(gomock.Any(), gomock.Any()).
@@ -514,7 +514,7 @@ func (s *ValidatorTestSuite) TestValidator_BlockValidation_NotImplemented() {
 			ParseNativeBlock(gomock.Any(), gomock.Any()).
 			Return(&api.NativeBlock{}, nil)
 		s.parser.EXPECT().
-			ValidateBlock(gomock.Any(), gomock.Any()).
+			ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(parser.ErrNotImplemented)
 		s.parser.EXPECT().
 			ParseRosettaBlock(gomock.Any(), gomock.Any()).
@@ -578,7 +578,7 @@ func (s *ValidatorTestSuite) TestValidator_RosettaBlockValidation_NotImplemented
 		ParseNativeBlock(gomock.Any(), gomock.Any()).
 		Return(&api.NativeBlock{}, nil)
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil)
 	s.parser.EXPECT().
 		ParseRosettaBlock(gomock.Any(), gomock.Any()).
@@ -603,7 +603,7 @@ func (s *ValidatorTestSuite) TestValidator_RosettaBlockValidation_NotImplemented
 			ParseNativeBlock(gomock.Any(), gomock.Any()).
 			Return(&api.NativeBlock{}, nil)
 		s.parser.EXPECT().
-			ValidateBlock(gomock.Any(), gomock.Any()).
+			ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil)
 		s.parser.EXPECT().
 			ParseRosettaBlock(gomock.Any(), gomock.Any()).
@@ -695,7 +695,7 @@ func (s *ValidatorTestSuite) TestValidator_ParseRosetta_NotImplemented() {
 		Return(&api.NativeBlock{}, nil).
 		AnyTimes()
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.parser.EXPECT().
@@ -780,7 +780,7 @@ func (s *ValidatorTestSuite) TestValidator_WithSkipBlock() {
 		Return(&api.NativeBlock{}, nil).
 		AnyTimes()
 	s.parser.EXPECT().
-		ValidateBlock(gomock.Any(), gomock.Any()).
+		ValidateRawBlock(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.parser.EXPECT().
@@ -51,6 +51,7 @@ type (
 		RehydrateFromTag        *uint32 // Optional. If not specified, rehydration is disabled.
 		UpgradeFromTag          *uint32 // Optional. If not specified, upgrade is disabled.
 		DataCompression         string  // Optional. If not specified, it is read from the workflow config.
+		Reencode                bool    // Optional. If set, the existing blocks are re-encoded with DataCompression instead of being extracted again.
 		Failover                bool    // Optional. If not specified, it is set as false.
 	}
 )
@@ -185,6 +186,7 @@ func (w *Backfiller) execute(ctx workflow.Context, request *BackfillerRequest) e
 				request.RehydrateFromTag,
 				request.UpgradeFromTag,
 				dataCompression,
+				request.Reencode,
 				failover,
 			)
 			if err != nil {
@@ -244,6 +246,7 @@ func (w *Backfiller) processBatch(
 	rehydrateFromTag *uint32,
 	upgradeFromTag *uint32,
 	dataCompression api.Compression,
+	reencode bool,
 	failover bool,
 ) ([]*api.BlockMetadata, error) {
 	batchSize := int(batchEnd - batchStart)
@@ -290,6 +293,7 @@ func (w *Backfiller) processBatch(
 					RehydrateFromTag: rehydrateFromTag,
 					UpgradeFromTag:   upgradeFromTag,
 					DataCompression:  dataCompression,
+					Reencode:         reencode,
 					Failover:         failover,
 				}
 				extractorResponse, err := w.extractor.Execute(ctx, extractorRequest)
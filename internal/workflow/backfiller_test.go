@@ -157,9 +157,9 @@ func (s *backfillerTestSuite) TestBackfiller() {
 			}, nil
 		})
 
-	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).
+	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Times(int(endHeight - startHeight)).
-		DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+		DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 			require.Equal(api.Compression_GZIP, compression)
 			_, ok := seen.blobStorage.LoadOrStore(block.Metadata.Height, true)
 			require.False(ok)
@@ -225,9 +225,9 @@ func (s *backfillerTestSuite) TestBackfiller_MiniBatch() {
 			}, nil
 		})
 
-	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).
+	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Times(int(endHeight - startHeight)).
-		DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+		DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 			require.Equal(api.Compression_GZIP, compression)
 			_, ok := seen.blobStorage.LoadOrStore(block.Metadata.Height, true)
 			require.False(ok)
@@ -295,9 +295,9 @@ func (s *backfillerTestSuite) TestBackfiller_Failover() {
 			}, nil
 		})
 
-	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).
+	s.blobStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Times(int(endHeight - startHeight)).
-		DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+		DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 			require.Equal(api.Compression_GZIP, compression)
 			_, ok := seen.blobStorage.LoadOrStore(block.Metadata.Height, true)
 			require.False(ok)
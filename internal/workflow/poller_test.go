@@ -20,6 +20,7 @@ import (
 	"github.com/coinbase/chainstorage/internal/blockchain/client"
 	clientmocks "github.com/coinbase/chainstorage/internal/blockchain/client/mocks"
 	"github.com/coinbase/chainstorage/internal/blockchain/endpoints"
+	"github.com/coinbase/chainstorage/internal/blockchain/parser"
 	"github.com/coinbase/chainstorage/internal/cadence"
 	"github.com/coinbase/chainstorage/internal/config"
 	"github.com/coinbase/chainstorage/internal/storage/blobstorage"
@@ -121,6 +122,7 @@ func (s *pollerTestSuite) SetupTest() {
 		fx.Provide(func() metastorage.MetaStorage {
 			return s.metaStorage
 		}),
+		fx.Provide(parser.NewNop),
 		fx.Provide(fx.Annotated{
 			Name: "master",
 			Target: func() client.Client {
@@ -206,7 +208,7 @@ func (s *pollerTestSuite) TestPollerSuccess() {
 				Return(&api.Block{Metadata: &api.BlockMetadata{Height: k}}, nil)
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -273,7 +275,7 @@ func (s *pollerTestSuite) TestPollerSuccess_FastSync() {
 				Return(&api.Block{Metadata: &api.BlockMetadata{Height: k}}, nil)
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -371,7 +373,7 @@ func (s *pollerTestSuite) TestPollerSuccess_FailoverEnabled() {
 				})
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -478,7 +480,7 @@ func (s *pollerTestSuite) TestPollerSuccess_ConsensusFailover() {
 				})
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -674,7 +676,7 @@ func (s *pollerTestSuite) TestPollerSuccess_ConsensusEnabled() {
 				Return(testutil.MakeBlocksFromStartHeight(curHeight, 1, tag)[0], nil)
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
 				})
@@ -763,7 +765,7 @@ func (s *pollerTestSuite) TestPollerSuccessAfterMasterFallback() {
 		for k := start; k < end; k++ {
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -846,7 +848,7 @@ func (s *pollerTestSuite) TestPollerSuccessAfterReprocessing() {
 				Return(&api.Block{Metadata: &api.BlockMetadata{Height: k}}, nil)
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -1038,7 +1040,7 @@ func (s *pollerTestSuite) TestPollerWithTransactionIndexingSuccess() {
 				}, nil)
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
@@ -1124,7 +1126,7 @@ func (s *pollerTestSuite) TestPoller_WithLivenessCheck() {
 				Return(&api.Block{Metadata: &api.BlockMetadata{Height: k}}, nil)
 			s.blobStorage.EXPECT().
 				Upload(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression) (string, error) {
+				DoAndReturn(func(ctx context.Context, block *api.Block, compression api.Compression, opts ...blobstorage.UploadOption) (string, error) {
 					require.Equal(api.Compression_GZIP, compression)
 					seen.blocks.LoadOrStore(block.Metadata.Height, true)
 					return "someObjectKey", nil
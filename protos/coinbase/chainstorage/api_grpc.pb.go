@@ -19,22 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ChainStorage_GetLatestBlock_FullMethodName          = "/coinbase.chainstorage.ChainStorage/GetLatestBlock"
-	ChainStorage_GetBlockFile_FullMethodName            = "/coinbase.chainstorage.ChainStorage/GetBlockFile"
-	ChainStorage_GetBlockFilesByRange_FullMethodName    = "/coinbase.chainstorage.ChainStorage/GetBlockFilesByRange"
-	ChainStorage_GetRawBlock_FullMethodName             = "/coinbase.chainstorage.ChainStorage/GetRawBlock"
-	ChainStorage_GetRawBlocksByRange_FullMethodName     = "/coinbase.chainstorage.ChainStorage/GetRawBlocksByRange"
-	ChainStorage_GetNativeBlock_FullMethodName          = "/coinbase.chainstorage.ChainStorage/GetNativeBlock"
-	ChainStorage_GetNativeBlocksByRange_FullMethodName  = "/coinbase.chainstorage.ChainStorage/GetNativeBlocksByRange"
-	ChainStorage_GetRosettaBlock_FullMethodName         = "/coinbase.chainstorage.ChainStorage/GetRosettaBlock"
-	ChainStorage_GetRosettaBlocksByRange_FullMethodName = "/coinbase.chainstorage.ChainStorage/GetRosettaBlocksByRange"
-	ChainStorage_StreamChainEvents_FullMethodName       = "/coinbase.chainstorage.ChainStorage/StreamChainEvents"
-	ChainStorage_GetChainEvents_FullMethodName          = "/coinbase.chainstorage.ChainStorage/GetChainEvents"
-	ChainStorage_GetChainMetadata_FullMethodName        = "/coinbase.chainstorage.ChainStorage/GetChainMetadata"
-	ChainStorage_GetVersionedChainEvent_FullMethodName  = "/coinbase.chainstorage.ChainStorage/GetVersionedChainEvent"
-	ChainStorage_GetBlockByTransaction_FullMethodName   = "/coinbase.chainstorage.ChainStorage/GetBlockByTransaction"
-	ChainStorage_GetNativeTransaction_FullMethodName    = "/coinbase.chainstorage.ChainStorage/GetNativeTransaction"
-	ChainStorage_GetVerifiedAccountState_FullMethodName = "/coinbase.chainstorage.ChainStorage/GetVerifiedAccountState"
+	ChainStorage_GetLatestBlock_FullMethodName                     = "/coinbase.chainstorage.ChainStorage/GetLatestBlock"
+	ChainStorage_GetBlockFile_FullMethodName                       = "/coinbase.chainstorage.ChainStorage/GetBlockFile"
+	ChainStorage_GetBlockFilesByRange_FullMethodName               = "/coinbase.chainstorage.ChainStorage/GetBlockFilesByRange"
+	ChainStorage_GetRawBlock_FullMethodName                        = "/coinbase.chainstorage.ChainStorage/GetRawBlock"
+	ChainStorage_GetRawBlocksByRange_FullMethodName                = "/coinbase.chainstorage.ChainStorage/GetRawBlocksByRange"
+	ChainStorage_GetNativeBlock_FullMethodName                     = "/coinbase.chainstorage.ChainStorage/GetNativeBlock"
+	ChainStorage_GetNativeBlocksByRange_FullMethodName             = "/coinbase.chainstorage.ChainStorage/GetNativeBlocksByRange"
+	ChainStorage_GetRosettaBlock_FullMethodName                    = "/coinbase.chainstorage.ChainStorage/GetRosettaBlock"
+	ChainStorage_GetRosettaBlocksByRange_FullMethodName            = "/coinbase.chainstorage.ChainStorage/GetRosettaBlocksByRange"
+	ChainStorage_StreamChainEvents_FullMethodName                  = "/coinbase.chainstorage.ChainStorage/StreamChainEvents"
+	ChainStorage_GetChainEvents_FullMethodName                     = "/coinbase.chainstorage.ChainStorage/GetChainEvents"
+	ChainStorage_GetChainMetadata_FullMethodName                   = "/coinbase.chainstorage.ChainStorage/GetChainMetadata"
+	ChainStorage_GetVersionedChainEvent_FullMethodName             = "/coinbase.chainstorage.ChainStorage/GetVersionedChainEvent"
+	ChainStorage_GetBlockByTransaction_FullMethodName              = "/coinbase.chainstorage.ChainStorage/GetBlockByTransaction"
+	ChainStorage_GetNativeTransaction_FullMethodName               = "/coinbase.chainstorage.ChainStorage/GetNativeTransaction"
+	ChainStorage_GetVerifiedAccountState_FullMethodName            = "/coinbase.chainstorage.ChainStorage/GetVerifiedAccountState"
+	ChainStorage_GetTransactionsByAddress_FullMethodName           = "/coinbase.chainstorage.ChainStorage/GetTransactionsByAddress"
+	ChainStorage_GetBlockByTimestamp_FullMethodName                = "/coinbase.chainstorage.ChainStorage/GetBlockByTimestamp"
+	ChainStorage_StreamFilteredTransactions_FullMethodName         = "/coinbase.chainstorage.ChainStorage/StreamFilteredTransactions"
+	ChainStorage_StreamRawBlocksByRange_FullMethodName             = "/coinbase.chainstorage.ChainStorage/StreamRawBlocksByRange"
+	ChainStorage_StreamNativeBlocksByRange_FullMethodName          = "/coinbase.chainstorage.ChainStorage/StreamNativeBlocksByRange"
+	ChainStorage_GetCanonicalBlockAsOfEvent_FullMethodName         = "/coinbase.chainstorage.ChainStorage/GetCanonicalBlockAsOfEvent"
+	ChainStorage_GetCanonicalBlocksByRangeAsOfEvent_FullMethodName = "/coinbase.chainstorage.ChainStorage/GetCanonicalBlocksByRangeAsOfEvent"
 )
 
 // ChainStorageClient is the client API for ChainStorage service.
@@ -57,6 +64,13 @@ type ChainStorageClient interface {
 	GetBlockByTransaction(ctx context.Context, in *GetBlockByTransactionRequest, opts ...grpc.CallOption) (*GetBlockByTransactionResponse, error)
 	GetNativeTransaction(ctx context.Context, in *GetNativeTransactionRequest, opts ...grpc.CallOption) (*GetNativeTransactionResponse, error)
 	GetVerifiedAccountState(ctx context.Context, in *GetVerifiedAccountStateRequest, opts ...grpc.CallOption) (*GetVerifiedAccountStateResponse, error)
+	GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*GetTransactionsByAddressResponse, error)
+	GetBlockByTimestamp(ctx context.Context, in *GetBlockByTimestampRequest, opts ...grpc.CallOption) (*GetBlockByTimestampResponse, error)
+	StreamFilteredTransactions(ctx context.Context, in *StreamFilteredTransactionsRequest, opts ...grpc.CallOption) (ChainStorage_StreamFilteredTransactionsClient, error)
+	StreamRawBlocksByRange(ctx context.Context, in *StreamRawBlocksByRangeRequest, opts ...grpc.CallOption) (ChainStorage_StreamRawBlocksByRangeClient, error)
+	StreamNativeBlocksByRange(ctx context.Context, in *StreamNativeBlocksByRangeRequest, opts ...grpc.CallOption) (ChainStorage_StreamNativeBlocksByRangeClient, error)
+	GetCanonicalBlockAsOfEvent(ctx context.Context, in *GetCanonicalBlockAsOfEventRequest, opts ...grpc.CallOption) (*GetCanonicalBlockAsOfEventResponse, error)
+	GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, in *GetCanonicalBlocksByRangeAsOfEventRequest, opts ...grpc.CallOption) (*GetCanonicalBlocksByRangeAsOfEventResponse, error)
 }
 
 type chainStorageClient struct {
@@ -234,6 +248,138 @@ func (c *chainStorageClient) GetVerifiedAccountState(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *chainStorageClient) GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*GetTransactionsByAddressResponse, error) {
+	out := new(GetTransactionsByAddressResponse)
+	err := c.cc.Invoke(ctx, ChainStorage_GetTransactionsByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainStorageClient) GetBlockByTimestamp(ctx context.Context, in *GetBlockByTimestampRequest, opts ...grpc.CallOption) (*GetBlockByTimestampResponse, error) {
+	out := new(GetBlockByTimestampResponse)
+	err := c.cc.Invoke(ctx, ChainStorage_GetBlockByTimestamp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainStorageClient) StreamFilteredTransactions(ctx context.Context, in *StreamFilteredTransactionsRequest, opts ...grpc.CallOption) (ChainStorage_StreamFilteredTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChainStorage_ServiceDesc.Streams[1], ChainStorage_StreamFilteredTransactions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainStorageStreamFilteredTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainStorage_StreamFilteredTransactionsClient interface {
+	Recv() (*StreamFilteredTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type chainStorageStreamFilteredTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainStorageStreamFilteredTransactionsClient) Recv() (*StreamFilteredTransactionsResponse, error) {
+	m := new(StreamFilteredTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainStorageClient) StreamRawBlocksByRange(ctx context.Context, in *StreamRawBlocksByRangeRequest, opts ...grpc.CallOption) (ChainStorage_StreamRawBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChainStorage_ServiceDesc.Streams[2], ChainStorage_StreamRawBlocksByRange_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainStorageStreamRawBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainStorage_StreamRawBlocksByRangeClient interface {
+	Recv() (*StreamRawBlocksByRangeResponse, error)
+	grpc.ClientStream
+}
+
+type chainStorageStreamRawBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainStorageStreamRawBlocksByRangeClient) Recv() (*StreamRawBlocksByRangeResponse, error) {
+	m := new(StreamRawBlocksByRangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainStorageClient) StreamNativeBlocksByRange(ctx context.Context, in *StreamNativeBlocksByRangeRequest, opts ...grpc.CallOption) (ChainStorage_StreamNativeBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChainStorage_ServiceDesc.Streams[3], ChainStorage_StreamNativeBlocksByRange_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainStorageStreamNativeBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainStorage_StreamNativeBlocksByRangeClient interface {
+	Recv() (*StreamNativeBlocksByRangeResponse, error)
+	grpc.ClientStream
+}
+
+type chainStorageStreamNativeBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainStorageStreamNativeBlocksByRangeClient) Recv() (*StreamNativeBlocksByRangeResponse, error) {
+	m := new(StreamNativeBlocksByRangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainStorageClient) GetCanonicalBlockAsOfEvent(ctx context.Context, in *GetCanonicalBlockAsOfEventRequest, opts ...grpc.CallOption) (*GetCanonicalBlockAsOfEventResponse, error) {
+	out := new(GetCanonicalBlockAsOfEventResponse)
+	err := c.cc.Invoke(ctx, ChainStorage_GetCanonicalBlockAsOfEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainStorageClient) GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, in *GetCanonicalBlocksByRangeAsOfEventRequest, opts ...grpc.CallOption) (*GetCanonicalBlocksByRangeAsOfEventResponse, error) {
+	out := new(GetCanonicalBlocksByRangeAsOfEventResponse)
+	err := c.cc.Invoke(ctx, ChainStorage_GetCanonicalBlocksByRangeAsOfEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChainStorageServer is the server API for ChainStorage service.
 // All implementations should embed UnimplementedChainStorageServer
 // for forward compatibility
@@ -254,6 +400,13 @@ type ChainStorageServer interface {
 	GetBlockByTransaction(context.Context, *GetBlockByTransactionRequest) (*GetBlockByTransactionResponse, error)
 	GetNativeTransaction(context.Context, *GetNativeTransactionRequest) (*GetNativeTransactionResponse, error)
 	GetVerifiedAccountState(context.Context, *GetVerifiedAccountStateRequest) (*GetVerifiedAccountStateResponse, error)
+	GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*GetTransactionsByAddressResponse, error)
+	GetBlockByTimestamp(context.Context, *GetBlockByTimestampRequest) (*GetBlockByTimestampResponse, error)
+	StreamFilteredTransactions(*StreamFilteredTransactionsRequest, ChainStorage_StreamFilteredTransactionsServer) error
+	StreamRawBlocksByRange(*StreamRawBlocksByRangeRequest, ChainStorage_StreamRawBlocksByRangeServer) error
+	StreamNativeBlocksByRange(*StreamNativeBlocksByRangeRequest, ChainStorage_StreamNativeBlocksByRangeServer) error
+	GetCanonicalBlockAsOfEvent(context.Context, *GetCanonicalBlockAsOfEventRequest) (*GetCanonicalBlockAsOfEventResponse, error)
+	GetCanonicalBlocksByRangeAsOfEvent(context.Context, *GetCanonicalBlocksByRangeAsOfEventRequest) (*GetCanonicalBlocksByRangeAsOfEventResponse, error)
 }
 
 // UnimplementedChainStorageServer should be embedded to have forward compatible implementations.
@@ -308,6 +461,27 @@ func (UnimplementedChainStorageServer) GetNativeTransaction(context.Context, *Ge
 func (UnimplementedChainStorageServer) GetVerifiedAccountState(context.Context, *GetVerifiedAccountStateRequest) (*GetVerifiedAccountStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiedAccountState not implemented")
 }
+func (UnimplementedChainStorageServer) GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*GetTransactionsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionsByAddress not implemented")
+}
+func (UnimplementedChainStorageServer) GetBlockByTimestamp(context.Context, *GetBlockByTimestampRequest) (*GetBlockByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByTimestamp not implemented")
+}
+func (UnimplementedChainStorageServer) StreamFilteredTransactions(*StreamFilteredTransactionsRequest, ChainStorage_StreamFilteredTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFilteredTransactions not implemented")
+}
+func (UnimplementedChainStorageServer) StreamRawBlocksByRange(*StreamRawBlocksByRangeRequest, ChainStorage_StreamRawBlocksByRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRawBlocksByRange not implemented")
+}
+func (UnimplementedChainStorageServer) StreamNativeBlocksByRange(*StreamNativeBlocksByRangeRequest, ChainStorage_StreamNativeBlocksByRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNativeBlocksByRange not implemented")
+}
+func (UnimplementedChainStorageServer) GetCanonicalBlockAsOfEvent(context.Context, *GetCanonicalBlockAsOfEventRequest) (*GetCanonicalBlockAsOfEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCanonicalBlockAsOfEvent not implemented")
+}
+func (UnimplementedChainStorageServer) GetCanonicalBlocksByRangeAsOfEvent(context.Context, *GetCanonicalBlocksByRangeAsOfEventRequest) (*GetCanonicalBlocksByRangeAsOfEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCanonicalBlocksByRangeAsOfEvent not implemented")
+}
 
 // UnsafeChainStorageServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ChainStorageServer will
@@ -611,6 +785,141 @@ func _ChainStorage_GetVerifiedAccountState_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainStorage_GetTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainStorageServer).GetTransactionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainStorage_GetTransactionsByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainStorageServer).GetTransactionsByAddress(ctx, req.(*GetTransactionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainStorage_GetBlockByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainStorageServer).GetBlockByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainStorage_GetBlockByTimestamp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainStorageServer).GetBlockByTimestamp(ctx, req.(*GetBlockByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainStorage_StreamFilteredTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFilteredTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainStorageServer).StreamFilteredTransactions(m, &chainStorageStreamFilteredTransactionsServer{stream})
+}
+
+type ChainStorage_StreamFilteredTransactionsServer interface {
+	Send(*StreamFilteredTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type chainStorageStreamFilteredTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainStorageStreamFilteredTransactionsServer) Send(m *StreamFilteredTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainStorage_StreamRawBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRawBlocksByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainStorageServer).StreamRawBlocksByRange(m, &chainStorageStreamRawBlocksByRangeServer{stream})
+}
+
+type ChainStorage_StreamRawBlocksByRangeServer interface {
+	Send(*StreamRawBlocksByRangeResponse) error
+	grpc.ServerStream
+}
+
+type chainStorageStreamRawBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainStorageStreamRawBlocksByRangeServer) Send(m *StreamRawBlocksByRangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainStorage_StreamNativeBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNativeBlocksByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainStorageServer).StreamNativeBlocksByRange(m, &chainStorageStreamNativeBlocksByRangeServer{stream})
+}
+
+type ChainStorage_StreamNativeBlocksByRangeServer interface {
+	Send(*StreamNativeBlocksByRangeResponse) error
+	grpc.ServerStream
+}
+
+type chainStorageStreamNativeBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainStorageStreamNativeBlocksByRangeServer) Send(m *StreamNativeBlocksByRangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainStorage_GetCanonicalBlockAsOfEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanonicalBlockAsOfEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainStorageServer).GetCanonicalBlockAsOfEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainStorage_GetCanonicalBlockAsOfEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainStorageServer).GetCanonicalBlockAsOfEvent(ctx, req.(*GetCanonicalBlockAsOfEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainStorage_GetCanonicalBlocksByRangeAsOfEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanonicalBlocksByRangeAsOfEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainStorageServer).GetCanonicalBlocksByRangeAsOfEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainStorage_GetCanonicalBlocksByRangeAsOfEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainStorageServer).GetCanonicalBlocksByRangeAsOfEvent(ctx, req.(*GetCanonicalBlocksByRangeAsOfEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChainStorage_ServiceDesc is the grpc.ServiceDesc for ChainStorage service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -678,6 +987,22 @@ var ChainStorage_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVerifiedAccountState",
 			Handler:    _ChainStorage_GetVerifiedAccountState_Handler,
 		},
+		{
+			MethodName: "GetTransactionsByAddress",
+			Handler:    _ChainStorage_GetTransactionsByAddress_Handler,
+		},
+		{
+			MethodName: "GetBlockByTimestamp",
+			Handler:    _ChainStorage_GetBlockByTimestamp_Handler,
+		},
+		{
+			MethodName: "GetCanonicalBlockAsOfEvent",
+			Handler:    _ChainStorage_GetCanonicalBlockAsOfEvent_Handler,
+		},
+		{
+			MethodName: "GetCanonicalBlocksByRangeAsOfEvent",
+			Handler:    _ChainStorage_GetCanonicalBlocksByRangeAsOfEvent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -685,6 +1010,21 @@ var ChainStorage_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ChainStorage_StreamChainEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamFilteredTransactions",
+			Handler:       _ChainStorage_StreamFilteredTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRawBlocksByRange",
+			Handler:       _ChainStorage_StreamRawBlocksByRange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNativeBlocksByRange",
+			Handler:       _ChainStorage_StreamNativeBlocksByRange_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "coinbase/chainstorage/api.proto",
 }
@@ -0,0 +1,942 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.2
+// source: coinbase/chainstorage/blockchain_cardano.proto
+
+package chainstorage
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CardanoCertificate_Type int32
+
+const (
+	CardanoCertificate_UNKNOWN                  CardanoCertificate_Type = 0
+	CardanoCertificate_STAKE_KEY_REGISTRATION   CardanoCertificate_Type = 1
+	CardanoCertificate_STAKE_KEY_DEREGISTRATION CardanoCertificate_Type = 2
+	CardanoCertificate_STAKE_DELEGATION         CardanoCertificate_Type = 3
+	CardanoCertificate_POOL_REGISTRATION        CardanoCertificate_Type = 4
+	CardanoCertificate_POOL_RETIREMENT          CardanoCertificate_Type = 5
+)
+
+// Enum value maps for CardanoCertificate_Type.
+var (
+	CardanoCertificate_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "STAKE_KEY_REGISTRATION",
+		2: "STAKE_KEY_DEREGISTRATION",
+		3: "STAKE_DELEGATION",
+		4: "POOL_REGISTRATION",
+		5: "POOL_RETIREMENT",
+	}
+	CardanoCertificate_Type_value = map[string]int32{
+		"UNKNOWN":                  0,
+		"STAKE_KEY_REGISTRATION":   1,
+		"STAKE_KEY_DEREGISTRATION": 2,
+		"STAKE_DELEGATION":         3,
+		"POOL_REGISTRATION":        4,
+		"POOL_RETIREMENT":          5,
+	}
+)
+
+func (x CardanoCertificate_Type) Enum() *CardanoCertificate_Type {
+	p := new(CardanoCertificate_Type)
+	*p = x
+	return p
+}
+
+func (x CardanoCertificate_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardanoCertificate_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_enumTypes[0].Descriptor()
+}
+
+func (CardanoCertificate_Type) Type() protoreflect.EnumType {
+	return &file_coinbase_chainstorage_blockchain_cardano_proto_enumTypes[0]
+}
+
+func (x CardanoCertificate_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardanoCertificate_Type.Descriptor instead.
+func (CardanoCertificate_Type) EnumDescriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// CardanoBlock is parsed from the cardano-rosetta responses, which are stored as RosettaBlobdata.
+type CardanoBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header       *CardanoHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Transactions []*CardanoTransaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *CardanoBlock) Reset() {
+	*x = CardanoBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoBlock) ProtoMessage() {}
+
+func (x *CardanoBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoBlock.ProtoReflect.Descriptor instead.
+func (*CardanoBlock) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CardanoBlock) GetHeader() *CardanoHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CardanoBlock) GetTransactions() []*CardanoTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type CardanoHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash       string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height     uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	ParentHash string                 `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Epoch      uint64                 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot       uint64                 `protobuf:"varint,6,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Pool id of the block producer.
+	CreatedBy         string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Size              uint64 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	TransactionsCount uint64 `protobuf:"varint,9,opt,name=transactions_count,json=transactionsCount,proto3" json:"transactions_count,omitempty"`
+}
+
+func (x *CardanoHeader) Reset() {
+	*x = CardanoHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoHeader) ProtoMessage() {}
+
+func (x *CardanoHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoHeader.ProtoReflect.Descriptor instead.
+func (*CardanoHeader) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CardanoHeader) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CardanoHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CardanoHeader) GetParentHash() string {
+	if x != nil {
+		return x.ParentHash
+	}
+	return ""
+}
+
+func (x *CardanoHeader) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CardanoHeader) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CardanoHeader) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CardanoHeader) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CardanoHeader) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CardanoHeader) GetTransactionsCount() uint64 {
+	if x != nil {
+		return x.TransactionsCount
+	}
+	return 0
+}
+
+type CardanoTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash         string                      `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index        uint64                      `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Size         uint64                      `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ScriptSize   uint64                      `protobuf:"varint,4,opt,name=script_size,json=scriptSize,proto3" json:"script_size,omitempty"`
+	Inputs       []*CardanoTransactionInput  `protobuf:"bytes,5,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs      []*CardanoTransactionOutput `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Certificates []*CardanoCertificate       `protobuf:"bytes,7,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	Withdrawals  []*CardanoWithdrawal        `protobuf:"bytes,8,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+}
+
+func (x *CardanoTransaction) Reset() {
+	*x = CardanoTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoTransaction) ProtoMessage() {}
+
+func (x *CardanoTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoTransaction.ProtoReflect.Descriptor instead.
+func (*CardanoTransaction) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CardanoTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CardanoTransaction) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CardanoTransaction) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CardanoTransaction) GetScriptSize() uint64 {
+	if x != nil {
+		return x.ScriptSize
+	}
+	return 0
+}
+
+func (x *CardanoTransaction) GetInputs() []*CardanoTransactionInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *CardanoTransaction) GetOutputs() []*CardanoTransactionOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CardanoTransaction) GetCertificates() []*CardanoCertificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *CardanoTransaction) GetWithdrawals() []*CardanoWithdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+type CardanoTransactionInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the spent output in the format of "{transaction_hash}:{output_index}".
+	CoinIdentifier string `protobuf:"bytes,1,opt,name=coin_identifier,json=coinIdentifier,proto3" json:"coin_identifier,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount in lovelace.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CardanoTransactionInput) Reset() {
+	*x = CardanoTransactionInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoTransactionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoTransactionInput) ProtoMessage() {}
+
+func (x *CardanoTransactionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoTransactionInput.ProtoReflect.Descriptor instead.
+func (*CardanoTransactionInput) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CardanoTransactionInput) GetCoinIdentifier() string {
+	if x != nil {
+		return x.CoinIdentifier
+	}
+	return ""
+}
+
+func (x *CardanoTransactionInput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CardanoTransactionInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CardanoTransactionOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the created output in the format of "{transaction_hash}:{output_index}".
+	CoinIdentifier string `protobuf:"bytes,1,opt,name=coin_identifier,json=coinIdentifier,proto3" json:"coin_identifier,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount in lovelace.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CardanoTransactionOutput) Reset() {
+	*x = CardanoTransactionOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoTransactionOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoTransactionOutput) ProtoMessage() {}
+
+func (x *CardanoTransactionOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoTransactionOutput.ProtoReflect.Descriptor instead.
+func (*CardanoTransactionOutput) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CardanoTransactionOutput) GetCoinIdentifier() string {
+	if x != nil {
+		return x.CoinIdentifier
+	}
+	return ""
+}
+
+func (x *CardanoTransactionOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CardanoTransactionOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CardanoCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type CardanoCertificate_Type `protobuf:"varint,1,opt,name=type,proto3,enum=coinbase.chainstorage.CardanoCertificate_Type" json:"type,omitempty"`
+	// Index of the operation within the transaction.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Stake address of the stake key certificates. It is empty for the pool certificates.
+	StakeAddress string `protobuf:"bytes,3,opt,name=stake_address,json=stakeAddress,proto3" json:"stake_address,omitempty"`
+	// Hex-encoded public key of the stake key certificates.
+	StakingCredential string `protobuf:"bytes,4,opt,name=staking_credential,json=stakingCredential,proto3" json:"staking_credential,omitempty"`
+	// Pool key hash of the pool being delegated to, registered or retired.
+	PoolKeyHash string `protobuf:"bytes,5,opt,name=pool_key_hash,json=poolKeyHash,proto3" json:"pool_key_hash,omitempty"`
+	// Deposit in lovelace paid by the registration certificates.
+	Deposit uint64 `protobuf:"varint,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// Deposit in lovelace refunded by the stake key deregistration certificate.
+	Refund uint64 `protobuf:"varint,7,opt,name=refund,proto3" json:"refund,omitempty"`
+	// Epoch in which the pool retires.
+	RetirementEpoch uint64 `protobuf:"varint,8,opt,name=retirement_epoch,json=retirementEpoch,proto3" json:"retirement_epoch,omitempty"`
+}
+
+func (x *CardanoCertificate) Reset() {
+	*x = CardanoCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoCertificate) ProtoMessage() {}
+
+func (x *CardanoCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoCertificate.ProtoReflect.Descriptor instead.
+func (*CardanoCertificate) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CardanoCertificate) GetType() CardanoCertificate_Type {
+	if x != nil {
+		return x.Type
+	}
+	return CardanoCertificate_UNKNOWN
+}
+
+func (x *CardanoCertificate) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CardanoCertificate) GetStakeAddress() string {
+	if x != nil {
+		return x.StakeAddress
+	}
+	return ""
+}
+
+func (x *CardanoCertificate) GetStakingCredential() string {
+	if x != nil {
+		return x.StakingCredential
+	}
+	return ""
+}
+
+func (x *CardanoCertificate) GetPoolKeyHash() string {
+	if x != nil {
+		return x.PoolKeyHash
+	}
+	return ""
+}
+
+func (x *CardanoCertificate) GetDeposit() uint64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *CardanoCertificate) GetRefund() uint64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *CardanoCertificate) GetRetirementEpoch() uint64 {
+	if x != nil {
+		return x.RetirementEpoch
+	}
+	return 0
+}
+
+type CardanoWithdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the operation within the transaction.
+	Index             uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	StakeAddress      string `protobuf:"bytes,2,opt,name=stake_address,json=stakeAddress,proto3" json:"stake_address,omitempty"`
+	StakingCredential string `protobuf:"bytes,3,opt,name=staking_credential,json=stakingCredential,proto3" json:"staking_credential,omitempty"`
+	// Amount of the rewards withdrawn in lovelace.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CardanoWithdrawal) Reset() {
+	*x = CardanoWithdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoWithdrawal) ProtoMessage() {}
+
+func (x *CardanoWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardanoWithdrawal.ProtoReflect.Descriptor instead.
+func (*CardanoWithdrawal) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CardanoWithdrawal) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CardanoWithdrawal) GetStakeAddress() string {
+	if x != nil {
+		return x.StakeAddress
+	}
+	return ""
+}
+
+func (x *CardanoWithdrawal) GetStakingCredential() string {
+	if x != nil {
+		return x.StakingCredential
+	}
+	return ""
+}
+
+func (x *CardanoWithdrawal) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+var File_coinbase_chainstorage_blockchain_cardano_proto protoreflect.FileDescriptor
+
+var file_coinbase_chainstorage_blockchain_cardano_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x15, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72,
+	0x64, 0x61, 0x6e, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x69, 0x6e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x64, 0x61,
+	0x6e, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa1, 0x03, 0x0a, 0x12,
+	0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x46, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e,
+	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x69,
+	0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x69,
+	0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x22,
+	0x74, 0x0a, 0x17, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x18, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x69, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd5, 0x03, 0x0a,
+	0x12, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e,
+	0x6f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x4b, 0x65,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0x8f, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x4b,
+	0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x44, 0x45, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x4f, 0x4c,
+	0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12,
+	0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x05, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x3f, 0x5a, 0x3d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coinbase_chainstorage_blockchain_cardano_proto_rawDescOnce sync.Once
+	file_coinbase_chainstorage_blockchain_cardano_proto_rawDescData = file_coinbase_chainstorage_blockchain_cardano_proto_rawDesc
+)
+
+func file_coinbase_chainstorage_blockchain_cardano_proto_rawDescGZIP() []byte {
+	file_coinbase_chainstorage_blockchain_cardano_proto_rawDescOnce.Do(func() {
+		file_coinbase_chainstorage_blockchain_cardano_proto_rawDescData = protoimpl.X.CompressGZIP(file_coinbase_chainstorage_blockchain_cardano_proto_rawDescData)
+	})
+	return file_coinbase_chainstorage_blockchain_cardano_proto_rawDescData
+}
+
+var file_coinbase_chainstorage_blockchain_cardano_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_coinbase_chainstorage_blockchain_cardano_proto_goTypes = []interface{}{
+	(CardanoCertificate_Type)(0),     // 0: coinbase.chainstorage.CardanoCertificate.Type
+	(*CardanoBlock)(nil),             // 1: coinbase.chainstorage.CardanoBlock
+	(*CardanoHeader)(nil),            // 2: coinbase.chainstorage.CardanoHeader
+	(*CardanoTransaction)(nil),       // 3: coinbase.chainstorage.CardanoTransaction
+	(*CardanoTransactionInput)(nil),  // 4: coinbase.chainstorage.CardanoTransactionInput
+	(*CardanoTransactionOutput)(nil), // 5: coinbase.chainstorage.CardanoTransactionOutput
+	(*CardanoCertificate)(nil),       // 6: coinbase.chainstorage.CardanoCertificate
+	(*CardanoWithdrawal)(nil),        // 7: coinbase.chainstorage.CardanoWithdrawal
+	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
+}
+var file_coinbase_chainstorage_blockchain_cardano_proto_depIdxs = []int32{
+	2, // 0: coinbase.chainstorage.CardanoBlock.header:type_name -> coinbase.chainstorage.CardanoHeader
+	3, // 1: coinbase.chainstorage.CardanoBlock.transactions:type_name -> coinbase.chainstorage.CardanoTransaction
+	8, // 2: coinbase.chainstorage.CardanoHeader.timestamp:type_name -> google.protobuf.Timestamp
+	4, // 3: coinbase.chainstorage.CardanoTransaction.inputs:type_name -> coinbase.chainstorage.CardanoTransactionInput
+	5, // 4: coinbase.chainstorage.CardanoTransaction.outputs:type_name -> coinbase.chainstorage.CardanoTransactionOutput
+	6, // 5: coinbase.chainstorage.CardanoTransaction.certificates:type_name -> coinbase.chainstorage.CardanoCertificate
+	7, // 6: coinbase.chainstorage.CardanoTransaction.withdrawals:type_name -> coinbase.chainstorage.CardanoWithdrawal
+	0, // 7: coinbase.chainstorage.CardanoCertificate.type:type_name -> coinbase.chainstorage.CardanoCertificate.Type
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_coinbase_chainstorage_blockchain_cardano_proto_init() }
+func file_coinbase_chainstorage_blockchain_cardano_proto_init() {
+	if File_coinbase_chainstorage_blockchain_cardano_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoTransactionInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoTransactionOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoWithdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coinbase_chainstorage_blockchain_cardano_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_coinbase_chainstorage_blockchain_cardano_proto_goTypes,
+		DependencyIndexes: file_coinbase_chainstorage_blockchain_cardano_proto_depIdxs,
+		EnumInfos:         file_coinbase_chainstorage_blockchain_cardano_proto_enumTypes,
+		MessageInfos:      file_coinbase_chainstorage_blockchain_cardano_proto_msgTypes,
+	}.Build()
+	File_coinbase_chainstorage_blockchain_cardano_proto = out.File
+	file_coinbase_chainstorage_blockchain_cardano_proto_rawDesc = nil
+	file_coinbase_chainstorage_blockchain_cardano_proto_goTypes = nil
+	file_coinbase_chainstorage_blockchain_cardano_proto_depIdxs = nil
+}
@@ -0,0 +1,1493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.2
+// source: coinbase/chainstorage/blockchain_cosmos.proto
+
+package chainstorage
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The raw responses of the Tendermint/CometBFT RPC for a given height.
+type CosmosBlobdata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response of /block?height={height}.
+	Block []byte `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// Response of /block_results?height={height}.
+	BlockResults []byte `protobuf:"bytes,2,opt,name=block_results,json=blockResults,proto3" json:"block_results,omitempty"`
+}
+
+func (x *CosmosBlobdata) Reset() {
+	*x = CosmosBlobdata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosBlobdata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosBlobdata) ProtoMessage() {}
+
+func (x *CosmosBlobdata) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosBlobdata.ProtoReflect.Descriptor instead.
+func (*CosmosBlobdata) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CosmosBlobdata) GetBlock() []byte {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *CosmosBlobdata) GetBlockResults() []byte {
+	if x != nil {
+		return x.BlockResults
+	}
+	return nil
+}
+
+type CosmosBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header       *CosmosHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Transactions []*CosmosTransaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Events emitted outside of the transactions, i.e. by BeginBlock and EndBlock, or by FinalizeBlock since CometBFT v0.38.
+	BlockEvents []*CosmosEvent `protobuf:"bytes,3,rep,name=block_events,json=blockEvents,proto3" json:"block_events,omitempty"`
+}
+
+func (x *CosmosBlock) Reset() {
+	*x = CosmosBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosBlock) ProtoMessage() {}
+
+func (x *CosmosBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosBlock.ProtoReflect.Descriptor instead.
+func (*CosmosBlock) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CosmosBlock) GetHeader() *CosmosHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CosmosBlock) GetTransactions() []*CosmosTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *CosmosBlock) GetBlockEvents() []*CosmosEvent {
+	if x != nil {
+		return x.BlockEvents
+	}
+	return nil
+}
+
+type CosmosHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height  uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Hash of the block, which is taken from the block id.
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hash of the previous block, which is taken from the last block id.
+	LastBlockHash      string `protobuf:"bytes,5,opt,name=last_block_hash,json=lastBlockHash,proto3" json:"last_block_hash,omitempty"`
+	LastCommitHash     string `protobuf:"bytes,6,opt,name=last_commit_hash,json=lastCommitHash,proto3" json:"last_commit_hash,omitempty"`
+	DataHash           string `protobuf:"bytes,7,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	ValidatorsHash     string `protobuf:"bytes,8,opt,name=validators_hash,json=validatorsHash,proto3" json:"validators_hash,omitempty"`
+	NextValidatorsHash string `protobuf:"bytes,9,opt,name=next_validators_hash,json=nextValidatorsHash,proto3" json:"next_validators_hash,omitempty"`
+	ConsensusHash      string `protobuf:"bytes,10,opt,name=consensus_hash,json=consensusHash,proto3" json:"consensus_hash,omitempty"`
+	AppHash            string `protobuf:"bytes,11,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	LastResultsHash    string `protobuf:"bytes,12,opt,name=last_results_hash,json=lastResultsHash,proto3" json:"last_results_hash,omitempty"`
+	EvidenceHash       string `protobuf:"bytes,13,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	ProposerAddress    string `protobuf:"bytes,14,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+}
+
+func (x *CosmosHeader) Reset() {
+	*x = CosmosHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosHeader) ProtoMessage() {}
+
+func (x *CosmosHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosHeader.ProtoReflect.Descriptor instead.
+func (*CosmosHeader) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CosmosHeader) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CosmosHeader) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CosmosHeader) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetLastBlockHash() string {
+	if x != nil {
+		return x.LastBlockHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetLastCommitHash() string {
+	if x != nil {
+		return x.LastCommitHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetDataHash() string {
+	if x != nil {
+		return x.DataHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetValidatorsHash() string {
+	if x != nil {
+		return x.ValidatorsHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetNextValidatorsHash() string {
+	if x != nil {
+		return x.NextValidatorsHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetConsensusHash() string {
+	if x != nil {
+		return x.ConsensusHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetAppHash() string {
+	if x != nil {
+		return x.AppHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetLastResultsHash() string {
+	if x != nil {
+		return x.LastResultsHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetEvidenceHash() string {
+	if x != nil {
+		return x.EvidenceHash
+	}
+	return ""
+}
+
+func (x *CosmosHeader) GetProposerAddress() string {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return ""
+}
+
+type CosmosTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the transaction, which is the upper-case hex-encoded SHA-256 checksum of the raw transaction.
+	Hash          string           `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index         uint64           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Messages      []*CosmosMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	Memo          string           `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	TimeoutHeight uint64           `protobuf:"varint,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Fee           *CosmosFee       `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Result code of the transaction. A non-zero code indicates that the transaction failed.
+	Code      uint32         `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	Codespace string         `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Log       string         `protobuf:"bytes,9,opt,name=log,proto3" json:"log,omitempty"`
+	GasWanted uint64         `protobuf:"varint,10,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed   uint64         `protobuf:"varint,11,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Events    []*CosmosEvent `protobuf:"bytes,12,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *CosmosTransaction) Reset() {
+	*x = CosmosTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosTransaction) ProtoMessage() {}
+
+func (x *CosmosTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosTransaction.ProtoReflect.Descriptor instead.
+func (*CosmosTransaction) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CosmosTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CosmosTransaction) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CosmosTransaction) GetMessages() []*CosmosMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *CosmosTransaction) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CosmosTransaction) GetTimeoutHeight() uint64 {
+	if x != nil {
+		return x.TimeoutHeight
+	}
+	return 0
+}
+
+func (x *CosmosTransaction) GetFee() *CosmosFee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *CosmosTransaction) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CosmosTransaction) GetCodespace() string {
+	if x != nil {
+		return x.Codespace
+	}
+	return ""
+}
+
+func (x *CosmosTransaction) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *CosmosTransaction) GetGasWanted() uint64 {
+	if x != nil {
+		return x.GasWanted
+	}
+	return 0
+}
+
+func (x *CosmosTransaction) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *CosmosTransaction) GetEvents() []*CosmosEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CosmosMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the message, e.g. "/cosmos.staking.v1beta1.MsgDelegate".
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// Protobuf-encoded message.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Decoded message. It is only set for the staking-related messages.
+	//
+	// Types that are assignable to Message:
+	//	*CosmosMessage_Delegate
+	//	*CosmosMessage_Undelegate
+	//	*CosmosMessage_BeginRedelegate
+	//	*CosmosMessage_WithdrawDelegatorReward
+	Message isCosmosMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *CosmosMessage) Reset() {
+	*x = CosmosMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosMessage) ProtoMessage() {}
+
+func (x *CosmosMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosMessage.ProtoReflect.Descriptor instead.
+func (*CosmosMessage) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CosmosMessage) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *CosmosMessage) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (m *CosmosMessage) GetMessage() isCosmosMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *CosmosMessage) GetDelegate() *CosmosMsgDelegate {
+	if x, ok := x.GetMessage().(*CosmosMessage_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *CosmosMessage) GetUndelegate() *CosmosMsgUndelegate {
+	if x, ok := x.GetMessage().(*CosmosMessage_Undelegate); ok {
+		return x.Undelegate
+	}
+	return nil
+}
+
+func (x *CosmosMessage) GetBeginRedelegate() *CosmosMsgBeginRedelegate {
+	if x, ok := x.GetMessage().(*CosmosMessage_BeginRedelegate); ok {
+		return x.BeginRedelegate
+	}
+	return nil
+}
+
+func (x *CosmosMessage) GetWithdrawDelegatorReward() *CosmosMsgWithdrawDelegatorReward {
+	if x, ok := x.GetMessage().(*CosmosMessage_WithdrawDelegatorReward); ok {
+		return x.WithdrawDelegatorReward
+	}
+	return nil
+}
+
+type isCosmosMessage_Message interface {
+	isCosmosMessage_Message()
+}
+
+type CosmosMessage_Delegate struct {
+	Delegate *CosmosMsgDelegate `protobuf:"bytes,100,opt,name=delegate,proto3,oneof"`
+}
+
+type CosmosMessage_Undelegate struct {
+	Undelegate *CosmosMsgUndelegate `protobuf:"bytes,101,opt,name=undelegate,proto3,oneof"`
+}
+
+type CosmosMessage_BeginRedelegate struct {
+	BeginRedelegate *CosmosMsgBeginRedelegate `protobuf:"bytes,102,opt,name=begin_redelegate,json=beginRedelegate,proto3,oneof"`
+}
+
+type CosmosMessage_WithdrawDelegatorReward struct {
+	WithdrawDelegatorReward *CosmosMsgWithdrawDelegatorReward `protobuf:"bytes,103,opt,name=withdraw_delegator_reward,json=withdrawDelegatorReward,proto3,oneof"`
+}
+
+func (*CosmosMessage_Delegate) isCosmosMessage_Message() {}
+
+func (*CosmosMessage_Undelegate) isCosmosMessage_Message() {}
+
+func (*CosmosMessage_BeginRedelegate) isCosmosMessage_Message() {}
+
+func (*CosmosMessage_WithdrawDelegatorReward) isCosmosMessage_Message() {}
+
+type CosmosMsgDelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           *CosmosCoin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CosmosMsgDelegate) Reset() {
+	*x = CosmosMsgDelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosMsgDelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosMsgDelegate) ProtoMessage() {}
+
+func (x *CosmosMsgDelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosMsgDelegate.ProtoReflect.Descriptor instead.
+func (*CosmosMsgDelegate) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CosmosMsgDelegate) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgDelegate) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgDelegate) GetAmount() *CosmosCoin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CosmosMsgUndelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           *CosmosCoin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CosmosMsgUndelegate) Reset() {
+	*x = CosmosMsgUndelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosMsgUndelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosMsgUndelegate) ProtoMessage() {}
+
+func (x *CosmosMsgUndelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosMsgUndelegate.ProtoReflect.Descriptor instead.
+func (*CosmosMsgUndelegate) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CosmosMsgUndelegate) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgUndelegate) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgUndelegate) GetAmount() *CosmosCoin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CosmosMsgBeginRedelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegatorAddress    string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorSrcAddress string      `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	ValidatorDstAddress string      `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	Amount              *CosmosCoin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CosmosMsgBeginRedelegate) Reset() {
+	*x = CosmosMsgBeginRedelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosMsgBeginRedelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosMsgBeginRedelegate) ProtoMessage() {}
+
+func (x *CosmosMsgBeginRedelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosMsgBeginRedelegate.ProtoReflect.Descriptor instead.
+func (*CosmosMsgBeginRedelegate) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CosmosMsgBeginRedelegate) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgBeginRedelegate) GetValidatorSrcAddress() string {
+	if x != nil {
+		return x.ValidatorSrcAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgBeginRedelegate) GetValidatorDstAddress() string {
+	if x != nil {
+		return x.ValidatorDstAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgBeginRedelegate) GetAmount() *CosmosCoin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CosmosMsgWithdrawDelegatorReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *CosmosMsgWithdrawDelegatorReward) Reset() {
+	*x = CosmosMsgWithdrawDelegatorReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosMsgWithdrawDelegatorReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosMsgWithdrawDelegatorReward) ProtoMessage() {}
+
+func (x *CosmosMsgWithdrawDelegatorReward) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosMsgWithdrawDelegatorReward.ProtoReflect.Descriptor instead.
+func (*CosmosMsgWithdrawDelegatorReward) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CosmosMsgWithdrawDelegatorReward) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *CosmosMsgWithdrawDelegatorReward) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+type CosmosCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Amount in the smallest unit of the denom. It is an arbitrary-precision integer encoded as a decimal string.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CosmosCoin) Reset() {
+	*x = CosmosCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosCoin) ProtoMessage() {}
+
+func (x *CosmosCoin) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosCoin.ProtoReflect.Descriptor instead.
+func (*CosmosCoin) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CosmosCoin) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *CosmosCoin) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type CosmosFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   []*CosmosCoin `protobuf:"bytes,1,rep,name=amount,proto3" json:"amount,omitempty"`
+	GasLimit uint64        `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	Payer    string        `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty"`
+	Granter  string        `protobuf:"bytes,4,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (x *CosmosFee) Reset() {
+	*x = CosmosFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosFee) ProtoMessage() {}
+
+func (x *CosmosFee) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosFee.ProtoReflect.Descriptor instead.
+func (*CosmosFee) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CosmosFee) GetAmount() []*CosmosCoin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CosmosFee) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *CosmosFee) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
+func (x *CosmosFee) GetGranter() string {
+	if x != nil {
+		return x.Granter
+	}
+	return ""
+}
+
+type CosmosEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes []*CosmosEventAttribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *CosmosEvent) Reset() {
+	*x = CosmosEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosEvent) ProtoMessage() {}
+
+func (x *CosmosEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosEvent.ProtoReflect.Descriptor instead.
+func (*CosmosEvent) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CosmosEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CosmosEvent) GetAttributes() []*CosmosEventAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CosmosEventAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Index bool   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *CosmosEventAttribute) Reset() {
+	*x = CosmosEventAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosEventAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosEventAttribute) ProtoMessage() {}
+
+func (x *CosmosEventAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosEventAttribute.ProtoReflect.Descriptor instead.
+func (*CosmosEventAttribute) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CosmosEventAttribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CosmosEventAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CosmosEventAttribute) GetIndex() bool {
+	if x != nil {
+		return x.Index
+	}
+	return false
+}
+
+var File_coinbase_chainstorage_blockchain_cosmos_proto protoreflect.FileDescriptor
+
+var file_coinbase_chainstorage_blockchain_cosmos_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x15, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x0e, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x42, 0x6c, 0x6f, 0x62, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x4c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x45, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8d, 0x04, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x26,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa8, 0x03, 0x0a, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x46,
+	0x65, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x67, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61,
+	0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xb6, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0a,
+	0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d,
+	0x73, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a,
+	0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x62, 0x65,
+	0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x66,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x4d, 0x73, 0x67, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x75, 0x0a, 0x19, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f,
+	0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x17, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42,
+	0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x11, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x69,
+	0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x4d, 0x73, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x73, 0x67,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x73,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x7c, 0x0a, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x0a,
+	0x0a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0x6e, 0x0a, 0x0b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22,
+	0x54, 0x0a, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescOnce sync.Once
+	file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescData = file_coinbase_chainstorage_blockchain_cosmos_proto_rawDesc
+)
+
+func file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescGZIP() []byte {
+	file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescOnce.Do(func() {
+		file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescData = protoimpl.X.CompressGZIP(file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescData)
+	})
+	return file_coinbase_chainstorage_blockchain_cosmos_proto_rawDescData
+}
+
+var file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_coinbase_chainstorage_blockchain_cosmos_proto_goTypes = []interface{}{
+	(*CosmosBlobdata)(nil),                   // 0: coinbase.chainstorage.CosmosBlobdata
+	(*CosmosBlock)(nil),                      // 1: coinbase.chainstorage.CosmosBlock
+	(*CosmosHeader)(nil),                     // 2: coinbase.chainstorage.CosmosHeader
+	(*CosmosTransaction)(nil),                // 3: coinbase.chainstorage.CosmosTransaction
+	(*CosmosMessage)(nil),                    // 4: coinbase.chainstorage.CosmosMessage
+	(*CosmosMsgDelegate)(nil),                // 5: coinbase.chainstorage.CosmosMsgDelegate
+	(*CosmosMsgUndelegate)(nil),              // 6: coinbase.chainstorage.CosmosMsgUndelegate
+	(*CosmosMsgBeginRedelegate)(nil),         // 7: coinbase.chainstorage.CosmosMsgBeginRedelegate
+	(*CosmosMsgWithdrawDelegatorReward)(nil), // 8: coinbase.chainstorage.CosmosMsgWithdrawDelegatorReward
+	(*CosmosCoin)(nil),                       // 9: coinbase.chainstorage.CosmosCoin
+	(*CosmosFee)(nil),                        // 10: coinbase.chainstorage.CosmosFee
+	(*CosmosEvent)(nil),                      // 11: coinbase.chainstorage.CosmosEvent
+	(*CosmosEventAttribute)(nil),             // 12: coinbase.chainstorage.CosmosEventAttribute
+	(*timestamppb.Timestamp)(nil),            // 13: google.protobuf.Timestamp
+}
+var file_coinbase_chainstorage_blockchain_cosmos_proto_depIdxs = []int32{
+	2,  // 0: coinbase.chainstorage.CosmosBlock.header:type_name -> coinbase.chainstorage.CosmosHeader
+	3,  // 1: coinbase.chainstorage.CosmosBlock.transactions:type_name -> coinbase.chainstorage.CosmosTransaction
+	11, // 2: coinbase.chainstorage.CosmosBlock.block_events:type_name -> coinbase.chainstorage.CosmosEvent
+	13, // 3: coinbase.chainstorage.CosmosHeader.time:type_name -> google.protobuf.Timestamp
+	4,  // 4: coinbase.chainstorage.CosmosTransaction.messages:type_name -> coinbase.chainstorage.CosmosMessage
+	10, // 5: coinbase.chainstorage.CosmosTransaction.fee:type_name -> coinbase.chainstorage.CosmosFee
+	11, // 6: coinbase.chainstorage.CosmosTransaction.events:type_name -> coinbase.chainstorage.CosmosEvent
+	5,  // 7: coinbase.chainstorage.CosmosMessage.delegate:type_name -> coinbase.chainstorage.CosmosMsgDelegate
+	6,  // 8: coinbase.chainstorage.CosmosMessage.undelegate:type_name -> coinbase.chainstorage.CosmosMsgUndelegate
+	7,  // 9: coinbase.chainstorage.CosmosMessage.begin_redelegate:type_name -> coinbase.chainstorage.CosmosMsgBeginRedelegate
+	8,  // 10: coinbase.chainstorage.CosmosMessage.withdraw_delegator_reward:type_name -> coinbase.chainstorage.CosmosMsgWithdrawDelegatorReward
+	9,  // 11: coinbase.chainstorage.CosmosMsgDelegate.amount:type_name -> coinbase.chainstorage.CosmosCoin
+	9,  // 12: coinbase.chainstorage.CosmosMsgUndelegate.amount:type_name -> coinbase.chainstorage.CosmosCoin
+	9,  // 13: coinbase.chainstorage.CosmosMsgBeginRedelegate.amount:type_name -> coinbase.chainstorage.CosmosCoin
+	9,  // 14: coinbase.chainstorage.CosmosFee.amount:type_name -> coinbase.chainstorage.CosmosCoin
+	12, // 15: coinbase.chainstorage.CosmosEvent.attributes:type_name -> coinbase.chainstorage.CosmosEventAttribute
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_coinbase_chainstorage_blockchain_cosmos_proto_init() }
+func file_coinbase_chainstorage_blockchain_cosmos_proto_init() {
+	if File_coinbase_chainstorage_blockchain_cosmos_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosBlobdata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosMsgDelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosMsgUndelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosMsgBeginRedelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosMsgWithdrawDelegatorReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosEventAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*CosmosMessage_Delegate)(nil),
+		(*CosmosMessage_Undelegate)(nil),
+		(*CosmosMessage_BeginRedelegate)(nil),
+		(*CosmosMessage_WithdrawDelegatorReward)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coinbase_chainstorage_blockchain_cosmos_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_coinbase_chainstorage_blockchain_cosmos_proto_goTypes,
+		DependencyIndexes: file_coinbase_chainstorage_blockchain_cosmos_proto_depIdxs,
+		MessageInfos:      file_coinbase_chainstorage_blockchain_cosmos_proto_msgTypes,
+	}.Build()
+	File_coinbase_chainstorage_blockchain_cosmos_proto = out.File
+	file_coinbase_chainstorage_blockchain_cosmos_proto_rawDesc = nil
+	file_coinbase_chainstorage_blockchain_cosmos_proto_goTypes = nil
+	file_coinbase_chainstorage_blockchain_cosmos_proto_depIdxs = nil
+}
@@ -0,0 +1,1399 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.2
+// source: coinbase/chainstorage/blockchain_sui.proto
+
+package chainstorage
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SuiTransactionEffects_Status int32
+
+const (
+	SuiTransactionEffects_UNKNOWN SuiTransactionEffects_Status = 0
+	SuiTransactionEffects_SUCCESS SuiTransactionEffects_Status = 1
+	SuiTransactionEffects_FAILURE SuiTransactionEffects_Status = 2
+)
+
+// Enum value maps for SuiTransactionEffects_Status.
+var (
+	SuiTransactionEffects_Status_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SUCCESS",
+		2: "FAILURE",
+	}
+	SuiTransactionEffects_Status_value = map[string]int32{
+		"UNKNOWN": 0,
+		"SUCCESS": 1,
+		"FAILURE": 2,
+	}
+)
+
+func (x SuiTransactionEffects_Status) Enum() *SuiTransactionEffects_Status {
+	p := new(SuiTransactionEffects_Status)
+	*p = x
+	return p
+}
+
+func (x SuiTransactionEffects_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuiTransactionEffects_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_coinbase_chainstorage_blockchain_sui_proto_enumTypes[0].Descriptor()
+}
+
+func (SuiTransactionEffects_Status) Type() protoreflect.EnumType {
+	return &file_coinbase_chainstorage_blockchain_sui_proto_enumTypes[0]
+}
+
+func (x SuiTransactionEffects_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuiTransactionEffects_Status.Descriptor instead.
+func (SuiTransactionEffects_Status) EnumDescriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type SuiOwner_Type int32
+
+const (
+	SuiOwner_UNKNOWN   SuiOwner_Type = 0
+	SuiOwner_ADDRESS   SuiOwner_Type = 1
+	SuiOwner_OBJECT    SuiOwner_Type = 2
+	SuiOwner_SHARED    SuiOwner_Type = 3
+	SuiOwner_IMMUTABLE SuiOwner_Type = 4
+)
+
+// Enum value maps for SuiOwner_Type.
+var (
+	SuiOwner_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ADDRESS",
+		2: "OBJECT",
+		3: "SHARED",
+		4: "IMMUTABLE",
+	}
+	SuiOwner_Type_value = map[string]int32{
+		"UNKNOWN":   0,
+		"ADDRESS":   1,
+		"OBJECT":    2,
+		"SHARED":    3,
+		"IMMUTABLE": 4,
+	}
+)
+
+func (x SuiOwner_Type) Enum() *SuiOwner_Type {
+	p := new(SuiOwner_Type)
+	*p = x
+	return p
+}
+
+func (x SuiOwner_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuiOwner_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_coinbase_chainstorage_blockchain_sui_proto_enumTypes[1].Descriptor()
+}
+
+func (SuiOwner_Type) Type() protoreflect.EnumType {
+	return &file_coinbase_chainstorage_blockchain_sui_proto_enumTypes[1]
+}
+
+func (x SuiOwner_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuiOwner_Type.Descriptor instead.
+func (SuiOwner_Type) EnumDescriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{10, 0}
+}
+
+// The raw responses of the Sui JSON-RPC for a given checkpoint.
+type SuiBlobdata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response of sui_getCheckpoint.
+	Checkpoint []byte `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// Responses of sui_multiGetTransactionBlocks, one per transaction in the order of the checkpoint.
+	Transactions [][]byte `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *SuiBlobdata) Reset() {
+	*x = SuiBlobdata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiBlobdata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiBlobdata) ProtoMessage() {}
+
+func (x *SuiBlobdata) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiBlobdata.ProtoReflect.Descriptor instead.
+func (*SuiBlobdata) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SuiBlobdata) GetCheckpoint() []byte {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *SuiBlobdata) GetTransactions() [][]byte {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// SuiBlock is a checkpoint, which is treated as a block.
+type SuiBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkpoint   *SuiCheckpoint         `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Transactions []*SuiTransactionBlock `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *SuiBlock) Reset() {
+	*x = SuiBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiBlock) ProtoMessage() {}
+
+func (x *SuiBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiBlock.ProtoReflect.Descriptor instead.
+func (*SuiBlock) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SuiBlock) GetCheckpoint() *SuiCheckpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *SuiBlock) GetTransactions() []*SuiTransactionBlock {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type SuiCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch          uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	Digest         string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	// It is empty for the genesis checkpoint.
+	PreviousDigest             string                 `protobuf:"bytes,4,opt,name=previous_digest,json=previousDigest,proto3" json:"previous_digest,omitempty"`
+	NetworkTotalTransactions   uint64                 `protobuf:"varint,5,opt,name=network_total_transactions,json=networkTotalTransactions,proto3" json:"network_total_transactions,omitempty"`
+	Timestamp                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EpochRollingGasCostSummary *SuiGasCostSummary     `protobuf:"bytes,7,opt,name=epoch_rolling_gas_cost_summary,json=epochRollingGasCostSummary,proto3" json:"epoch_rolling_gas_cost_summary,omitempty"`
+	// Digests of the transactions included in the checkpoint.
+	Transactions       []string `protobuf:"bytes,8,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	ValidatorSignature string   `protobuf:"bytes,9,opt,name=validator_signature,json=validatorSignature,proto3" json:"validator_signature,omitempty"`
+	// Set if the checkpoint is the last one of the epoch.
+	EndOfEpoch bool `protobuf:"varint,10,opt,name=end_of_epoch,json=endOfEpoch,proto3" json:"end_of_epoch,omitempty"`
+}
+
+func (x *SuiCheckpoint) Reset() {
+	*x = SuiCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiCheckpoint) ProtoMessage() {}
+
+func (x *SuiCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiCheckpoint.ProtoReflect.Descriptor instead.
+func (*SuiCheckpoint) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SuiCheckpoint) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SuiCheckpoint) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *SuiCheckpoint) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *SuiCheckpoint) GetPreviousDigest() string {
+	if x != nil {
+		return x.PreviousDigest
+	}
+	return ""
+}
+
+func (x *SuiCheckpoint) GetNetworkTotalTransactions() uint64 {
+	if x != nil {
+		return x.NetworkTotalTransactions
+	}
+	return 0
+}
+
+func (x *SuiCheckpoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SuiCheckpoint) GetEpochRollingGasCostSummary() *SuiGasCostSummary {
+	if x != nil {
+		return x.EpochRollingGasCostSummary
+	}
+	return nil
+}
+
+func (x *SuiCheckpoint) GetTransactions() []string {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *SuiCheckpoint) GetValidatorSignature() string {
+	if x != nil {
+		return x.ValidatorSignature
+	}
+	return ""
+}
+
+func (x *SuiCheckpoint) GetEndOfEpoch() bool {
+	if x != nil {
+		return x.EndOfEpoch
+	}
+	return false
+}
+
+// Gas costs in MIST.
+type SuiGasCostSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ComputationCost         uint64 `protobuf:"varint,1,opt,name=computation_cost,json=computationCost,proto3" json:"computation_cost,omitempty"`
+	StorageCost             uint64 `protobuf:"varint,2,opt,name=storage_cost,json=storageCost,proto3" json:"storage_cost,omitempty"`
+	StorageRebate           uint64 `protobuf:"varint,3,opt,name=storage_rebate,json=storageRebate,proto3" json:"storage_rebate,omitempty"`
+	NonRefundableStorageFee uint64 `protobuf:"varint,4,opt,name=non_refundable_storage_fee,json=nonRefundableStorageFee,proto3" json:"non_refundable_storage_fee,omitempty"`
+}
+
+func (x *SuiGasCostSummary) Reset() {
+	*x = SuiGasCostSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiGasCostSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiGasCostSummary) ProtoMessage() {}
+
+func (x *SuiGasCostSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiGasCostSummary.ProtoReflect.Descriptor instead.
+func (*SuiGasCostSummary) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SuiGasCostSummary) GetComputationCost() uint64 {
+	if x != nil {
+		return x.ComputationCost
+	}
+	return 0
+}
+
+func (x *SuiGasCostSummary) GetStorageCost() uint64 {
+	if x != nil {
+		return x.StorageCost
+	}
+	return 0
+}
+
+func (x *SuiGasCostSummary) GetStorageRebate() uint64 {
+	if x != nil {
+		return x.StorageRebate
+	}
+	return 0
+}
+
+func (x *SuiGasCostSummary) GetNonRefundableStorageFee() uint64 {
+	if x != nil {
+		return x.NonRefundableStorageFee
+	}
+	return 0
+}
+
+type SuiTransactionBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Digest string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Index of the transaction within the checkpoint.
+	Index  uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Kind of the transaction, e.g. ProgrammableTransaction or ConsensusCommitPrologue.
+	Kind           string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	GasData        *SuiGasData            `protobuf:"bytes,5,opt,name=gas_data,json=gasData,proto3" json:"gas_data,omitempty"`
+	Effects        *SuiTransactionEffects `protobuf:"bytes,6,opt,name=effects,proto3" json:"effects,omitempty"`
+	Events         []*SuiEvent            `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	BalanceChanges []*SuiBalanceChange    `protobuf:"bytes,8,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SuiTransactionBlock) Reset() {
+	*x = SuiTransactionBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiTransactionBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiTransactionBlock) ProtoMessage() {}
+
+func (x *SuiTransactionBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiTransactionBlock.ProtoReflect.Descriptor instead.
+func (*SuiTransactionBlock) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SuiTransactionBlock) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *SuiTransactionBlock) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SuiTransactionBlock) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SuiTransactionBlock) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SuiTransactionBlock) GetGasData() *SuiGasData {
+	if x != nil {
+		return x.GasData
+	}
+	return nil
+}
+
+func (x *SuiTransactionBlock) GetEffects() *SuiTransactionEffects {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *SuiTransactionBlock) GetEvents() []*SuiEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SuiTransactionBlock) GetBalanceChanges() []*SuiBalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+func (x *SuiTransactionBlock) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type SuiGasData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Gas price in MIST.
+	Price uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Gas budget in MIST.
+	Budget  uint64          `protobuf:"varint,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	Payment []*SuiObjectRef `protobuf:"bytes,4,rep,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *SuiGasData) Reset() {
+	*x = SuiGasData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiGasData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiGasData) ProtoMessage() {}
+
+func (x *SuiGasData) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiGasData.ProtoReflect.Descriptor instead.
+func (*SuiGasData) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SuiGasData) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SuiGasData) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SuiGasData) GetBudget() uint64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *SuiGasData) GetPayment() []*SuiObjectRef {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type SuiObjectRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Version  uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Digest   string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *SuiObjectRef) Reset() {
+	*x = SuiObjectRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiObjectRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiObjectRef) ProtoMessage() {}
+
+func (x *SuiObjectRef) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiObjectRef.ProtoReflect.Descriptor instead.
+func (*SuiObjectRef) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuiObjectRef) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *SuiObjectRef) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SuiObjectRef) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type SuiTransactionEffects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SuiTransactionEffects_Status `protobuf:"varint,1,opt,name=status,proto3,enum=coinbase.chainstorage.SuiTransactionEffects_Status" json:"status,omitempty"`
+	// Error message of the failed transaction.
+	Error         string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ExecutedEpoch uint64             `protobuf:"varint,3,opt,name=executed_epoch,json=executedEpoch,proto3" json:"executed_epoch,omitempty"`
+	GasUsed       *SuiGasCostSummary `protobuf:"bytes,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	EventsDigest  string             `protobuf:"bytes,5,opt,name=events_digest,json=eventsDigest,proto3" json:"events_digest,omitempty"`
+	// Digests of the transactions this transaction depends on.
+	Dependencies []string `protobuf:"bytes,6,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *SuiTransactionEffects) Reset() {
+	*x = SuiTransactionEffects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiTransactionEffects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiTransactionEffects) ProtoMessage() {}
+
+func (x *SuiTransactionEffects) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiTransactionEffects.ProtoReflect.Descriptor instead.
+func (*SuiTransactionEffects) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SuiTransactionEffects) GetStatus() SuiTransactionEffects_Status {
+	if x != nil {
+		return x.Status
+	}
+	return SuiTransactionEffects_UNKNOWN
+}
+
+func (x *SuiTransactionEffects) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SuiTransactionEffects) GetExecutedEpoch() uint64 {
+	if x != nil {
+		return x.ExecutedEpoch
+	}
+	return 0
+}
+
+func (x *SuiTransactionEffects) GetGasUsed() *SuiGasCostSummary {
+	if x != nil {
+		return x.GasUsed
+	}
+	return nil
+}
+
+func (x *SuiTransactionEffects) GetEventsDigest() string {
+	if x != nil {
+		return x.EventsDigest
+	}
+	return ""
+}
+
+func (x *SuiTransactionEffects) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type SuiEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionDigest string `protobuf:"bytes,1,opt,name=transaction_digest,json=transactionDigest,proto3" json:"transaction_digest,omitempty"`
+	EventSequence     uint64 `protobuf:"varint,2,opt,name=event_sequence,json=eventSequence,proto3" json:"event_sequence,omitempty"`
+	PackageId         string `protobuf:"bytes,3,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	TransactionModule string `protobuf:"bytes,4,opt,name=transaction_module,json=transactionModule,proto3" json:"transaction_module,omitempty"`
+	Sender            string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Move type of the event, e.g. "0x3::validator::StakingRequestEvent".
+	Type string `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	// JSON-encoded fields of the event.
+	ParsedJson string `protobuf:"bytes,7,opt,name=parsed_json,json=parsedJson,proto3" json:"parsed_json,omitempty"`
+	// Base58-encoded BCS bytes of the event.
+	Bcs string `protobuf:"bytes,8,opt,name=bcs,proto3" json:"bcs,omitempty"`
+}
+
+func (x *SuiEvent) Reset() {
+	*x = SuiEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiEvent) ProtoMessage() {}
+
+func (x *SuiEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiEvent.ProtoReflect.Descriptor instead.
+func (*SuiEvent) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SuiEvent) GetTransactionDigest() string {
+	if x != nil {
+		return x.TransactionDigest
+	}
+	return ""
+}
+
+func (x *SuiEvent) GetEventSequence() uint64 {
+	if x != nil {
+		return x.EventSequence
+	}
+	return 0
+}
+
+func (x *SuiEvent) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *SuiEvent) GetTransactionModule() string {
+	if x != nil {
+		return x.TransactionModule
+	}
+	return ""
+}
+
+func (x *SuiEvent) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SuiEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuiEvent) GetParsedJson() string {
+	if x != nil {
+		return x.ParsedJson
+	}
+	return ""
+}
+
+func (x *SuiEvent) GetBcs() string {
+	if x != nil {
+		return x.Bcs
+	}
+	return ""
+}
+
+type SuiBalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner *SuiOwner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Move type of the coin, e.g. "0x2::sui::SUI".
+	CoinType string `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	// Signed amount of the change in the smallest unit of the coin.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SuiBalanceChange) Reset() {
+	*x = SuiBalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiBalanceChange) ProtoMessage() {}
+
+func (x *SuiBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiBalanceChange.ProtoReflect.Descriptor instead.
+func (*SuiBalanceChange) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SuiBalanceChange) GetOwner() *SuiOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *SuiBalanceChange) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *SuiBalanceChange) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type SuiOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type SuiOwner_Type `protobuf:"varint,1,opt,name=type,proto3,enum=coinbase.chainstorage.SuiOwner_Type" json:"type,omitempty"`
+	// Address of the owner. It is set for the ADDRESS and OBJECT types.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Version at which the object became shared. It is set for the SHARED type.
+	InitialSharedVersion uint64 `protobuf:"varint,3,opt,name=initial_shared_version,json=initialSharedVersion,proto3" json:"initial_shared_version,omitempty"`
+}
+
+func (x *SuiOwner) Reset() {
+	*x = SuiOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiOwner) ProtoMessage() {}
+
+func (x *SuiOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiOwner.ProtoReflect.Descriptor instead.
+func (*SuiOwner) Descriptor() ([]byte, []int) {
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SuiOwner) GetType() SuiOwner_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SuiOwner_UNKNOWN
+}
+
+func (x *SuiOwner) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SuiOwner) GetInitialSharedVersion() uint64 {
+	if x != nil {
+		return x.InitialSharedVersion
+	}
+	return 0
+}
+
+var File_coinbase_chainstorage_blockchain_sui_proto protoreflect.FileDescriptor
+
+var file_coinbase_chainstorage_blockchain_sui_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x73, 0x75, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f,
+	0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0b, 0x53, 0x75, 0x69, 0x42, 0x6c, 0x6f, 0x62, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x08, 0x53, 0x75, 0x69, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x44, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x75, 0x69, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x69, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xec, 0x03, 0x0a, 0x0d, 0x53,
+	0x75, 0x69, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x1a,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x18, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x6c, 0x0a, 0x1e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
+	0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x69, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x1a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x6f, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f, 0x6f,
+	0x66, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65,
+	0x6e, 0x64, 0x4f, 0x66, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x53, 0x75,
+	0x69, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65,
+	0x65, 0x22, 0xba, 0x03, 0x0a, 0x13, 0x53, 0x75, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75,
+	0x69, 0x47, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x67, 0x61, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x69, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x69, 0x6e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x53, 0x75, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x69, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8f,
+	0x01, 0x0a, 0x0a, 0x53, 0x75, 0x69, 0x47, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x69, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x5d, 0x0a, 0x0c, 0x53, 0x75, 0x69, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22,
+	0xe0, 0x02, 0x0a, 0x15, 0x53, 0x75, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x69, 0x6e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x53, 0x75, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x43, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75,
+	0x69, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x2f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x10, 0x02, 0x22, 0x8d, 0x02, 0x0a, 0x08, 0x53, 0x75, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x62, 0x63, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62,
+	0x63, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x53, 0x75, 0x69, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75,
+	0x69, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x08, 0x53, 0x75, 0x69, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x69, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f,
+	0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x04, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coinbase_chainstorage_blockchain_sui_proto_rawDescOnce sync.Once
+	file_coinbase_chainstorage_blockchain_sui_proto_rawDescData = file_coinbase_chainstorage_blockchain_sui_proto_rawDesc
+)
+
+func file_coinbase_chainstorage_blockchain_sui_proto_rawDescGZIP() []byte {
+	file_coinbase_chainstorage_blockchain_sui_proto_rawDescOnce.Do(func() {
+		file_coinbase_chainstorage_blockchain_sui_proto_rawDescData = protoimpl.X.CompressGZIP(file_coinbase_chainstorage_blockchain_sui_proto_rawDescData)
+	})
+	return file_coinbase_chainstorage_blockchain_sui_proto_rawDescData
+}
+
+var file_coinbase_chainstorage_blockchain_sui_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_coinbase_chainstorage_blockchain_sui_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_coinbase_chainstorage_blockchain_sui_proto_goTypes = []interface{}{
+	(SuiTransactionEffects_Status)(0), // 0: coinbase.chainstorage.SuiTransactionEffects.Status
+	(SuiOwner_Type)(0),                // 1: coinbase.chainstorage.SuiOwner.Type
+	(*SuiBlobdata)(nil),               // 2: coinbase.chainstorage.SuiBlobdata
+	(*SuiBlock)(nil),                  // 3: coinbase.chainstorage.SuiBlock
+	(*SuiCheckpoint)(nil),             // 4: coinbase.chainstorage.SuiCheckpoint
+	(*SuiGasCostSummary)(nil),         // 5: coinbase.chainstorage.SuiGasCostSummary
+	(*SuiTransactionBlock)(nil),       // 6: coinbase.chainstorage.SuiTransactionBlock
+	(*SuiGasData)(nil),                // 7: coinbase.chainstorage.SuiGasData
+	(*SuiObjectRef)(nil),              // 8: coinbase.chainstorage.SuiObjectRef
+	(*SuiTransactionEffects)(nil),     // 9: coinbase.chainstorage.SuiTransactionEffects
+	(*SuiEvent)(nil),                  // 10: coinbase.chainstorage.SuiEvent
+	(*SuiBalanceChange)(nil),          // 11: coinbase.chainstorage.SuiBalanceChange
+	(*SuiOwner)(nil),                  // 12: coinbase.chainstorage.SuiOwner
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+}
+var file_coinbase_chainstorage_blockchain_sui_proto_depIdxs = []int32{
+	4,  // 0: coinbase.chainstorage.SuiBlock.checkpoint:type_name -> coinbase.chainstorage.SuiCheckpoint
+	6,  // 1: coinbase.chainstorage.SuiBlock.transactions:type_name -> coinbase.chainstorage.SuiTransactionBlock
+	13, // 2: coinbase.chainstorage.SuiCheckpoint.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 3: coinbase.chainstorage.SuiCheckpoint.epoch_rolling_gas_cost_summary:type_name -> coinbase.chainstorage.SuiGasCostSummary
+	7,  // 4: coinbase.chainstorage.SuiTransactionBlock.gas_data:type_name -> coinbase.chainstorage.SuiGasData
+	9,  // 5: coinbase.chainstorage.SuiTransactionBlock.effects:type_name -> coinbase.chainstorage.SuiTransactionEffects
+	10, // 6: coinbase.chainstorage.SuiTransactionBlock.events:type_name -> coinbase.chainstorage.SuiEvent
+	11, // 7: coinbase.chainstorage.SuiTransactionBlock.balance_changes:type_name -> coinbase.chainstorage.SuiBalanceChange
+	13, // 8: coinbase.chainstorage.SuiTransactionBlock.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 9: coinbase.chainstorage.SuiGasData.payment:type_name -> coinbase.chainstorage.SuiObjectRef
+	0,  // 10: coinbase.chainstorage.SuiTransactionEffects.status:type_name -> coinbase.chainstorage.SuiTransactionEffects.Status
+	5,  // 11: coinbase.chainstorage.SuiTransactionEffects.gas_used:type_name -> coinbase.chainstorage.SuiGasCostSummary
+	12, // 12: coinbase.chainstorage.SuiBalanceChange.owner:type_name -> coinbase.chainstorage.SuiOwner
+	1,  // 13: coinbase.chainstorage.SuiOwner.type:type_name -> coinbase.chainstorage.SuiOwner.Type
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_coinbase_chainstorage_blockchain_sui_proto_init() }
+func file_coinbase_chainstorage_blockchain_sui_proto_init() {
+	if File_coinbase_chainstorage_blockchain_sui_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiBlobdata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiGasCostSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiTransactionBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiGasData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiObjectRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiTransactionEffects); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiBalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinbase_chainstorage_blockchain_sui_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coinbase_chainstorage_blockchain_sui_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_coinbase_chainstorage_blockchain_sui_proto_goTypes,
+		DependencyIndexes: file_coinbase_chainstorage_blockchain_sui_proto_depIdxs,
+		EnumInfos:         file_coinbase_chainstorage_blockchain_sui_proto_enumTypes,
+		MessageInfos:      file_coinbase_chainstorage_blockchain_sui_proto_msgTypes,
+	}.Build()
+	File_coinbase_chainstorage_blockchain_sui_proto = out.File
+	file_coinbase_chainstorage_blockchain_sui_proto_rawDesc = nil
+	file_coinbase_chainstorage_blockchain_sui_proto_goTypes = nil
+	file_coinbase_chainstorage_blockchain_sui_proto_depIdxs = nil
+}
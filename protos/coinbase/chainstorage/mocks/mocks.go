@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/coinbase/chainstorage/protos/coinbase/chainstorage (interfaces: ChainStorageClient,ChainStorage_StreamChainEventsClient)
+// Source: github.com/coinbase/chainstorage/protos/coinbase/chainstorage (interfaces: ChainStorageClient,ChainStorage_StreamChainEventsClient,ChainStorage_StreamNativeBlocksByRangeClient,ChainStorage_StreamRawBlocksByRangeClient)
 //
 // Generated by this command:
 //
-//	mockgen -destination protos/coinbase/chainstorage/mocks/mocks.go -package chainstoragemocks github.com/coinbase/chainstorage/protos/coinbase/chainstorage ChainStorageClient,ChainStorage_StreamChainEventsClient
+//	mockgen -destination protos/coinbase/chainstorage/mocks/mocks.go -package chainstoragemocks github.com/coinbase/chainstorage/protos/coinbase/chainstorage ChainStorageClient,ChainStorage_StreamChainEventsClient,ChainStorage_StreamNativeBlocksByRangeClient,ChainStorage_StreamRawBlocksByRangeClient
 //
 
 // Package chainstoragemocks is a generated GoMock package.
@@ -42,6 +42,26 @@ func (m *MockChainStorageClient) EXPECT() *MockChainStorageClientMockRecorder {
 	return m.recorder
 }
 
+// GetBlockByTimestamp mocks base method.
+func (m *MockChainStorageClient) GetBlockByTimestamp(arg0 context.Context, arg1 *chainstorage.GetBlockByTimestampRequest, arg2 ...grpc.CallOption) (*chainstorage.GetBlockByTimestampResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockByTimestamp", varargs...)
+	ret0, _ := ret[0].(*chainstorage.GetBlockByTimestampResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByTimestamp indicates an expected call of GetBlockByTimestamp.
+func (mr *MockChainStorageClientMockRecorder) GetBlockByTimestamp(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByTimestamp", reflect.TypeOf((*MockChainStorageClient)(nil).GetBlockByTimestamp), varargs...)
+}
+
 // GetBlockByTransaction mocks base method.
 func (m *MockChainStorageClient) GetBlockByTransaction(arg0 context.Context, arg1 *chainstorage.GetBlockByTransactionRequest, arg2 ...grpc.CallOption) (*chainstorage.GetBlockByTransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +122,46 @@ func (mr *MockChainStorageClientMockRecorder) GetBlockFilesByRange(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockFilesByRange", reflect.TypeOf((*MockChainStorageClient)(nil).GetBlockFilesByRange), varargs...)
 }
 
+// GetCanonicalBlockAsOfEvent mocks base method.
+func (m *MockChainStorageClient) GetCanonicalBlockAsOfEvent(arg0 context.Context, arg1 *chainstorage.GetCanonicalBlockAsOfEventRequest, arg2 ...grpc.CallOption) (*chainstorage.GetCanonicalBlockAsOfEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCanonicalBlockAsOfEvent", varargs...)
+	ret0, _ := ret[0].(*chainstorage.GetCanonicalBlockAsOfEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCanonicalBlockAsOfEvent indicates an expected call of GetCanonicalBlockAsOfEvent.
+func (mr *MockChainStorageClientMockRecorder) GetCanonicalBlockAsOfEvent(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCanonicalBlockAsOfEvent", reflect.TypeOf((*MockChainStorageClient)(nil).GetCanonicalBlockAsOfEvent), varargs...)
+}
+
+// GetCanonicalBlocksByRangeAsOfEvent mocks base method.
+func (m *MockChainStorageClient) GetCanonicalBlocksByRangeAsOfEvent(arg0 context.Context, arg1 *chainstorage.GetCanonicalBlocksByRangeAsOfEventRequest, arg2 ...grpc.CallOption) (*chainstorage.GetCanonicalBlocksByRangeAsOfEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCanonicalBlocksByRangeAsOfEvent", varargs...)
+	ret0, _ := ret[0].(*chainstorage.GetCanonicalBlocksByRangeAsOfEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCanonicalBlocksByRangeAsOfEvent indicates an expected call of GetCanonicalBlocksByRangeAsOfEvent.
+func (mr *MockChainStorageClientMockRecorder) GetCanonicalBlocksByRangeAsOfEvent(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCanonicalBlocksByRangeAsOfEvent", reflect.TypeOf((*MockChainStorageClient)(nil).GetCanonicalBlocksByRangeAsOfEvent), varargs...)
+}
+
 // GetChainEvents mocks base method.
 func (m *MockChainStorageClient) GetChainEvents(arg0 context.Context, arg1 *chainstorage.GetChainEventsRequest, arg2 ...grpc.CallOption) (*chainstorage.GetChainEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -302,6 +362,26 @@ func (mr *MockChainStorageClientMockRecorder) GetRosettaBlocksByRange(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRosettaBlocksByRange", reflect.TypeOf((*MockChainStorageClient)(nil).GetRosettaBlocksByRange), varargs...)
 }
 
+// GetTransactionsByAddress mocks base method.
+func (m *MockChainStorageClient) GetTransactionsByAddress(arg0 context.Context, arg1 *chainstorage.GetTransactionsByAddressRequest, arg2 ...grpc.CallOption) (*chainstorage.GetTransactionsByAddressResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTransactionsByAddress", varargs...)
+	ret0, _ := ret[0].(*chainstorage.GetTransactionsByAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsByAddress indicates an expected call of GetTransactionsByAddress.
+func (mr *MockChainStorageClientMockRecorder) GetTransactionsByAddress(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByAddress", reflect.TypeOf((*MockChainStorageClient)(nil).GetTransactionsByAddress), varargs...)
+}
+
 // GetVerifiedAccountState mocks base method.
 func (m *MockChainStorageClient) GetVerifiedAccountState(arg0 context.Context, arg1 *chainstorage.GetVerifiedAccountStateRequest, arg2 ...grpc.CallOption) (*chainstorage.GetVerifiedAccountStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -362,6 +442,66 @@ func (mr *MockChainStorageClientMockRecorder) StreamChainEvents(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChainEvents", reflect.TypeOf((*MockChainStorageClient)(nil).StreamChainEvents), varargs...)
 }
 
+// StreamFilteredTransactions mocks base method.
+func (m *MockChainStorageClient) StreamFilteredTransactions(arg0 context.Context, arg1 *chainstorage.StreamFilteredTransactionsRequest, arg2 ...grpc.CallOption) (chainstorage.ChainStorage_StreamFilteredTransactionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamFilteredTransactions", varargs...)
+	ret0, _ := ret[0].(chainstorage.ChainStorage_StreamFilteredTransactionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamFilteredTransactions indicates an expected call of StreamFilteredTransactions.
+func (mr *MockChainStorageClientMockRecorder) StreamFilteredTransactions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamFilteredTransactions", reflect.TypeOf((*MockChainStorageClient)(nil).StreamFilteredTransactions), varargs...)
+}
+
+// StreamNativeBlocksByRange mocks base method.
+func (m *MockChainStorageClient) StreamNativeBlocksByRange(arg0 context.Context, arg1 *chainstorage.StreamNativeBlocksByRangeRequest, arg2 ...grpc.CallOption) (chainstorage.ChainStorage_StreamNativeBlocksByRangeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamNativeBlocksByRange", varargs...)
+	ret0, _ := ret[0].(chainstorage.ChainStorage_StreamNativeBlocksByRangeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamNativeBlocksByRange indicates an expected call of StreamNativeBlocksByRange.
+func (mr *MockChainStorageClientMockRecorder) StreamNativeBlocksByRange(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamNativeBlocksByRange", reflect.TypeOf((*MockChainStorageClient)(nil).StreamNativeBlocksByRange), varargs...)
+}
+
+// StreamRawBlocksByRange mocks base method.
+func (m *MockChainStorageClient) StreamRawBlocksByRange(arg0 context.Context, arg1 *chainstorage.StreamRawBlocksByRangeRequest, arg2 ...grpc.CallOption) (chainstorage.ChainStorage_StreamRawBlocksByRangeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamRawBlocksByRange", varargs...)
+	ret0, _ := ret[0].(chainstorage.ChainStorage_StreamRawBlocksByRangeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamRawBlocksByRange indicates an expected call of StreamRawBlocksByRange.
+func (mr *MockChainStorageClientMockRecorder) StreamRawBlocksByRange(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamRawBlocksByRange", reflect.TypeOf((*MockChainStorageClient)(nil).StreamRawBlocksByRange), varargs...)
+}
+
 // MockChainStorage_StreamChainEventsClient is a mock of ChainStorage_StreamChainEventsClient interface.
 type MockChainStorage_StreamChainEventsClient struct {
 	ctrl     *gomock.Controller
@@ -484,3 +624,249 @@ func (mr *MockChainStorage_StreamChainEventsClientMockRecorder) Trailer() *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockChainStorage_StreamChainEventsClient)(nil).Trailer))
 }
+
+// MockChainStorage_StreamNativeBlocksByRangeClient is a mock of ChainStorage_StreamNativeBlocksByRangeClient interface.
+type MockChainStorage_StreamNativeBlocksByRangeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder
+}
+
+// MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder is the mock recorder for MockChainStorage_StreamNativeBlocksByRangeClient.
+type MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder struct {
+	mock *MockChainStorage_StreamNativeBlocksByRangeClient
+}
+
+// NewMockChainStorage_StreamNativeBlocksByRangeClient creates a new mock instance.
+func NewMockChainStorage_StreamNativeBlocksByRangeClient(ctrl *gomock.Controller) *MockChainStorage_StreamNativeBlocksByRangeClient {
+	mock := &MockChainStorage_StreamNativeBlocksByRangeClient{ctrl: ctrl}
+	mock.recorder = &MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) EXPECT() *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) Recv() (*chainstorage.StreamNativeBlocksByRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*chainstorage.StreamNativeBlocksByRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockChainStorage_StreamNativeBlocksByRangeClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockChainStorage_StreamNativeBlocksByRangeClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockChainStorage_StreamNativeBlocksByRangeClient)(nil).Trailer))
+}
+
+// MockChainStorage_StreamRawBlocksByRangeClient is a mock of ChainStorage_StreamRawBlocksByRangeClient interface.
+type MockChainStorage_StreamRawBlocksByRangeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder
+}
+
+// MockChainStorage_StreamRawBlocksByRangeClientMockRecorder is the mock recorder for MockChainStorage_StreamRawBlocksByRangeClient.
+type MockChainStorage_StreamRawBlocksByRangeClientMockRecorder struct {
+	mock *MockChainStorage_StreamRawBlocksByRangeClient
+}
+
+// NewMockChainStorage_StreamRawBlocksByRangeClient creates a new mock instance.
+func NewMockChainStorage_StreamRawBlocksByRangeClient(ctrl *gomock.Controller) *MockChainStorage_StreamRawBlocksByRangeClient {
+	mock := &MockChainStorage_StreamRawBlocksByRangeClient{ctrl: ctrl}
+	mock.recorder = &MockChainStorage_StreamRawBlocksByRangeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) EXPECT() *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) Recv() (*chainstorage.StreamRawBlocksByRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*chainstorage.StreamRawBlocksByRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockChainStorage_StreamRawBlocksByRangeClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockChainStorage_StreamRawBlocksByRangeClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockChainStorage_StreamRawBlocksByRangeClient)(nil).Trailer))
+}
@@ -80,6 +80,42 @@ type (
 		// Note that this API is still experimental and may change at any time.
 		GetBlockByTransaction(ctx context.Context, tag uint32, transactionHash string) ([]*api.Block, error)
 
+		// GetTransactionsByAddress returns the transactions touching the address between [req.StartHeight, req.EndHeight),
+		// ordered by block height. Transactions in orphaned blocks are excluded.
+		// req.EndHeight is optional and defaults to req.StartHeight + 1.
+		// The result pages returned by the server are followed until the last one, starting from req.PageToken if set.
+		// To look up the activities over a long period, page through the heights with consecutive ranges.
+		// Note that this API is still experimental and may change at any time.
+		GetTransactionsByAddress(ctx context.Context, req *api.GetTransactionsByAddressRequest) ([]*api.AddressTransaction, error)
+
+		// GetBlockByTimestamp returns the latest block produced at or before req.Timestamp (AT_OR_BEFORE),
+		// or the earliest block produced at or after req.Timestamp (AT_OR_AFTER).
+		// Skipped blocks are never returned. If no block satisfies the request, a NotFound error is returned.
+		// Note that this API is still experimental and may change at any time.
+		GetBlockByTimestamp(ctx context.Context, req *api.GetBlockByTimestampRequest) (*api.BlockIdentifier, error)
+
+		// GetCanonicalBlockAsOfEvent returns the block which was on the canonical chain at req.Height
+		// right after the event identified by req.EventTag and req.SequenceNum had been emitted.
+		// The answer is derived from the event history and hence never changes, which makes it suitable for replays and audits.
+		// If the height was not on the canonical chain at that point, a NotFound error is returned.
+		// Note that this API is still experimental and may change at any time.
+		GetCanonicalBlockAsOfEvent(ctx context.Context, req *api.GetCanonicalBlockAsOfEventRequest) (*api.BlockIdentifier, error)
+
+		// GetCanonicalBlocksByRangeAsOfEvent is the range version of GetCanonicalBlockAsOfEvent,
+		// covering the heights between [req.StartHeight, req.EndHeight).
+		// Note that this API is still experimental and may change at any time.
+		GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, req *api.GetCanonicalBlocksByRangeAsOfEventRequest) ([]*api.BlockIdentifier, error)
+
+		// StreamRawBlocksByRange streams the raw blocks between [req.StartHeight, req.EndHeight) on the canonical chain.
+		// Unlike GetBlocksByRange, the range is not limited by the batch size of the server.
+		// If the stream is interrupted by a transient error, it is reconnected from the next block transparently.
+		// Note that this API is still experimental and may change at any time.
+		StreamRawBlocksByRange(ctx context.Context, req *api.StreamRawBlocksByRangeRequest) (BlockIterator[*api.Block], error)
+
+		// StreamNativeBlocksByRange is similar to StreamRawBlocksByRange except that the blocks are parsed by ChainStorage.
+		// Note that this API is still experimental and may change at any time.
+		StreamNativeBlocksByRange(ctx context.Context, req *api.StreamNativeBlocksByRangeRequest) (BlockIterator[*api.NativeBlock], error)
+
 		// StreamChainEvents streams raw blocks from ChainStorage.
 		// The caller is responsible for keeping track of the sequence or sequence_num in BlockchainEvent.
 		StreamChainEvents(ctx context.Context, cfg StreamingConfiguration) (<-chan *ChainEventResult, error)
@@ -282,7 +318,13 @@ func (c *clientImpl) streamBlocks(
 	defer close(ch)
 
 	request := proto.Clone(cfg.ChainEventsRequest).(*api.ChainEventsRequest)
-	for i := uint64(0); cfg.NumberOfEvents == 0 || i < cfg.NumberOfEvents; i++ {
+	var buffer *confirmationBuffer
+	if cfg.BlockConfirmationGap > 0 {
+		buffer = newConfirmationBuffer(cfg.BlockConfirmationGap)
+	}
+
+	numEvents := uint64(0)
+	for cfg.NumberOfEvents == 0 || numEvents < cfg.NumberOfEvents {
 		var event *api.BlockchainEvent
 		if err := c.retry.Retry(ctx, func(ctx context.Context) error {
 			resp, err := stream.Recv()
@@ -339,29 +381,51 @@ func (c *clientImpl) streamBlocks(
 			return
 		}
 
-		// block is omitted if EventOnly is specified.
-		var block *api.Block
-		if !cfg.EventOnly {
+		// The stream is reconnected from the last received event,
+		// because the events held by the confirmation buffer are kept in memory.
+		request.Sequence = event.Sequence
+		request.SequenceNum = event.SequenceNum
+
+		events := []*api.BlockchainEvent{event}
+		if buffer != nil {
 			var err error
-			blockID := event.GetBlock()
-			block, err = c.downloadBlock(ctx, blockID.GetTag(), blockID.GetHeight(), blockID.GetHash())
+			events, err = buffer.Push(event)
 			if err != nil {
 				c.sendBlockResult(ctx, ch, &ChainEventResult{
-					Error: xerrors.Errorf("failed to download block (cfg={%+v}, request={%+v}, event={%+v}): %w", cfg, request, event, err),
+					Error: xerrors.Errorf("failed to buffer event (cfg={%+v}, request={%+v}, event={%+v}): %w", cfg, request, event, err),
 				})
 				return
 			}
 		}
 
-		if ok := c.sendBlockResult(ctx, ch, &ChainEventResult{
-			BlockchainEvent: event,
-			Block:           block,
-		}); !ok {
-			return
-		}
+		for _, event := range events {
+			if cfg.NumberOfEvents > 0 && numEvents >= cfg.NumberOfEvents {
+				return
+			}
 
-		request.Sequence = event.Sequence
-		request.SequenceNum = event.SequenceNum
+			// block is omitted if EventOnly is specified.
+			var block *api.Block
+			if !cfg.EventOnly {
+				var err error
+				blockID := event.GetBlock()
+				block, err = c.downloadBlock(ctx, blockID.GetTag(), blockID.GetHeight(), blockID.GetHash())
+				if err != nil {
+					c.sendBlockResult(ctx, ch, &ChainEventResult{
+						Error: xerrors.Errorf("failed to download block (cfg={%+v}, request={%+v}, event={%+v}): %w", cfg, request, event, err),
+					})
+					return
+				}
+			}
+
+			if ok := c.sendBlockResult(ctx, ch, &ChainEventResult{
+				BlockchainEvent: event,
+				Block:           block,
+			}); !ok {
+				return
+			}
+
+			numEvents += 1
+		}
 	}
 }
 
@@ -483,6 +547,118 @@ func (c *clientImpl) GetBlockByTransaction(ctx context.Context, tag uint32, tran
 	return blocks, nil
 }
 
+func (c *clientImpl) GetTransactionsByAddress(ctx context.Context, req *api.GetTransactionsByAddressRequest) ([]*api.AddressTransaction, error) {
+	request := proto.Clone(req).(*api.GetTransactionsByAddressRequest)
+	var transactions []*api.AddressTransaction
+	for {
+		resp, err := c.client.GetTransactionsByAddress(ctx, request)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get transactions by address (req={%+v}): %w", request, err)
+		}
+
+		transactions = append(transactions, resp.Transactions...)
+		if resp.NextPageToken == "" {
+			return transactions, nil
+		}
+
+		request.PageToken = resp.NextPageToken
+	}
+}
+
+func (c *clientImpl) GetBlockByTimestamp(ctx context.Context, req *api.GetBlockByTimestampRequest) (*api.BlockIdentifier, error) {
+	resp, err := c.client.GetBlockByTimestamp(ctx, req)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get block by timestamp (req={%+v}): %w", req, err)
+	}
+
+	return resp.Block, nil
+}
+
+func (c *clientImpl) GetCanonicalBlockAsOfEvent(ctx context.Context, req *api.GetCanonicalBlockAsOfEventRequest) (*api.BlockIdentifier, error) {
+	resp, err := c.client.GetCanonicalBlockAsOfEvent(ctx, req)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get canonical block as of event (req={%+v}): %w", req, err)
+	}
+
+	return resp.Block, nil
+}
+
+func (c *clientImpl) GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, req *api.GetCanonicalBlocksByRangeAsOfEventRequest) ([]*api.BlockIdentifier, error) {
+	resp, err := c.client.GetCanonicalBlocksByRangeAsOfEvent(ctx, req)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get canonical blocks by range as of event (req={%+v}): %w", req, err)
+	}
+
+	return resp.Blocks, nil
+}
+
+func (c *clientImpl) StreamRawBlocksByRange(ctx context.Context, req *api.StreamRawBlocksByRangeRequest) (BlockIterator[*api.Block], error) {
+	open := func(ctx context.Context, startHeight uint64) (blockReceiver[*api.Block], error) {
+		request := proto.Clone(req).(*api.StreamRawBlocksByRangeRequest)
+		request.StartHeight = startHeight
+		stream, err := c.client.StreamRawBlocksByRange(ctx, request)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to call StreamRawBlocksByRange (req={%+v}): %w", request, err)
+		}
+
+		return func() (*api.Block, error) {
+			resp, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+
+			return resp.GetBlock(), nil
+		}, nil
+	}
+
+	getHeight := func(block *api.Block) uint64 {
+		return block.GetMetadata().GetHeight()
+	}
+
+	var validate func(ctx context.Context, block *api.Block) error
+	if c.blockValidation {
+		validate = c.validateBlock
+	}
+
+	iterator, err := newBlockIterator(ctx, c, req.GetStartHeight(), req.GetEndHeight(), open, getHeight, validate)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to stream raw blocks (req={%+v}): %w", req, err)
+	}
+
+	return iterator, nil
+}
+
+func (c *clientImpl) StreamNativeBlocksByRange(ctx context.Context, req *api.StreamNativeBlocksByRangeRequest) (BlockIterator[*api.NativeBlock], error) {
+	open := func(ctx context.Context, startHeight uint64) (blockReceiver[*api.NativeBlock], error) {
+		request := proto.Clone(req).(*api.StreamNativeBlocksByRangeRequest)
+		request.StartHeight = startHeight
+		stream, err := c.client.StreamNativeBlocksByRange(ctx, request)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to call StreamNativeBlocksByRange (req={%+v}): %w", request, err)
+		}
+
+		return func() (*api.NativeBlock, error) {
+			resp, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+
+			return resp.GetBlock(), nil
+		}, nil
+	}
+
+	getHeight := func(block *api.NativeBlock) uint64 {
+		return block.GetHeight()
+	}
+
+	iterator, err := newBlockIterator(ctx, c, req.GetStartHeight(), req.GetEndHeight(), open, getHeight, nil)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to stream native blocks (req={%+v}): %w", req, err)
+	}
+
+	return iterator, nil
+}
+
 func (c *clientImpl) validateBlock(ctx context.Context, rawBlock *api.Block) error {
 	hash := rawBlock.GetMetadata().GetHash()
 	height := rawBlock.GetMetadata().GetHeight()
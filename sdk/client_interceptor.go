@@ -134,6 +134,52 @@ func (c *timeoutableClient) GetBlockByTransaction(ctx context.Context, tag uint3
 	})
 }
 
+func (c *timeoutableClient) GetTransactionsByAddress(ctx context.Context, req *api.GetTransactionsByAddressRequest) ([]*api.AddressTransaction, error) {
+	return intercept(ctx, c.logger, func(ctx context.Context) ([]*api.AddressTransaction, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.mediumTimeout)
+		defer cancel()
+
+		return c.client.GetTransactionsByAddress(ctx, req)
+	})
+}
+
+func (c *timeoutableClient) GetBlockByTimestamp(ctx context.Context, req *api.GetBlockByTimestampRequest) (*api.BlockIdentifier, error) {
+	return intercept(ctx, c.logger, func(ctx context.Context) (*api.BlockIdentifier, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.mediumTimeout)
+		defer cancel()
+
+		return c.client.GetBlockByTimestamp(ctx, req)
+	})
+}
+
+func (c *timeoutableClient) GetCanonicalBlockAsOfEvent(ctx context.Context, req *api.GetCanonicalBlockAsOfEventRequest) (*api.BlockIdentifier, error) {
+	return intercept(ctx, c.logger, func(ctx context.Context) (*api.BlockIdentifier, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.shortTimeout)
+		defer cancel()
+
+		return c.client.GetCanonicalBlockAsOfEvent(ctx, req)
+	})
+}
+
+func (c *timeoutableClient) GetCanonicalBlocksByRangeAsOfEvent(ctx context.Context, req *api.GetCanonicalBlocksByRangeAsOfEventRequest) ([]*api.BlockIdentifier, error) {
+	return intercept(ctx, c.logger, func(ctx context.Context) ([]*api.BlockIdentifier, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.mediumTimeout)
+		defer cancel()
+
+		return c.client.GetCanonicalBlocksByRangeAsOfEvent(ctx, req)
+	})
+}
+
+func (c *timeoutableClient) StreamRawBlocksByRange(ctx context.Context, req *api.StreamRawBlocksByRangeRequest) (BlockIterator[*api.Block], error) {
+	// No timeout is implemented.
+	return c.client.StreamRawBlocksByRange(ctx, req)
+}
+
+func (c *timeoutableClient) StreamNativeBlocksByRange(ctx context.Context, req *api.StreamNativeBlocksByRangeRequest) (BlockIterator[*api.NativeBlock], error) {
+	// No timeout is implemented.
+	return c.client.StreamNativeBlocksByRange(ctx, req)
+}
+
 func (c *timeoutableClient) StreamChainEvents(ctx context.Context, cfg StreamingConfiguration) (<-chan *ChainEventResult, error) {
 	// No timeout is implemented.
 	return c.client.StreamChainEvents(ctx, cfg)
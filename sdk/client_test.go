@@ -9,8 +9,10 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/coinbase/chainstorage/internal/blockchain/parser"
 	"github.com/coinbase/chainstorage/internal/config"
@@ -318,6 +320,47 @@ func (s *clientTestSuite) TestStreamBlocks_WithSkippedBlocks() {
 	s.require.Equal(numberOfEvents, count)
 }
 
+func (s *clientTestSuite) TestStreamBlocks_WithBlockConfirmationGap() {
+	s.gatewayClient.EXPECT().StreamChainEvents(gomock.Any(), gomock.Any()).Return(s.streamClient, nil)
+
+	receivedEvents := []*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 10, "0xa"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xb"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xc"),
+		newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 12, "0xc"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xcc"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 13, "0xd"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 14, "0xe"),
+	}
+	for i, event := range receivedEvents {
+		event.SequenceNum = int64(i + 1)
+		s.streamClient.EXPECT().Recv().Return(&api.ChainEventsResponse{
+			Event: event,
+		}, nil)
+	}
+
+	ch, err := s.client.StreamChainEvents(context.Background(), StreamingConfiguration{
+		ChainEventsRequest:   &api.ChainEventsRequest{},
+		NumberOfEvents:       3,
+		BlockConfirmationGap: 2,
+		EventOnly:            true,
+	})
+	s.require.NoError(err)
+
+	var actualEvents []*api.BlockchainEvent
+	for result := range ch {
+		s.require.NotNil(result)
+		s.require.NoError(result.Error)
+		actualEvents = append(actualEvents, result.BlockchainEvent)
+	}
+
+	s.require.Equal([]*api.BlockchainEvent{
+		receivedEvents[0],
+		receivedEvents[1],
+		receivedEvents[4],
+	}, actualEvents)
+}
+
 func (s *clientTestSuite) TestStreamBlocks_ErrStreamChainEvents() {
 	mockError := xerrors.New("some mock error")
 	s.gatewayClient.EXPECT().StreamChainEvents(gomock.Any(), gomock.Any()).Return(nil, mockError)
@@ -579,6 +622,232 @@ func (s *clientTestSuite) TestGetChainMetadata() {
 	s.require.Equal("13s", resp.BlockTime)
 }
 
+func (s *clientTestSuite) TestGetTransactionsByAddress() {
+	req := &api.GetTransactionsByAddressRequest{
+		Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+		StartHeight: 100,
+		EndHeight:   200,
+	}
+	transactions := []*api.AddressTransaction{
+		{
+			TransactionHash: "0xabc",
+			Block: &api.BlockIdentifier{
+				Hash:   "0x123",
+				Height: 150,
+			},
+		},
+		{
+			TransactionHash: "0xdef",
+			Block: &api.BlockIdentifier{
+				Hash:   "0x456",
+				Height: 160,
+			},
+		},
+	}
+	gomock.InOrder(
+		s.gatewayClient.EXPECT().GetTransactionsByAddress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, in *api.GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*api.GetTransactionsByAddressResponse, error) {
+				s.require.Equal(req.Address, in.Address)
+				s.require.Empty(in.PageToken)
+				return &api.GetTransactionsByAddressResponse{
+					Transactions:  transactions[:1],
+					NextPageToken: "token",
+				}, nil
+			}),
+		s.gatewayClient.EXPECT().GetTransactionsByAddress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, in *api.GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*api.GetTransactionsByAddressResponse, error) {
+				s.require.Equal(req.Address, in.Address)
+				s.require.Equal("token", in.PageToken)
+				return &api.GetTransactionsByAddressResponse{
+					Transactions: transactions[1:],
+				}, nil
+			}),
+	)
+
+	actual, err := s.client.GetTransactionsByAddress(context.Background(), req)
+	s.require.NoError(err)
+	s.require.Equal(transactions, actual)
+	s.require.Empty(req.PageToken)
+}
+
+func (s *clientTestSuite) TestGetBlockByTimestamp() {
+	req := &api.GetBlockByTimestampRequest{
+		Timestamp: &timestamppb.Timestamp{Seconds: 1640995200},
+		Mode:      api.GetBlockByTimestampRequest_AT_OR_AFTER,
+	}
+	block := &api.BlockIdentifier{
+		Hash:      "0x123",
+		Height:    150,
+		Timestamp: &timestamppb.Timestamp{Seconds: 1640995205},
+	}
+	s.gatewayClient.EXPECT().GetBlockByTimestamp(gomock.Any(), req).Return(&api.GetBlockByTimestampResponse{
+		Block: block,
+	}, nil)
+
+	actual, err := s.client.GetBlockByTimestamp(context.Background(), req)
+	s.require.NoError(err)
+	s.require.Equal(block, actual)
+}
+
+func (s *clientTestSuite) TestGetCanonicalBlockAsOfEvent() {
+	req := &api.GetCanonicalBlockAsOfEventRequest{
+		EventTag:    1,
+		SequenceNum: 12345,
+		Height:      150,
+	}
+	block := &api.BlockIdentifier{
+		Hash:   "0x123",
+		Height: 150,
+	}
+	s.gatewayClient.EXPECT().GetCanonicalBlockAsOfEvent(gomock.Any(), req).Return(&api.GetCanonicalBlockAsOfEventResponse{
+		Block: block,
+	}, nil)
+
+	actual, err := s.client.GetCanonicalBlockAsOfEvent(context.Background(), req)
+	s.require.NoError(err)
+	s.require.Equal(block, actual)
+}
+
+func (s *clientTestSuite) TestGetCanonicalBlocksByRangeAsOfEvent() {
+	req := &api.GetCanonicalBlocksByRangeAsOfEventRequest{
+		EventTag:    1,
+		SequenceNum: 12345,
+		StartHeight: 150,
+		EndHeight:   152,
+	}
+	blocks := []*api.BlockIdentifier{
+		{Hash: "0x123", Height: 150},
+		{Hash: "0x456", Height: 151},
+	}
+	s.gatewayClient.EXPECT().GetCanonicalBlocksByRangeAsOfEvent(gomock.Any(), req).Return(&api.GetCanonicalBlocksByRangeAsOfEventResponse{
+		Blocks: blocks,
+	}, nil)
+
+	actual, err := s.client.GetCanonicalBlocksByRangeAsOfEvent(context.Background(), req)
+	s.require.NoError(err)
+	s.require.Equal(blocks, actual)
+}
+
+func (s *clientTestSuite) TestStreamRawBlocksByRange() {
+	const (
+		startHeight = uint64(100)
+		endHeight   = uint64(105)
+	)
+
+	blocks := testutil.MakeBlocksFromStartHeight(startHeight, int(endHeight-startHeight), 1)
+	streamClient := apimocks.NewMockChainStorage_StreamRawBlocksByRangeClient(s.ctrl)
+	reconnectedStreamClient := apimocks.NewMockChainStorage_StreamRawBlocksByRangeClient(s.ctrl)
+	gomock.InOrder(
+		s.gatewayClient.EXPECT().StreamRawBlocksByRange(gomock.Any(), testutil.MatchProto(&api.StreamRawBlocksByRangeRequest{
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+		})).Return(streamClient, nil),
+		streamClient.EXPECT().Recv().Return(&api.StreamRawBlocksByRangeResponse{Block: blocks[0]}, nil),
+		streamClient.EXPECT().Recv().Return(&api.StreamRawBlocksByRangeResponse{Block: blocks[1]}, nil),
+		streamClient.EXPECT().Recv().Return(nil, status.Error(codes.Internal, "unexpected EOF")),
+		// The stream is reconnected from the next block.
+		s.gatewayClient.EXPECT().StreamRawBlocksByRange(gomock.Any(), testutil.MatchProto(&api.StreamRawBlocksByRangeRequest{
+			StartHeight: startHeight + 2,
+			EndHeight:   endHeight,
+		})).Return(reconnectedStreamClient, nil),
+		reconnectedStreamClient.EXPECT().Recv().Return(&api.StreamRawBlocksByRangeResponse{Block: blocks[2]}, nil),
+		reconnectedStreamClient.EXPECT().Recv().Return(&api.StreamRawBlocksByRangeResponse{Block: blocks[3]}, nil),
+		reconnectedStreamClient.EXPECT().Recv().Return(&api.StreamRawBlocksByRangeResponse{Block: blocks[4]}, nil),
+	)
+
+	iterator, err := s.client.StreamRawBlocksByRange(context.Background(), &api.StreamRawBlocksByRangeRequest{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+	s.require.NoError(err)
+
+	var actual []*api.Block
+	for iterator.Next() {
+		actual = append(actual, iterator.Block())
+	}
+	s.require.NoError(iterator.Err())
+	s.require.Equal(blocks, actual)
+}
+
+func (s *clientTestSuite) TestStreamRawBlocksByRange_NonTransientError() {
+	streamClient := apimocks.NewMockChainStorage_StreamRawBlocksByRangeClient(s.ctrl)
+	s.gatewayClient.EXPECT().StreamRawBlocksByRange(gomock.Any(), gomock.Any()).Return(streamClient, nil)
+	streamClient.EXPECT().Recv().Return(nil, status.Error(codes.FailedPrecondition, "range is beyond the tip"))
+
+	iterator, err := s.client.StreamRawBlocksByRange(context.Background(), &api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   105,
+	})
+	s.require.NoError(err)
+	s.require.False(iterator.Next())
+	s.require.Nil(iterator.Block())
+	s.require.Error(iterator.Err())
+	var grpcErr gateway.GrpcError
+	s.require.True(xerrors.As(iterator.Err(), &grpcErr))
+	s.require.Equal(codes.FailedPrecondition, grpcErr.GRPCStatus().Code())
+	s.require.False(iterator.Next())
+}
+
+func (s *clientTestSuite) TestStreamRawBlocksByRange_UnexpectedBlock() {
+	blocks := testutil.MakeBlocksFromStartHeight(101, 1, 1)
+	streamClient := apimocks.NewMockChainStorage_StreamRawBlocksByRangeClient(s.ctrl)
+	s.gatewayClient.EXPECT().StreamRawBlocksByRange(gomock.Any(), gomock.Any()).Return(streamClient, nil)
+	streamClient.EXPECT().Recv().Return(&api.StreamRawBlocksByRangeResponse{Block: blocks[0]}, nil)
+
+	iterator, err := s.client.StreamRawBlocksByRange(context.Background(), &api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   105,
+	})
+	s.require.NoError(err)
+	s.require.False(iterator.Next())
+	s.require.Contains(iterator.Err().Error(), "received unexpected block")
+}
+
+func (s *clientTestSuite) TestStreamRawBlocksByRange_InvalidRange() {
+	_, err := s.client.StreamRawBlocksByRange(context.Background(), &api.StreamRawBlocksByRangeRequest{
+		StartHeight: 100,
+		EndHeight:   100,
+	})
+	s.require.Error(err)
+	s.require.Contains(err.Error(), "invalid range")
+}
+
+func (s *clientTestSuite) TestStreamNativeBlocksByRange() {
+	const (
+		startHeight = uint64(100)
+		endHeight   = uint64(103)
+	)
+
+	blocks := make([]*api.NativeBlock, endHeight-startHeight)
+	streamClient := apimocks.NewMockChainStorage_StreamNativeBlocksByRangeClient(s.ctrl)
+	s.gatewayClient.EXPECT().StreamNativeBlocksByRange(gomock.Any(), testutil.MatchProto(&api.StreamNativeBlocksByRangeRequest{
+		Tag:         1,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})).Return(streamClient, nil)
+	for i := range blocks {
+		blocks[i] = &api.NativeBlock{
+			Tag:    1,
+			Height: startHeight + uint64(i),
+		}
+		streamClient.EXPECT().Recv().Return(&api.StreamNativeBlocksByRangeResponse{Block: blocks[i]}, nil)
+	}
+
+	iterator, err := s.client.StreamNativeBlocksByRange(context.Background(), &api.StreamNativeBlocksByRangeRequest{
+		Tag:         1,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+	s.require.NoError(err)
+
+	var actual []*api.NativeBlock
+	for iterator.Next() {
+		actual = append(actual, iterator.Block())
+	}
+	s.require.NoError(iterator.Err())
+	s.require.Equal(blocks, actual)
+}
+
 func (s *clientTestSuite) TestGetStaticChainMetadata() {
 	s.config.Chain.BlockTag.Latest = 1
 	s.config.Chain.BlockTag.Stable = 2
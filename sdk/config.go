@@ -35,7 +35,9 @@ type (
 		// Number of events to return from the stream. If not specified, streaming never ends.
 		NumberOfEvents uint64
 
-		// Not implemented.
+		// Number of blocks the tip must be past a BLOCK_ADDED event before the event is returned.
+		// BLOCK_ADDED/BLOCK_REMOVED pairs that cancel out within the gap are dropped.
+		// If not specified, events are returned as soon as they are received.
 		BlockConfirmationGap uint64
 
 		// If specified, the Block field is omitted from ChainEventResult.
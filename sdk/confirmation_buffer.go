@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"container/list"
+
+	"golang.org/x/xerrors"
+
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+type (
+	// confirmationBuffer holds BLOCK_ADDED events until the tip is BlockConfirmationGap blocks past them.
+	// A BLOCK_REMOVED event that rolls back a block still held in the buffer cancels out the pending BLOCK_ADDED event,
+	// so that the caller only observes reorgs deeper than the confirmation gap.
+	confirmationBuffer struct {
+		gap    uint64
+		tip    uint64
+		events *list.List
+	}
+)
+
+func newConfirmationBuffer(gap uint64) *confirmationBuffer {
+	return &confirmationBuffer{
+		gap:    gap,
+		events: list.New(),
+	}
+}
+
+// Push appends the event to the buffer and returns the events which are now confirmed, in stream order.
+func (b *confirmationBuffer) Push(event *api.BlockchainEvent) ([]*api.BlockchainEvent, error) {
+	block := event.GetBlock()
+	if block == nil {
+		return nil, xerrors.Errorf("block identifier is missing in event {%+v}", event)
+	}
+
+	switch event.Type {
+	case api.BlockchainEvent_BLOCK_ADDED:
+		b.tip = block.Height
+		b.events.PushBack(event)
+	case api.BlockchainEvent_BLOCK_REMOVED:
+		if block.Height > 0 {
+			b.tip = block.Height - 1
+		} else {
+			b.tip = 0
+		}
+
+		// rollback case +1, +2, [+3, -3]: the two events cancel out.
+		if lastItem := b.events.Back(); lastItem != nil {
+			lastEvent := lastItem.Value.(*api.BlockchainEvent)
+			if lastEvent.Type == api.BlockchainEvent_BLOCK_ADDED {
+				lastBlock := lastEvent.GetBlock()
+				if lastBlock.Height != block.Height || lastBlock.Hash != block.Hash {
+					return nil, xerrors.Errorf("expect event {%+v} and lastEvent {%+v} to have the same block hash/height", event, lastEvent)
+				}
+
+				b.events.Remove(lastItem)
+				return b.popConfirmedEvents(), nil
+			}
+		}
+
+		// The block being removed has already been confirmed and returned to the caller.
+		b.events.PushBack(event)
+	default:
+		return nil, xerrors.Errorf("unexpected event type: %v", event.Type)
+	}
+
+	return b.popConfirmedEvents(), nil
+}
+
+func (b *confirmationBuffer) popConfirmedEvents() []*api.BlockchainEvent {
+	var confirmed []*api.BlockchainEvent
+	for item := b.events.Front(); item != nil; item = b.events.Front() {
+		event := item.Value.(*api.BlockchainEvent)
+		if event.Type == api.BlockchainEvent_BLOCK_ADDED && event.GetBlock().Height+b.gap > b.tip {
+			break
+		}
+
+		b.events.Remove(item)
+		confirmed = append(confirmed, event)
+	}
+
+	return confirmed
+}
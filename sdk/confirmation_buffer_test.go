@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/coinbase/chainstorage/internal/utils/testutil"
+	api "github.com/coinbase/chainstorage/protos/coinbase/chainstorage"
+)
+
+func TestConfirmationBuffer(t *testing.T) {
+	require := testutil.Require(t)
+
+	buffer := newConfirmationBuffer(2)
+	events, err := buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 10, "0xa"))
+	require.NoError(err)
+	require.Empty(events)
+
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xb"))
+	require.NoError(err)
+	require.Empty(events)
+
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xc"))
+	require.NoError(err)
+	require.Equal(1, len(events))
+	require.Equal(uint64(10), events[0].Block.Height)
+
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 13, "0xd"))
+	require.NoError(err)
+	require.Equal(1, len(events))
+	require.Equal(uint64(11), events[0].Block.Height)
+}
+
+func TestConfirmationBuffer_ReorgWithinGap(t *testing.T) {
+	require := testutil.Require(t)
+
+	buffer := newConfirmationBuffer(3)
+	for _, event := range []*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 10, "0xa"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xb"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xc"),
+		newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 12, "0xc"),
+		newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 11, "0xb"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xbb"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xcc"),
+	} {
+		events, err := buffer.Push(event)
+		require.NoError(err)
+		require.Empty(events)
+	}
+
+	events, err := buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 13, "0xdd"))
+	require.NoError(err)
+	require.Equal([]*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 10, "0xa"),
+	}, events)
+
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 14, "0xee"))
+	require.NoError(err)
+	require.Equal([]*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xbb"),
+	}, events)
+}
+
+func TestConfirmationBuffer_ReorgBeyondGap(t *testing.T) {
+	require := testutil.Require(t)
+
+	buffer := newConfirmationBuffer(1)
+	for _, event := range []*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 10, "0xa"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xb"),
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xc"),
+	} {
+		_, err := buffer.Push(event)
+		require.NoError(err)
+	}
+
+	// Block 12 is still pending and cancels out.
+	events, err := buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 12, "0xc"))
+	require.NoError(err)
+	require.Empty(events)
+
+	// Block 11 has been confirmed, so the removal is returned.
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 11, "0xb"))
+	require.NoError(err)
+	require.Equal([]*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 11, "0xb"),
+	}, events)
+
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xbb"))
+	require.NoError(err)
+	require.Empty(events)
+
+	events, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 12, "0xcc"))
+	require.NoError(err)
+	require.Equal([]*api.BlockchainEvent{
+		newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 11, "0xbb"),
+	}, events)
+}
+
+func TestConfirmationBuffer_InconsistentRemoval(t *testing.T) {
+	require := testutil.Require(t)
+
+	buffer := newConfirmationBuffer(2)
+	_, err := buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_ADDED, 10, "0xa"))
+	require.NoError(err)
+
+	_, err = buffer.Push(newTestEvent(api.BlockchainEvent_BLOCK_REMOVED, 10, "0xb"))
+	require.Error(err)
+	require.Contains(err.Error(), "to have the same block hash/height")
+}
+
+func newTestEvent(eventType api.BlockchainEvent_Type, height uint64, hash string) *api.BlockchainEvent {
+	return &api.BlockchainEvent{
+		Type: eventType,
+		Block: &api.BlockIdentifier{
+			Height: height,
+			Hash:   hash,
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/coinbase/chainstorage/internal/utils/retry"
+)
+
+type (
+	// BlockIterator iterates through the blocks returned by StreamRawBlocksByRange or StreamNativeBlocksByRange in height order.
+	// Typical usage:
+	//
+	//	iterator, err := client.StreamRawBlocksByRange(ctx, req)
+	//	if err != nil {
+	//		return err
+	//	}
+	//	for iterator.Next() {
+	//		block := iterator.Block()
+	//	}
+	//	if err := iterator.Err(); err != nil {
+	//		return err
+	//	}
+	//
+	// The underlying stream is released once Next returns false.
+	// To stop the iteration early, cancel the context passed to the client.
+	BlockIterator[T any] interface {
+		// Next advances the iterator to the next block.
+		// It returns false when the range is exhausted or an error occurs.
+		Next() bool
+
+		// Block returns the current block.
+		Block() T
+
+		// Err returns the error, if any, that stopped the iteration.
+		Err() error
+	}
+
+	// blockReceiver receives the next block from a stream.
+	blockReceiver[T any] func() (T, error)
+
+	// blockStreamOpener opens a new stream starting at startHeight.
+	blockStreamOpener[T any] func(ctx context.Context, startHeight uint64) (blockReceiver[T], error)
+
+	blockIterator[T any] struct {
+		ctx         context.Context
+		cancel      context.CancelFunc
+		logger      *zap.Logger
+		retry       retry.Retry
+		isTransient func(err error) bool
+		open        blockStreamOpener[T]
+		getHeight   func(block T) uint64
+		validate    func(ctx context.Context, block T) error
+		recv        blockReceiver[T]
+		nextHeight  uint64
+		endHeight   uint64
+		block       T
+		err         error
+	}
+)
+
+func newBlockIterator[T any](
+	ctx context.Context,
+	c *clientImpl,
+	startHeight uint64,
+	endHeight uint64,
+	open blockStreamOpener[T],
+	getHeight func(block T) uint64,
+	validate func(ctx context.Context, block T) error,
+) (*blockIterator[T], error) {
+	if startHeight >= endHeight {
+		return nil, xerrors.Errorf("invalid range: startHeight=%v, endHeight=%v", startHeight, endHeight)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	recv, err := open(ctx, startHeight)
+	if err != nil {
+		cancel()
+		return nil, xerrors.Errorf("failed to open stream: %w", err)
+	}
+
+	return &blockIterator[T]{
+		ctx:         ctx,
+		cancel:      cancel,
+		logger:      c.logger,
+		retry:       c.retry,
+		isTransient: c.isTransientStreamError,
+		open:        open,
+		getHeight:   getHeight,
+		validate:    validate,
+		recv:        recv,
+		nextHeight:  startHeight,
+		endHeight:   endHeight,
+	}, nil
+}
+
+func (it *blockIterator[T]) Next() bool {
+	var empty T
+	it.block = empty
+	if it.err != nil || it.nextHeight >= it.endHeight {
+		it.cancel()
+		return false
+	}
+
+	var block T
+	if err := it.retry.Retry(it.ctx, func(ctx context.Context) error {
+		var err error
+		block, err = it.recv()
+		if err != nil {
+			if !it.isTransient(err) {
+				// Fail fast if the error is NOT transient.
+				// Note that io.EOF is not transient either, since the server always sends the whole range.
+				return err
+			}
+
+			// In the event of a transient error,
+			// reconnect the stream from the next block, which has not been received yet.
+			it.logger.Info(
+				"reconnecting stream",
+				zap.Error(err),
+				zap.Uint64("start_height", it.nextHeight),
+				zap.Uint64("end_height", it.endHeight),
+			)
+
+			recv, newErr := it.open(it.ctx, it.nextHeight)
+			if newErr != nil {
+				it.logger.Warn(
+					"failed to reconnect stream",
+					zap.Error(newErr),
+					zap.Uint64("start_height", it.nextHeight),
+					zap.Uint64("end_height", it.endHeight),
+				)
+				return err
+			}
+
+			it.recv = recv
+			return retry.Retryable(err)
+		}
+
+		return nil
+	}); err != nil {
+		return it.fail(xerrors.Errorf("failed to receive block (height=%v): %w", it.nextHeight, err))
+	}
+
+	if height := it.getHeight(block); height != it.nextHeight {
+		return it.fail(xerrors.Errorf("received unexpected block (expected=%v, actual=%v)", it.nextHeight, height))
+	}
+
+	if it.validate != nil {
+		if err := it.validate(it.ctx, block); err != nil {
+			return it.fail(xerrors.Errorf("failed to validate block (height=%v): %w", it.nextHeight, err))
+		}
+	}
+
+	it.block = block
+	it.nextHeight += 1
+	return true
+}
+
+func (it *blockIterator[T]) Block() T {
+	return it.block
+}
+
+func (it *blockIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *blockIterator[T]) fail(err error) bool {
+	it.err = err
+	it.cancel()
+	return false
+}
@@ -56,6 +56,21 @@ func (mr *MockClientMockRecorder) GetBlock(arg0, arg1, arg2 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockClient)(nil).GetBlock), arg0, arg1, arg2)
 }
 
+// GetBlockByTimestamp mocks base method.
+func (m *MockClient) GetBlockByTimestamp(arg0 context.Context, arg1 *chainstorage.GetBlockByTimestampRequest) (*chainstorage.BlockIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockByTimestamp", arg0, arg1)
+	ret0, _ := ret[0].(*chainstorage.BlockIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByTimestamp indicates an expected call of GetBlockByTimestamp.
+func (mr *MockClientMockRecorder) GetBlockByTimestamp(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByTimestamp", reflect.TypeOf((*MockClient)(nil).GetBlockByTimestamp), arg0, arg1)
+}
+
 // GetBlockByTransaction mocks base method.
 func (m *MockClient) GetBlockByTransaction(arg0 context.Context, arg1 uint32, arg2 string) ([]*chainstorage.Block, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +145,36 @@ func (mr *MockClientMockRecorder) GetBlocksByRangeWithTag(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByRangeWithTag", reflect.TypeOf((*MockClient)(nil).GetBlocksByRangeWithTag), arg0, arg1, arg2, arg3)
 }
 
+// GetCanonicalBlockAsOfEvent mocks base method.
+func (m *MockClient) GetCanonicalBlockAsOfEvent(arg0 context.Context, arg1 *chainstorage.GetCanonicalBlockAsOfEventRequest) (*chainstorage.BlockIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCanonicalBlockAsOfEvent", arg0, arg1)
+	ret0, _ := ret[0].(*chainstorage.BlockIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCanonicalBlockAsOfEvent indicates an expected call of GetCanonicalBlockAsOfEvent.
+func (mr *MockClientMockRecorder) GetCanonicalBlockAsOfEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCanonicalBlockAsOfEvent", reflect.TypeOf((*MockClient)(nil).GetCanonicalBlockAsOfEvent), arg0, arg1)
+}
+
+// GetCanonicalBlocksByRangeAsOfEvent mocks base method.
+func (m *MockClient) GetCanonicalBlocksByRangeAsOfEvent(arg0 context.Context, arg1 *chainstorage.GetCanonicalBlocksByRangeAsOfEventRequest) ([]*chainstorage.BlockIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCanonicalBlocksByRangeAsOfEvent", arg0, arg1)
+	ret0, _ := ret[0].([]*chainstorage.BlockIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCanonicalBlocksByRangeAsOfEvent indicates an expected call of GetCanonicalBlocksByRangeAsOfEvent.
+func (mr *MockClientMockRecorder) GetCanonicalBlocksByRangeAsOfEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCanonicalBlocksByRangeAsOfEvent", reflect.TypeOf((*MockClient)(nil).GetCanonicalBlocksByRangeAsOfEvent), arg0, arg1)
+}
+
 // GetChainEvents mocks base method.
 func (m *MockClient) GetChainEvents(arg0 context.Context, arg1 *chainstorage.GetChainEventsRequest) ([]*chainstorage.BlockchainEvent, error) {
 	m.ctrl.T.Helper()
@@ -233,6 +278,21 @@ func (mr *MockClientMockRecorder) GetTag() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockClient)(nil).GetTag))
 }
 
+// GetTransactionsByAddress mocks base method.
+func (m *MockClient) GetTransactionsByAddress(arg0 context.Context, arg1 *chainstorage.GetTransactionsByAddressRequest) ([]*chainstorage.AddressTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsByAddress", arg0, arg1)
+	ret0, _ := ret[0].([]*chainstorage.AddressTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsByAddress indicates an expected call of GetTransactionsByAddress.
+func (mr *MockClientMockRecorder) GetTransactionsByAddress(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByAddress", reflect.TypeOf((*MockClient)(nil).GetTransactionsByAddress), arg0, arg1)
+}
+
 // SetBlockValidation mocks base method.
 func (m *MockClient) SetBlockValidation(arg0 bool) {
 	m.ctrl.T.Helper()
@@ -284,6 +344,36 @@ func (mr *MockClientMockRecorder) StreamChainEvents(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChainEvents", reflect.TypeOf((*MockClient)(nil).StreamChainEvents), arg0, arg1)
 }
 
+// StreamNativeBlocksByRange mocks base method.
+func (m *MockClient) StreamNativeBlocksByRange(arg0 context.Context, arg1 *chainstorage.StreamNativeBlocksByRangeRequest) (sdk.BlockIterator[*chainstorage.NativeBlock], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamNativeBlocksByRange", arg0, arg1)
+	ret0, _ := ret[0].(sdk.BlockIterator[*chainstorage.NativeBlock])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamNativeBlocksByRange indicates an expected call of StreamNativeBlocksByRange.
+func (mr *MockClientMockRecorder) StreamNativeBlocksByRange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamNativeBlocksByRange", reflect.TypeOf((*MockClient)(nil).StreamNativeBlocksByRange), arg0, arg1)
+}
+
+// StreamRawBlocksByRange mocks base method.
+func (m *MockClient) StreamRawBlocksByRange(arg0 context.Context, arg1 *chainstorage.StreamRawBlocksByRangeRequest) (sdk.BlockIterator[*chainstorage.Block], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamRawBlocksByRange", arg0, arg1)
+	ret0, _ := ret[0].(sdk.BlockIterator[*chainstorage.Block])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamRawBlocksByRange indicates an expected call of StreamRawBlocksByRange.
+func (mr *MockClientMockRecorder) StreamRawBlocksByRange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamRawBlocksByRange", reflect.TypeOf((*MockClient)(nil).StreamRawBlocksByRange), arg0, arg1)
+}
+
 // MockParser is a mock of Parser interface.
 type MockParser struct {
 	ctrl     *gomock.Controller